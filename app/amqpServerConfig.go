@@ -2,23 +2,59 @@ package app
 
 import (
 	"fmt"
+	"go-as/src/infrastructure/messaging"
 	"os"
+	"strconv"
+	"time"
 
-	"github.com/streadway/amqp"
+	"go.uber.org/zap"
 )
 
-func ConnectToAMQPServer() *amqp.Connection {
+const defaultAMQPMaxRetries = 5
+const defaultAMQPRetryDelay = 30 * time.Second
+const defaultAMQPPrefetch = 10
+const defaultAMQPConsumerWorkers = 4
+const defaultAMQPReconnectInitialBackoff = time.Second
+const defaultAMQPReconnectMaxBackoff = 30 * time.Second
+const defaultAMQPChannelMaxReopenAttempts = 5
+
+func ConnectToAMQPServer(logger *zap.Logger) *messaging.AMQPConnectionManager {
 	amqpUser := os.Getenv("AMQP_USER")
 	amqpPassword := os.Getenv("AMQP_PASSWORD")
 	amqpHost := os.Getenv("AMQP_HOST")
 	amqpPort := os.Getenv("AMQP_PORT")
 	amqpVhost := os.Getenv("AMQP_VHOST")
 
-	amqpConnection, err := amqp.Dial(getServerConnectionURL(amqpUser, amqpPassword, amqpHost, amqpPort, amqpVhost))
-	if err != nil {
-		panic(err)
+	settings := messaging.AMQPConnectionSettings{
+		InitialBackoff:           loadPositiveDurationEnv("AMQP_RECONNECT_INITIAL_BACKOFF", defaultAMQPReconnectInitialBackoff, logger),
+		MaxBackoff:               loadPositiveDurationEnv("AMQP_RECONNECT_MAX_BACKOFF", defaultAMQPReconnectMaxBackoff, logger),
+		MaxChannelReopenAttempts: loadPositiveIntEnv("AMQP_CHANNEL_MAX_REOPEN_ATTEMPTS", defaultAMQPChannelMaxReopenAttempts, logger),
+	}
+	dialer := messaging.NewAMQPDialer(getServerConnectionURL(amqpUser, amqpPassword, amqpHost, amqpPort, amqpVhost))
+	connectionManager := messaging.NewAMQPConnectionManager(dialer, settings, logger)
+	connectionManager.Connect()
+	return connectionManager
+}
+
+func LoadEventListenerSettings(logger *zap.Logger) messaging.EventListenerSettings {
+	return messaging.EventListenerSettings{
+		MaxRetries: loadAMQPMaxRetries(logger),
+		RetryDelay: loadPositiveDurationEnv("AMQP_RETRY_DELAY", defaultAMQPRetryDelay, logger),
+		Prefetch:   loadPositiveIntEnv("AMQP_PREFETCH", defaultAMQPPrefetch, logger),
+		Workers:    loadPositiveIntEnv("AMQP_CONSUMER_WORKERS", defaultAMQPConsumerWorkers, logger),
+	}
+}
+
+func loadAMQPMaxRetries(logger *zap.Logger) int {
+	maxRetries := os.Getenv("AMQP_MAX_RETRIES")
+	if maxRetries == "" {
+		return defaultAMQPMaxRetries
+	}
+	retries, err := strconv.Atoi(maxRetries)
+	if err != nil || retries < 0 {
+		logger.Fatal(fmt.Sprintf("Invalid AMQP max retries %s", maxRetries))
 	}
-	return amqpConnection
+	return retries
 }
 
 func getServerConnectionURL(amqpUser string, amqpPassword string, amqpHost string, amqpPort string, amqpVhost string) string {
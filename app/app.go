@@ -1,21 +1,44 @@
 package app
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"go.uber.org/zap"
 )
 
+const shutdownTimeout = 30 * time.Second
+
 func Start() {
 	container := BuildDIContainer()
 	httpServer := BuildHTTPServer(&container)
 	RunEventConsumers(&container)
+	RunBackgroundTasks(&container)
 
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 	err := container.Invoke(func(logger *zap.Logger) {
 		serverHost := os.Getenv("HTTP_SERVER_HOST")
 		serverPort := os.Getenv("HTTP_SERVER_PORT")
-		handleError(httpServer.Start(fmt.Sprintf("%s:%s", serverHost, serverPort)), logger)
+		go func() {
+			if err := httpServer.Start(fmt.Sprintf("%s:%s", serverHost, serverPort)); err != nil && err != http.ErrServerClosed {
+				logger.Fatal(err.Error())
+			}
+		}()
+
+		<-ctx.Done()
+		logger.Info("Shutting down")
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := httpServer.Shutdown(shutdownCtx); err != nil {
+			logger.Warn(fmt.Sprintf("Error shutting down HTTP server: %s", err.Error()))
+		}
+		StopBackgroundTasks(&container, shutdownCtx)
 	})
 	if err != nil {
 		panic(err)
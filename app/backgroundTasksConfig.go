@@ -0,0 +1,75 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"go-as/src/application/purgeExpiredGrants"
+	"go-as/src/domain/events"
+	"go-as/src/infrastructure/logging"
+	"go-as/src/infrastructure/messaging"
+	"os"
+	"time"
+
+	"go.uber.org/dig"
+	"go.uber.org/zap"
+)
+
+const defaultExpiredGrantsSweepInterval = time.Minute
+
+func LoadExpiredGrantsSweepInterval() (time.Duration, error) {
+	sweepInterval := os.Getenv("EXPIRED_GRANTS_SWEEP_INTERVAL")
+	if sweepInterval == "" {
+		return defaultExpiredGrantsSweepInterval, nil
+	}
+	interval, err := time.ParseDuration(sweepInterval)
+	if err != nil || interval <= 0 {
+		return 0, fmt.Errorf("invalid expired grants sweep interval %s", sweepInterval)
+	}
+	return interval, nil
+}
+
+func RunBackgroundTasks(container *dig.Container) {
+	if err := container.Invoke(func(logger *zap.Logger) {
+		handleError(container.Invoke(func(sweeper *purgeExpiredGrants.ExpiredGrantsSweeper) {
+			sweeper.Run()
+		}), logger)
+		handleError(container.Invoke(func(decisionLog *logging.AsyncDecisionLog) {
+			decisionLog.Run()
+		}), logger)
+		handleError(container.Invoke(func(outboxRelay *messaging.OutboxRelay) {
+			outboxRelay.Run()
+		}), logger)
+		handleError(container.Invoke(func(sweeper *events.ProcessedEventSweeper) {
+			sweeper.Run()
+		}), logger)
+	}); err != nil {
+		panic(fmt.Sprintf("Error adding background tasks to the dependency container %s", err.Error()))
+	}
+}
+
+func StopBackgroundTasks(container *dig.Container, ctx context.Context) {
+	if err := container.Invoke(func(logger *zap.Logger) {
+		handleError(container.Invoke(func(sweeper *purgeExpiredGrants.ExpiredGrantsSweeper) {
+			if err := sweeper.Stop(ctx); err != nil {
+				logger.Warn(fmt.Sprintf("Error stopping expired grants sweeper: %s", err.Error()))
+			}
+		}), logger)
+		handleError(container.Invoke(func(decisionLog *logging.AsyncDecisionLog) {
+			if err := decisionLog.Close(ctx); err != nil {
+				logger.Warn(fmt.Sprintf("Error flushing decision log: %s", err.Error()))
+			}
+		}), logger)
+		handleError(container.Invoke(func(outboxRelay *messaging.OutboxRelay) {
+			if err := outboxRelay.Stop(ctx); err != nil {
+				logger.Warn(fmt.Sprintf("Error stopping outbox relay: %s", err.Error()))
+			}
+		}), logger)
+		handleError(container.Invoke(func(sweeper *events.ProcessedEventSweeper) {
+			if err := sweeper.Stop(ctx); err != nil {
+				logger.Warn(fmt.Sprintf("Error stopping processed event sweeper: %s", err.Error()))
+			}
+		}), logger)
+	}); err != nil {
+		panic(fmt.Sprintf("Error stopping background tasks from the dependency container %s", err.Error()))
+	}
+}
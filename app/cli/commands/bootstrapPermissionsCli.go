@@ -3,6 +3,7 @@ package commands
 import (
 	"context"
 	"go-as/src/application/createPermission"
+	"go-as/src/domain/audit"
 	"go-as/src/domain/permission"
 	"go-as/src/domain/role"
 	"go-as/src/domain/user"
@@ -11,12 +12,22 @@ import (
 	"go.uber.org/zap"
 )
 
-var permissions [5]string = [...]string{
+const permissionsService = "go-as"
+
+var permissions [13]string = [...]string{
 	permission.CreatePermissionPermission,
+	permission.ReadPermissionPermission,
+	permission.DeletePermissionPermission,
 	role.CreateRolePermission,
 	role.UpdateRolePermission,
 	role.DeleteRolePermission,
+	role.ReadRolePermission,
 	user.UpdateUserPermission,
+	user.CheckOtherUserPermissionsPermission,
+	user.ReadUserPermission,
+	user.CreateUserPermission,
+	user.DeleteUserPermission,
+	audit.ReadAuditPermission,
 }
 
 type BoostrapPermissionsCLI struct {
@@ -30,7 +41,8 @@ func (cli *BoostrapPermissionsCLI) Execute(_ *cli.Context) error {
 	ctx := context.Background()
 	for _, permission := range permissions {
 		useCaseRequest := createPermission.CreatePermissionRequest{
-			Name: permission,
+			Name:    permission,
+			Service: permissionsService,
 		}
 		response := cli.createPermissionUseCase.Execute(ctx, &useCaseRequest)
 		if response.Err != nil {
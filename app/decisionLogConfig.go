@@ -0,0 +1,118 @@
+package app
+
+import (
+	"fmt"
+	"go-as/src/domain/decision"
+	"go-as/src/infrastructure/database"
+	"go-as/src/infrastructure/files"
+	"go-as/src/infrastructure/logging"
+	"go-as/src/infrastructure/messaging"
+	"go-as/src/infrastructure/transformers"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/dig"
+	"go.uber.org/zap"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+const (
+	databaseDecisionSink = "database"
+	fileDecisionSink     = "file"
+	amqpDecisionSink     = "amqp"
+)
+
+const defaultDecisionLogSampleRate = 1.0
+const defaultDecisionLogBufferSize = 10000
+const defaultDecisionLogBatchSize = 100
+const defaultDecisionLogFlushInterval = time.Second
+const defaultDecisionLogAMQPExchange = "DecisionLog"
+
+func LoadDecisionLogSettings(logger *zap.Logger) logging.DecisionLogSettings {
+	redactedFields := loadDecisionLogRedactedFields(logger)
+	return logging.DecisionLogSettings{
+		SampleRate:     loadDecisionLogSampleRate(logger),
+		RedactedFields: redactedFields,
+		HashKey:        loadDecisionLogHashKey(redactedFields, logger),
+		BufferSize:     loadPositiveIntEnv("DECISION_LOG_BUFFER_SIZE", defaultDecisionLogBufferSize, logger),
+		BatchSize:      loadPositiveIntEnv("DECISION_LOG_BATCH_SIZE", defaultDecisionLogBatchSize, logger),
+		FlushInterval:  loadPositiveDurationEnv("DECISION_LOG_FLUSH_INTERVAL", defaultDecisionLogFlushInterval, logger),
+	}
+}
+
+func loadDecisionLogSampleRate(logger *zap.Logger) float64 {
+	sampleRate := os.Getenv("DECISION_LOG_SAMPLE_RATE")
+	if sampleRate == "" {
+		return defaultDecisionLogSampleRate
+	}
+	rate, err := strconv.ParseFloat(sampleRate, 64)
+	if err != nil || rate < 0 || rate > 1 {
+		logger.Fatal(fmt.Sprintf("Invalid decision log sample rate %s", sampleRate))
+	}
+	return rate
+}
+
+func loadDecisionLogRedactedFields(logger *zap.Logger) []string {
+	redactedFields := os.Getenv("DECISION_LOG_REDACTED_FIELDS")
+	if redactedFields == "" {
+		return nil
+	}
+	var fields []string
+	for _, field := range strings.Split(redactedFields, ",") {
+		field = strings.TrimSpace(field)
+		switch field {
+		case logging.SubjectDecisionField, logging.PermissionsDecisionField, logging.ReasonDecisionField:
+			fields = append(fields, field)
+		default:
+			logger.Fatal(fmt.Sprintf("Invalid decision log redacted field %s", field))
+		}
+	}
+	return fields
+}
+
+func loadDecisionLogHashKey(redactedFields []string, logger *zap.Logger) []byte {
+	hashKey := os.Getenv("DECISION_LOG_HASH_KEY")
+	for _, field := range redactedFields {
+		if hashKey == "" && (field == logging.SubjectDecisionField || field == logging.PermissionsDecisionField) {
+			logger.Fatal(fmt.Sprintf("DECISION_LOG_HASH_KEY is required to redact the decision log field %s", field))
+		}
+	}
+	return []byte(hashKey)
+}
+
+func addDecisionSink(container *dig.Container, logger *zap.Logger) {
+	sinkType := os.Getenv("DECISION_LOG_SINK")
+	switch sinkType {
+	case "", databaseDecisionSink:
+		handleError(container.Provide(database.NewDecisionDbSink, dig.As(new(decision.DecisionSink))), logger)
+	case fileDecisionSink:
+		handleError(container.Provide(func(decisionTransformer *transformers.DecisionToDTOTransformer) decision.DecisionSink {
+			fileWriter := &lumberjack.Logger{
+				Filename:   os.Getenv("DECISION_LOG_FILE_PATH"),
+				MaxSize:    500,
+				MaxBackups: 3,
+				MaxAge:     28,
+			}
+			return files.NewJSONLDecisionSink(fileWriter, decisionTransformer)
+		}), logger)
+	case amqpDecisionSink:
+		if transportType := loadEventTransport(); transportType != amqpEventTransport {
+			logger.Fatal(fmt.Sprintf("The amqp decision log sink requires the amqp event transport, EVENT_TRANSPORT is %s", transportType))
+		}
+		exchange := os.Getenv("DECISION_LOG_AMQP_EXCHANGE")
+		if exchange == "" {
+			exchange = defaultDecisionLogAMQPExchange
+		}
+		handleError(container.Provide(func(connectionManager *messaging.AMQPConnectionManager, decisionTransformer *transformers.DecisionToDTOTransformer, eventToMessageTransformer *transformers.EventToAMQPMessageTransformer) (decision.DecisionSink, error) {
+			amqpChannel, err := connectionManager.Channel()
+			if err != nil {
+				return nil, err
+			}
+			return messaging.NewAMQPDecisionSink(amqpChannel, exchange, decisionTransformer, eventToMessageTransformer)
+		}), logger)
+	default:
+		logger.Fatal(fmt.Sprintf("Invalid decision log sink %s", sinkType))
+	}
+}
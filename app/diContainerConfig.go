@@ -3,14 +3,42 @@ package app
 import (
 	"fmt"
 	"go-as/app/cli/commands"
+	"go-as/src/application/batchCheckUserPermissions"
+	"go-as/src/application/checkOtherUserHasPermissions"
 	"go-as/src/application/checkUserHasPermissions"
 	"go-as/src/application/createPermission"
 	"go-as/src/application/createRole"
 	"go-as/src/application/createUser"
+	"go-as/src/application/deletePermission"
+	"go-as/src/application/deleteRole"
+	"go-as/src/application/deleteUser"
 	"go-as/src/application/getApplicationHealth"
+	"go-as/src/application/getOtherUserEffectivePermissions"
+	"go-as/src/application/getPermission"
+	"go-as/src/application/getRole"
+	"go-as/src/application/getUser"
+	"go-as/src/application/getUserEffectivePermissions"
+	"go-as/src/application/grantRoleResourcePermission"
+	"go-as/src/application/grantUserPermission"
+	"go-as/src/application/grantUserResourcePermission"
+	"go-as/src/application/grantUserRole"
+	"go-as/src/application/listAuditEntries"
+	"go-as/src/application/listPermissions"
+	"go-as/src/application/listRoles"
+	"go-as/src/application/listUsers"
+	"go-as/src/application/purgeExpiredGrants"
+	"go-as/src/application/registerUser"
+	"go-as/src/application/revokeUserPermission"
+	"go-as/src/application/revokeUserRole"
+	"go-as/src/application/syncUser"
+	"go-as/src/application/updateRole"
+	"go-as/src/application/updateUserDeniedPermissions"
+	"go-as/src/application/updateUserDisabled"
 	"go-as/src/application/updateUserPermissions"
 	"go-as/src/application/updateUserRoles"
+	"go-as/src/domain/audit"
 	"go-as/src/domain/auth"
+	"go-as/src/domain/decision"
 	"go-as/src/domain/events"
 	"go-as/src/domain/healthcheck"
 	"go-as/src/domain/internals"
@@ -27,7 +55,6 @@ import (
 	"go-as/src/infrastructure/messaging"
 	"go-as/src/infrastructure/transformers"
 
-	"github.com/streadway/amqp"
 	"go.uber.org/dig"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
@@ -45,41 +72,105 @@ func BuildDIContainer() dig.Container {
 		handleError(container.Provide(logging.NewZapTracedLogger, dig.As(new(internals.Logger))), logger)
 		handleError(container.Provide(logging.NewZapGormTracedLogger), logger)
 		handleError(container.Provide(ConnectDatabase), logger)
-		handleError(container.Provide(ConnectToAMQPServer), logger)
+		handleError(container.Provide(LoadEventListenerSettings), logger)
 		handleError(container.Provide(LoadJWTSettings), logger)
 
 		handleError(container.Provide(database.NewPermissionDbRepository, dig.As(new(permission.PermissionRepository))), logger)
 		handleError(container.Provide(database.NewRoleDbRepository, dig.As(new(role.RoleRepository))), logger)
 		handleError(container.Provide(database.NewUserDbRepository, dig.As(new(user.UserRepository))), logger)
+		handleError(container.Provide(database.NewGrantDbRepository, dig.As(new(user.GrantRepository))), logger)
+		handleError(container.Provide(database.NewAuditDbRepository, dig.As(new(audit.AuditRepository))), logger)
+		handleError(container.Provide(database.NewOutboxDbRepository, dig.As(new(events.OutboxRepository))), logger)
+		handleError(container.Provide(database.NewGormTransactionManager, dig.As(new(internals.TransactionManager))), logger)
+		handleError(container.Provide(database.NewProcessedEventDbRepository, dig.As(new(events.ProcessedEventRepository))), logger)
+		handleError(container.Provide(database.NewEventPositionDbRepository, dig.As(new(events.EventPositionRepository))), logger)
 
 		handleError(container.Provide(jwt.NewJWTClaimsToAccessTokenTransformer), logger)
 		handleError(container.Provide(jwt.NewJWTAccessTokenDeserializer, dig.As(new(auth.AccessTokenDeserializer))), logger)
 
 		handleError(container.Provide(transformers.NewRoleToResponseTransformer), logger)
+		handleError(container.Provide(transformers.NewRolePageToResponseTransformer), logger)
 		handleError(container.Provide(transformers.NewPermissionToResponseTransformer), logger)
+		handleError(container.Provide(transformers.NewPermissionPageToResponseTransformer), logger)
+		handleError(container.Provide(transformers.NewUserToResponseTransformer), logger)
+		handleError(container.Provide(transformers.NewUserPageToResponseTransformer), logger)
+		handleError(container.Provide(transformers.NewAuditEntryPageToResponseTransformer), logger)
 		handleError(container.Provide(transformers.NewEventToAMQPMessageTransformer), logger)
-		handleError(container.Provide(transformers.NewAMQPDeliveryToMapTransformer), logger)
+		handleError(container.Provide(transformers.NewDecisionToDTOTransformer), logger)
+		handleError(container.Provide(transformers.NewEventMessageToEnvelopeTransformer), logger)
 		handleError(container.Provide(transformers.NewErrorToEchoErrorTransformer), logger)
-
-		handleError(container.Provide(func(amqpConnection *amqp.Connection, logger *zap.Logger) *amqp.Channel {
-			amqpChannel, err := amqpConnection.Channel()
-			if err != nil {
-				logger.Fatal("Error creating the AMQP channel")
-				return nil
-			}
-			return amqpChannel
+		handleError(container.Provide(transformers.NewGrantValidityDTOToDomainTransformer), logger)
+		handleError(container.Provide(transformers.NewPermissionDenialToResponseTransformer), logger)
+		handleError(container.Provide(transformers.NewPermissionExplanationToResponseTransformer), logger)
+		handleError(container.Provide(transformers.NewCheckPermissionsRequestTransformer), logger)
+		handleError(container.Provide(transformers.NewCheckPermissionsResponseTransformer), logger)
+		handleError(container.Provide(transformers.NewEffectivePermissionsResponseTransformer), logger)
+
+		addEventTransport(container, logger)
+		handleError(container.Provide(func(processedEventRepository events.ProcessedEventRepository, transactionManager internals.TransactionManager, logger *zap.Logger) *events.EventDeduplicator {
+			return events.NewEventDeduplicator(processedEventRepository, transactionManager, LoadEventIdempotencyKeys(logger), LoadEventIdempotencyTTL(logger))
+		}), logger)
+		handleError(container.Provide(func(eventDeduplicator *events.EventDeduplicator, tracedLogger internals.Logger, logger *zap.Logger) *events.ProcessedEventSweeper {
+			return events.NewProcessedEventSweeper(eventDeduplicator, LoadEventIdempotencySweepInterval(logger), tracedLogger)
+		}), logger)
+		handleError(container.Provide(events.NewEventOrdering), logger)
+		handleError(container.Provide(BuildEventRegistry), logger)
+		handleError(container.Provide(events.NewOutboxEventPublisher, dig.As(new(events.EventPublisher))), logger)
+		handleError(container.Provide(func(outboxEventSender messaging.OutboxEventSender, outboxRepository events.OutboxRepository, logger *zap.Logger) *messaging.OutboxRelay {
+			return messaging.NewOutboxRelay(outboxEventSender, outboxRepository, LoadOutboxRelaySettings(logger), logger)
 		}), logger)
-		handleError(container.Provide(messaging.NewAMQPExchangeManager), logger)
-		handleError(container.Provide(messaging.NewAMQPQueueEventListenerFactory, dig.As(new(events.EventListenerFactory))), logger)
 
+		addDecisionSink(container, logger)
+		handleError(container.Provide(func(sink decision.DecisionSink, logger *zap.Logger) *logging.AsyncDecisionLog {
+			return logging.NewAsyncDecisionLog(sink, LoadDecisionLogSettings(logger), logger)
+		}), logger)
+		handleError(container.Provide(func(decisionLog *logging.AsyncDecisionLog) decision.DecisionLog {
+			return decisionLog
+		}), logger)
+		handleError(container.Provide(audit.NewChangeRecorder), logger)
 		handleError(container.Provide(internals.NewAuthorizedUseCaseExecutor), logger)
 		handleError(container.Provide(createUser.NewCreateUserUseCase), logger)
 		handleError(container.Provide(createUser.NewUserCreatedEventConsumer), logger)
+		handleError(container.Provide(registerUser.NewRegisterUserUseCase), logger)
+		handleError(container.Provide(listUsers.NewListUsersUseCase), logger)
+		handleError(container.Provide(getUser.NewGetUserUseCase), logger)
+		handleError(container.Provide(deleteUser.NewDeleteUserUseCase), logger)
+		handleError(container.Provide(deleteUser.NewUserDeletedEventConsumer), logger)
+		handleError(container.Provide(syncUser.NewSyncUserUseCase), logger)
+		handleError(container.Provide(syncUser.NewUserUpdatedEventConsumer), logger)
+		handleError(container.Provide(updateUserDisabled.NewUpdateUserDisabledUseCase), logger)
 		handleError(container.Provide(createPermission.NewCreatePermissionUseCase), logger)
+		handleError(container.Provide(listPermissions.NewListPermissionsUseCase), logger)
+		handleError(container.Provide(getPermission.NewGetPermissionUseCase), logger)
+		handleError(container.Provide(deletePermission.NewDeletePermissionUseCase), logger)
 		handleError(container.Provide(createRole.NewCreateRoleUseCase), logger)
+		handleError(container.Provide(listRoles.NewListRolesUseCase), logger)
+		handleError(container.Provide(getRole.NewGetRoleUseCase), logger)
+		handleError(container.Provide(updateRole.NewUpdateRoleUseCase), logger)
+		handleError(container.Provide(deleteRole.NewDeleteRoleUseCase), logger)
 		handleError(container.Provide(checkUserHasPermissions.NewCheckUserHasPermissionUseCase), logger)
+		handleError(container.Provide(checkOtherUserHasPermissions.NewCheckOtherUserHasPermissionUseCase), logger)
+		handleError(container.Provide(batchCheckUserPermissions.NewBatchCheckUserPermissionsUseCase), logger)
+		handleError(container.Provide(getUserEffectivePermissions.NewGetUserEffectivePermissionsUseCase), logger)
+		handleError(container.Provide(getOtherUserEffectivePermissions.NewGetOtherUserEffectivePermissionsUseCase), logger)
 		handleError(container.Provide(updateUserPermissions.NewUpdateUserPermissionsUseCase), logger)
 		handleError(container.Provide(updateUserRoles.NewUpdateUserRolesUseCase), logger)
+		handleError(container.Provide(updateUserDeniedPermissions.NewUpdateUserDeniedPermissionsUseCase), logger)
+		handleError(container.Provide(grantUserPermission.NewGrantUserPermissionUseCase), logger)
+		handleError(container.Provide(revokeUserPermission.NewRevokeUserPermissionUseCase), logger)
+		handleError(container.Provide(grantUserRole.NewGrantUserRoleUseCase), logger)
+		handleError(container.Provide(revokeUserRole.NewRevokeUserRoleUseCase), logger)
+		handleError(container.Provide(grantUserResourcePermission.NewGrantUserResourcePermissionUseCase), logger)
+		handleError(container.Provide(grantRoleResourcePermission.NewGrantRoleResourcePermissionUseCase), logger)
+		handleError(container.Provide(listAuditEntries.NewListAuditEntriesUseCase), logger)
+		handleError(container.Provide(purgeExpiredGrants.NewPurgeExpiredGrantsUseCase), logger)
+		handleError(container.Provide(func(useCase *purgeExpiredGrants.PurgeExpiredGrantsUseCase, logger *zap.Logger) (*purgeExpiredGrants.ExpiredGrantsSweeper, error) {
+			interval, err := LoadExpiredGrantsSweepInterval()
+			if err != nil {
+				return nil, err
+			}
+			return purgeExpiredGrants.NewExpiredGrantsSweeper(useCase, interval, logger), nil
+		}), logger)
 
 		handleError(container.Provide(dto.NewEchoDTOSerializer), logger)
 		handleError(container.Provide(dto.NewEchoDTODeserializer), logger)
@@ -91,11 +182,37 @@ func BuildDIContainer() dig.Container {
 		addHealthCheckDependencies(container, logger)
 
 		handleError(container.Provide(api.NewHTTPAccessTokenFinder), logger)
+		handleError(container.Provide(api.NewHTTPVersionTagHandler), logger)
 		handleError(container.Provide(controllers.NewCreatePermissionController), logger)
+		handleError(container.Provide(controllers.NewListPermissionsController), logger)
+		handleError(container.Provide(controllers.NewGetPermissionController), logger)
+		handleError(container.Provide(controllers.NewDeletePermissionController), logger)
 		handleError(container.Provide(controllers.NewCreateRoleController), logger)
+		handleError(container.Provide(controllers.NewListRolesController), logger)
+		handleError(container.Provide(controllers.NewGetRoleController), logger)
+		handleError(container.Provide(controllers.NewUpdateRoleController), logger)
+		handleError(container.Provide(controllers.NewDeleteRoleController), logger)
 		handleError(container.Provide(controllers.NewCheckPermissionsController), logger)
+		handleError(container.Provide(controllers.NewExplainPermissionsController), logger)
+		handleError(container.Provide(controllers.NewCheckOtherUserPermissionsController), logger)
+		handleError(container.Provide(controllers.NewBatchCheckPermissionsController), logger)
+		handleError(container.Provide(controllers.NewGetUserEffectivePermissionsController), logger)
+		handleError(container.Provide(controllers.NewGetMyPermissionsController), logger)
+		handleError(container.Provide(controllers.NewRegisterUserController), logger)
+		handleError(container.Provide(controllers.NewListUsersController), logger)
+		handleError(container.Provide(controllers.NewGetUserController), logger)
+		handleError(container.Provide(controllers.NewDeleteUserController), logger)
+		handleError(container.Provide(controllers.NewUpdateUserDisabledController), logger)
 		handleError(container.Provide(controllers.NewUpdateUserPermissionsController), logger)
 		handleError(container.Provide(controllers.NewUpdateUserRolesController), logger)
+		handleError(container.Provide(controllers.NewUpdateUserDeniedPermissionsController), logger)
+		handleError(container.Provide(controllers.NewGrantUserPermissionController), logger)
+		handleError(container.Provide(controllers.NewRevokeUserPermissionController), logger)
+		handleError(container.Provide(controllers.NewGrantUserRoleController), logger)
+		handleError(container.Provide(controllers.NewRevokeUserRoleController), logger)
+		handleError(container.Provide(controllers.NewGrantUserResourcePermissionController), logger)
+		handleError(container.Provide(controllers.NewGrantRoleResourcePermissionController), logger)
+		handleError(container.Provide(controllers.NewListAuditEntriesController), logger)
 
 		handleError(container.Provide(commands.NewBoostrapPermissionsCLI), logger)
 	}); err != nil {
@@ -105,21 +222,17 @@ func BuildDIContainer() dig.Container {
 	return *container
 }
 
+type healthCheckersAggregator struct {
+	dig.Out
+	HealthChecker healthcheck.SingleHealthChecker `group:"healthcheckers"`
+}
+
 func addHealthCheckDependencies(diContainer *dig.Container, logger *zap.Logger) {
-	type healthCheckersAggregator struct {
-		dig.Out
-		HealthChecker healthcheck.SingleHealthChecker `group:"healthcheckers"`
-	}
 	handleError(diContainer.Provide(func(db *gorm.DB) healthCheckersAggregator {
 		return healthCheckersAggregator{
 			HealthChecker: database.NewDatabaseHealthChecker(db),
 		}
 	}), logger)
-	handleError(diContainer.Provide(func(amqpConnection *amqp.Connection) healthCheckersAggregator {
-		return healthCheckersAggregator{
-			HealthChecker: messaging.NewAMQPHealthChecker(amqpConnection),
-		}
-	}), logger)
 
 	type healthCheckersGroup struct {
 		dig.In
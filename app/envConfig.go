@@ -0,0 +1,34 @@
+package app
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+func loadPositiveIntEnv(variable string, defaultValue int, logger *zap.Logger) int {
+	rawValue := os.Getenv(variable)
+	if rawValue == "" {
+		return defaultValue
+	}
+	value, err := strconv.Atoi(rawValue)
+	if err != nil || value <= 0 {
+		logger.Fatal(fmt.Sprintf("Invalid %s %s", variable, rawValue))
+	}
+	return value
+}
+
+func loadPositiveDurationEnv(variable string, defaultValue time.Duration, logger *zap.Logger) time.Duration {
+	rawValue := os.Getenv(variable)
+	if rawValue == "" {
+		return defaultValue
+	}
+	duration, err := time.ParseDuration(rawValue)
+	if err != nil || duration <= 0 {
+		logger.Fatal(fmt.Sprintf("Invalid %s %s", variable, rawValue))
+	}
+	return duration
+}
@@ -3,6 +3,8 @@ package app
 import (
 	"fmt"
 	"go-as/src/application/createUser"
+	"go-as/src/application/deleteUser"
+	"go-as/src/application/syncUser"
 
 	"go.uber.org/dig"
 	"go.uber.org/zap"
@@ -15,6 +17,16 @@ func RunEventConsumers(container *dig.Container) {
 				logger.Fatal(fmt.Sprintf("Error running UserCreatedEventConsumer: %s", err.Error()))
 			}
 		}), logger)
+		handleError(container.Invoke(func(consumer *deleteUser.UserDeletedEventConsumer) {
+			if err := consumer.Consume(); err != nil {
+				logger.Fatal(fmt.Sprintf("Error running UserDeletedEventConsumer: %s", err.Error()))
+			}
+		}), logger)
+		handleError(container.Invoke(func(consumer *syncUser.UserUpdatedEventConsumer) {
+			if err := consumer.Consume(); err != nil {
+				logger.Fatal(fmt.Sprintf("Error running UserUpdatedEventConsumer: %s", err.Error()))
+			}
+		}), logger)
 	}); err != nil {
 		panic(fmt.Sprintf("Error adding event consumers to the dependency container %s", err.Error()))
 	}
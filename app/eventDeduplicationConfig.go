@@ -0,0 +1,38 @@
+package app
+
+import (
+	"fmt"
+	"go-as/src/domain/events"
+	"os"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const defaultEventIdempotencyTTL = 7 * 24 * time.Hour
+const defaultEventIdempotencySweepInterval = time.Hour
+
+func LoadEventIdempotencyKeys(logger *zap.Logger) map[string]events.IdempotencyKey {
+	keyFields := os.Getenv("EVENT_IDEMPOTENCY_KEY_FIELDS")
+	idempotencyKeys := make(map[string]events.IdempotencyKey)
+	if keyFields == "" {
+		return idempotencyKeys
+	}
+	for _, eventKeyField := range strings.Split(keyFields, ",") {
+		eventName, keyField, found := strings.Cut(strings.TrimSpace(eventKeyField), "=")
+		if !found || eventName == "" || keyField == "" {
+			logger.Fatal(fmt.Sprintf("Invalid event idempotency key field %s", eventKeyField))
+		}
+		idempotencyKeys[eventName] = events.FieldIdempotencyKey(keyField)
+	}
+	return idempotencyKeys
+}
+
+func LoadEventIdempotencyTTL(logger *zap.Logger) time.Duration {
+	return loadPositiveDurationEnv("EVENT_IDEMPOTENCY_TTL", defaultEventIdempotencyTTL, logger)
+}
+
+func LoadEventIdempotencySweepInterval(logger *zap.Logger) time.Duration {
+	return loadPositiveDurationEnv("EVENT_IDEMPOTENCY_SWEEP_INTERVAL", defaultEventIdempotencySweepInterval, logger)
+}
@@ -0,0 +1,18 @@
+package app
+
+import (
+	"go-as/src/domain/events"
+	"go-as/src/domain/user"
+	"go-as/src/infrastructure/dto"
+)
+
+func BuildEventRegistry(validator *dto.DTOValidator) (*events.EventRegistry, error) {
+	eventRegistry := events.NewEventRegistry(validator)
+	eventRegistry.Register(user.UserCreatedEvent{}, 1)
+	eventRegistry.Register(user.UserDeletedEvent{}, 1)
+	eventRegistry.Register(user.UserUpdatedEvent{}, user.UserUpdatedEventVersion)
+	if err := eventRegistry.RegisterUpcaster(user.UserUpdatedEvent{}, 1, user.UpcastUserUpdatedEventV1); err != nil {
+		return nil, err
+	}
+	return eventRegistry, nil
+}
@@ -0,0 +1,82 @@
+package app
+
+import (
+	"fmt"
+	"go-as/src/domain/events"
+	"go-as/src/infrastructure/messaging"
+	"go-as/src/infrastructure/transformers"
+	"os"
+
+	"github.com/nats-io/nats.go"
+	"go.uber.org/dig"
+	"go.uber.org/zap"
+)
+
+const (
+	amqpEventTransport     = "amqp"
+	inMemoryEventTransport = "memory"
+	natsEventTransport     = "nats"
+)
+
+const defaultInMemoryEventBufferSize = 1000
+
+func loadEventTransport() string {
+	transportType := os.Getenv("EVENT_TRANSPORT")
+	if transportType == "" {
+		return amqpEventTransport
+	}
+	return transportType
+}
+
+func addEventTransport(container *dig.Container, logger *zap.Logger) {
+	transportType := loadEventTransport()
+	switch transportType {
+	case amqpEventTransport:
+		handleError(container.Provide(ConnectToAMQPServer), logger)
+		handleError(container.Provide(func(connectionManager *messaging.AMQPConnectionManager, logger *zap.Logger) *messaging.AMQPManagedChannel {
+			amqpChannel, err := connectionManager.Channel()
+			if err != nil {
+				logger.Fatal("Error creating the AMQP channel")
+				return nil
+			}
+			return amqpChannel
+		}), logger)
+		handleError(container.Provide(messaging.NewAMQPExchangeManager), logger)
+		handleError(container.Provide(messaging.NewAMQPQueueEventListenerFactory, dig.As(new(events.EventListenerFactory))), logger)
+		handleError(container.Provide(func(connectionManager *messaging.AMQPConnectionManager, eventToMessageTransformer *transformers.EventToAMQPMessageTransformer, logger *zap.Logger) (messaging.OutboxEventSender, error) {
+			amqpChannel, err := connectionManager.Channel()
+			if err != nil {
+				return nil, err
+			}
+			return messaging.NewAMQPOutboxSender(amqpChannel, eventToMessageTransformer, LoadOutboxRelaySettings(logger))
+		}), logger)
+		handleError(container.Provide(func(connectionManager *messaging.AMQPConnectionManager) healthCheckersAggregator {
+			return healthCheckersAggregator{
+				HealthChecker: messaging.NewAMQPHealthChecker(connectionManager),
+			}
+		}), logger)
+	case inMemoryEventTransport:
+		bufferSize := loadPositiveIntEnv("IN_MEMORY_EVENT_BUFFER_SIZE", defaultInMemoryEventBufferSize, logger)
+		handleError(container.Provide(func() *messaging.InMemoryEventBus {
+			return messaging.NewInMemoryEventBus(bufferSize)
+		}), logger)
+		handleError(container.Provide(messaging.NewInMemoryEventListenerFactory, dig.As(new(events.EventListenerFactory))), logger)
+		handleError(container.Provide(messaging.NewInMemoryOutboxSender, dig.As(new(messaging.OutboxEventSender))), logger)
+	case natsEventTransport:
+		handleError(container.Provide(ConnectToNATSServer), logger)
+		handleError(container.Provide(func(connection *nats.Conn) (*messaging.NATSJetStream, error) {
+			return messaging.NewNATSJetStream(connection, LoadNATSStreamSettings())
+		}), logger)
+		handleError(container.Provide(messaging.NewNATSEventListenerFactory, dig.As(new(events.EventListenerFactory))), logger)
+		handleError(container.Provide(func(natsJetStream *messaging.NATSJetStream, logger *zap.Logger) messaging.OutboxEventSender {
+			return messaging.NewNATSOutboxSender(natsJetStream, LoadOutboxRelaySettings(logger))
+		}), logger)
+		handleError(container.Provide(func(connection *nats.Conn) healthCheckersAggregator {
+			return healthCheckersAggregator{
+				HealthChecker: messaging.NewNATSHealthChecker(connection),
+			}
+		}), logger)
+	default:
+		logger.Fatal(fmt.Sprintf("Invalid event transport %s", transportType))
+	}
+}
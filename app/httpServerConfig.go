@@ -30,21 +30,96 @@ func BuildHTTPServer(container *dig.Container) *echo.Echo {
 		handleError(container.Invoke(func(controller *controllers.CreateRoleController) {
 			server.POST("/roles", controller.Handle)
 		}), logger)
+		handleError(container.Invoke(func(controller *controllers.ListRolesController) {
+			server.GET("/roles", controller.Handle)
+		}), logger)
+		handleError(container.Invoke(func(controller *controllers.GetRoleController) {
+			server.GET("/roles/:name", controller.Handle)
+		}), logger)
+		handleError(container.Invoke(func(controller *controllers.UpdateRoleController) {
+			server.PUT("/roles/:name", controller.Handle)
+		}), logger)
+		handleError(container.Invoke(func(controller *controllers.DeleteRoleController) {
+			server.DELETE("/roles/:name", controller.Handle)
+		}), logger)
 		handleError(container.Invoke(func(controller *controllers.CreatePermissionController) {
 			server.POST("/permissions", controller.Handle)
 		}), logger)
+		handleError(container.Invoke(func(controller *controllers.ListPermissionsController) {
+			server.GET("/permissions", controller.Handle)
+		}), logger)
+		handleError(container.Invoke(func(controller *controllers.GetPermissionController) {
+			server.GET("/permissions/:name", controller.Handle)
+		}), logger)
+		handleError(container.Invoke(func(controller *controllers.DeletePermissionController) {
+			server.DELETE("/permissions/:name", controller.Handle)
+		}), logger)
 		handleError(container.Invoke(func(controller *controllers.GetStatusController) {
 			server.GET("/status", controller.Handle)
 		}), logger)
 		handleError(container.Invoke(func(controller *controllers.CheckPermissionsController) {
 			server.POST("/permissions/check", controller.Handle)
 		}), logger)
+		handleError(container.Invoke(func(controller *controllers.ExplainPermissionsController) {
+			server.POST("/permissions/explain", controller.Handle)
+		}), logger)
+		handleError(container.Invoke(func(controller *controllers.BatchCheckPermissionsController) {
+			server.POST("/permissions/check/batch", controller.Handle)
+		}), logger)
+		handleError(container.Invoke(func(controller *controllers.RegisterUserController) {
+			server.POST("/users", controller.Handle)
+		}), logger)
+		handleError(container.Invoke(func(controller *controllers.ListUsersController) {
+			server.GET("/users", controller.Handle)
+		}), logger)
+		handleError(container.Invoke(func(controller *controllers.GetUserController) {
+			server.GET("/user/:email", controller.Handle)
+		}), logger)
+		handleError(container.Invoke(func(controller *controllers.DeleteUserController) {
+			server.DELETE("/user/:email", controller.Handle)
+		}), logger)
+		handleError(container.Invoke(func(controller *controllers.UpdateUserDisabledController) {
+			server.PUT("/user/:email/disabled", controller.Handle)
+		}), logger)
 		handleError(container.Invoke(func(controller *controllers.UpdateUserPermissionsController) {
 			server.PUT("/user/:email/permissions", controller.Handle)
 		}), logger)
+		handleError(container.Invoke(func(controller *controllers.CheckOtherUserPermissionsController) {
+			server.POST("/user/:email/permissions/check", controller.Handle)
+		}), logger)
+		handleError(container.Invoke(func(controller *controllers.GetUserEffectivePermissionsController) {
+			server.GET("/user/:email/permissions/effective", controller.Handle)
+		}), logger)
+		handleError(container.Invoke(func(controller *controllers.GetMyPermissionsController) {
+			server.GET("/me/permissions", controller.Handle)
+		}), logger)
 		handleError(container.Invoke(func(controller *controllers.UpdateUserRolesController) {
 			server.PUT("/user/:email/roles", controller.Handle)
 		}), logger)
+		handleError(container.Invoke(func(controller *controllers.GrantUserPermissionController) {
+			server.POST("/user/:email/permissions/:name", controller.Handle)
+		}), logger)
+		handleError(container.Invoke(func(controller *controllers.RevokeUserPermissionController) {
+			server.DELETE("/user/:email/permissions/:name", controller.Handle)
+		}), logger)
+		handleError(container.Invoke(func(controller *controllers.GrantUserRoleController) {
+			server.POST("/user/:email/roles/:name", controller.Handle)
+		}), logger)
+		handleError(container.Invoke(func(controller *controllers.RevokeUserRoleController) {
+			server.DELETE("/user/:email/roles/:name", controller.Handle)
+		}), logger)
+		handleError(container.Invoke(func(controller *controllers.UpdateUserDeniedPermissionsController) {
+			server.PUT("/user/:email/denied-permissions", controller.Handle)
+		}), logger)
+		handleError(container.Invoke(func(controller *controllers.GrantUserResourcePermissionController) {
+			server.POST("/user/:email/resource-permissions", controller.Handle)
+		}), logger)
+		handleError(container.Invoke(func(controller *controllers.GrantRoleResourcePermissionController) {
+			server.POST("/roles/:name/resource-permissions", controller.Handle)
+		}), logger)
+		handleError(container.Invoke(func(controller *controllers.ListAuditEntriesController) {
+			server.GET("/audit", controller.Handle)
+		}), logger)
 	}); err != nil {
 		panic("Error adding HTTP API components to the dependency injection container")
 	}
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE role_parent (
+    role_name TEXT NOT NULL,
+    parent_name TEXT NOT NULL,
+    FOREIGN KEY (role_name) REFERENCES roles(name),
+    FOREIGN KEY (parent_name) REFERENCES roles(name),
+    UNIQUE (role_name, parent_name),
+    CHECK (role_name <> parent_name)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE role_parent;
+-- +goose StatementEnd
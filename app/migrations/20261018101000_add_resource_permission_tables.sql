@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE user_resource_permission (
+    user_email VARCHAR(36) NOT NULL,
+    permission_name TEXT NOT NULL,
+    resource_type TEXT NOT NULL,
+    resource_id TEXT NOT NULL DEFAULT '*',
+    FOREIGN KEY (user_email) REFERENCES users(email),
+    FOREIGN KEY (permission_name) REFERENCES permissions(name),
+    PRIMARY KEY (user_email, permission_name, resource_type, resource_id)
+);
+CREATE TABLE role_resource_permission (
+    role_name TEXT NOT NULL,
+    permission_name TEXT NOT NULL,
+    resource_type TEXT NOT NULL,
+    resource_id TEXT NOT NULL DEFAULT '*',
+    FOREIGN KEY (role_name) REFERENCES roles(name),
+    FOREIGN KEY (permission_name) REFERENCES permissions(name),
+    PRIMARY KEY (role_name, permission_name, resource_type, resource_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE role_resource_permission;
+DROP TABLE user_resource_permission;
+-- +goose StatementEnd
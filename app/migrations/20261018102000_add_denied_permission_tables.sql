@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE user_denied_permission (
+    user_email VARCHAR(36) NOT NULL,
+    permission_name TEXT NOT NULL,
+    FOREIGN KEY (user_email) REFERENCES users(email),
+    FOREIGN KEY (permission_name) REFERENCES permissions(name),
+    UNIQUE (user_email, permission_name)
+);
+CREATE TABLE role_denied_permission (
+    role_name TEXT NOT NULL,
+    permission_name TEXT NOT NULL,
+    FOREIGN KEY (role_name) REFERENCES roles(name),
+    FOREIGN KEY (permission_name) REFERENCES permissions(name),
+    UNIQUE (role_name, permission_name)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE user_denied_permission;
+DROP TABLE role_denied_permission;
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE user_permission
+    ADD COLUMN valid_from TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN valid_until TIMESTAMP WITH TIME ZONE,
+    ADD CHECK (valid_from IS NULL OR valid_until IS NULL OR valid_from < valid_until);
+ALTER TABLE user_role
+    ADD COLUMN valid_from TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN valid_until TIMESTAMP WITH TIME ZONE,
+    ADD CHECK (valid_from IS NULL OR valid_until IS NULL OR valid_from < valid_until);
+CREATE INDEX user_permission_valid_until_idx ON user_permission (valid_until) WHERE valid_until IS NOT NULL;
+CREATE INDEX user_role_valid_until_idx ON user_role (valid_until) WHERE valid_until IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX user_role_valid_until_idx;
+DROP INDEX user_permission_valid_until_idx;
+ALTER TABLE user_role
+    DROP COLUMN valid_from,
+    DROP COLUMN valid_until;
+ALTER TABLE user_permission
+    DROP COLUMN valid_from,
+    DROP COLUMN valid_until;
+-- +goose StatementEnd
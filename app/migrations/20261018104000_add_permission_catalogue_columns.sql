@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE permissions
+    ADD COLUMN description TEXT NOT NULL DEFAULT '',
+    ADD COLUMN service TEXT NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE permissions
+    DROP COLUMN description,
+    DROP COLUMN service;
+-- +goose StatementEnd
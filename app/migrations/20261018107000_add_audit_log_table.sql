@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE audit_log (
+    id BIGSERIAL PRIMARY KEY,
+    actor TEXT NOT NULL,
+    action TEXT NOT NULL,
+    target_type TEXT NOT NULL,
+    target TEXT NOT NULL,
+    before TEXT NOT NULL,
+    after TEXT NOT NULL,
+    trace_id TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX audit_log_actor_idx ON audit_log (actor, created_at);
+CREATE INDEX audit_log_target_idx ON audit_log (target_type, target, created_at);
+CREATE INDEX audit_log_created_at_idx ON audit_log (created_at);
+CREATE FUNCTION reject_audit_log_modification() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_log rows are immutable';
+END;
+$$ LANGUAGE plpgsql;
+CREATE TRIGGER audit_log_immutable
+    BEFORE UPDATE OR DELETE ON audit_log
+    FOR EACH ROW EXECUTE FUNCTION reject_audit_log_modification();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER audit_log_immutable ON audit_log;
+DROP FUNCTION reject_audit_log_modification();
+DROP TABLE audit_log;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE decision_log (
+    id BIGSERIAL PRIMARY KEY,
+    subject TEXT NOT NULL,
+    permissions TEXT NOT NULL,
+    allowed BOOLEAN NOT NULL,
+    reason TEXT NOT NULL,
+    source TEXT NOT NULL,
+    trace_id TEXT NOT NULL DEFAULT '',
+    decided_at TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX decision_log_subject_idx ON decision_log (subject, decided_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE decision_log;
+-- +goose StatementEnd
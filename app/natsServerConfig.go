@@ -0,0 +1,56 @@
+package app
+
+import (
+	"fmt"
+	"go-as/src/infrastructure/messaging"
+	"os"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"go.uber.org/zap"
+)
+
+const defaultNATSStream = "EVENTS"
+const defaultNATSSubjectPrefix = "events"
+const defaultNATSReconnectWait = 2 * time.Second
+
+func ConnectToNATSServer(logger *zap.Logger) *nats.Conn {
+	natsURL := os.Getenv("NATS_URL")
+	if natsURL == "" {
+		natsURL = nats.DefaultURL
+	}
+	connection, err := nats.Connect(
+		natsURL,
+		nats.Name("go-as"),
+		nats.MaxReconnects(-1),
+		nats.RetryOnFailedConnect(true),
+		nats.ReconnectWait(loadPositiveDurationEnv("NATS_RECONNECT_WAIT", defaultNATSReconnectWait, logger)),
+		nats.DisconnectErrHandler(func(_ *nats.Conn, err error) {
+			if err != nil {
+				logger.Warn(fmt.Sprintf("NATS connection lost: %s", err.Error()))
+			}
+		}),
+		nats.ReconnectHandler(func(_ *nats.Conn) {
+			logger.Info("NATS connection reestablished")
+		}),
+	)
+	if err != nil {
+		logger.Fatal(fmt.Sprintf("Error connecting to NATS server: %s", err.Error()))
+	}
+	return connection
+}
+
+func LoadNATSStreamSettings() messaging.NATSStreamSettings {
+	stream := os.Getenv("NATS_STREAM")
+	if stream == "" {
+		stream = defaultNATSStream
+	}
+	subjectPrefix := os.Getenv("NATS_SUBJECT_PREFIX")
+	if subjectPrefix == "" {
+		subjectPrefix = defaultNATSSubjectPrefix
+	}
+	return messaging.NATSStreamSettings{
+		Stream:        stream,
+		SubjectPrefix: subjectPrefix,
+	}
+}
@@ -0,0 +1,32 @@
+package app
+
+import (
+	"go-as/src/infrastructure/messaging"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const defaultOutboxPollInterval = time.Second
+const defaultOutboxBatchSize = 100
+const defaultOutboxRetryBaseDelay = time.Second
+const defaultOutboxRetryMaxDelay = 5 * time.Minute
+const defaultOutboxMaxAttempts = 20
+const defaultOutboxLeaseDuration = time.Minute
+const defaultOutboxConfirmTimeout = 5 * time.Second
+const defaultOutboxRetention = 7 * 24 * time.Hour
+const defaultOutboxRetentionSweepInterval = time.Hour
+
+func LoadOutboxRelaySettings(logger *zap.Logger) messaging.OutboxRelaySettings {
+	return messaging.OutboxRelaySettings{
+		PollInterval:           loadPositiveDurationEnv("OUTBOX_POLL_INTERVAL", defaultOutboxPollInterval, logger),
+		BatchSize:              loadPositiveIntEnv("OUTBOX_BATCH_SIZE", defaultOutboxBatchSize, logger),
+		RetryBaseDelay:         loadPositiveDurationEnv("OUTBOX_RETRY_BASE_DELAY", defaultOutboxRetryBaseDelay, logger),
+		RetryMaxDelay:          loadPositiveDurationEnv("OUTBOX_RETRY_MAX_DELAY", defaultOutboxRetryMaxDelay, logger),
+		MaxAttempts:            loadPositiveIntEnv("OUTBOX_MAX_ATTEMPTS", defaultOutboxMaxAttempts, logger),
+		LeaseDuration:          loadPositiveDurationEnv("OUTBOX_LEASE_DURATION", defaultOutboxLeaseDuration, logger),
+		ConfirmTimeout:         loadPositiveDurationEnv("OUTBOX_CONFIRM_TIMEOUT", defaultOutboxConfirmTimeout, logger),
+		Retention:              loadPositiveDurationEnv("OUTBOX_RETENTION", defaultOutboxRetention, logger),
+		RetentionSweepInterval: loadPositiveDurationEnv("OUTBOX_RETENTION_SWEEP_INTERVAL", defaultOutboxRetentionSweepInterval, logger),
+	}
+}
@@ -4,6 +4,7 @@ package mocks
 
 import (
 	context "context"
+	pagination "go-as/src/domain/pagination"
 	permission "go-as/src/domain/permission"
 
 	mock "github.com/stretchr/testify/mock"
@@ -14,6 +15,41 @@ type PermissionRepository struct {
 	mock.Mock
 }
 
+// CountReferences provides a mock function with given fields: ctx, permissionName
+func (_m *PermissionRepository) CountReferences(ctx context.Context, permissionName string) (int64, error) {
+	ret := _m.Called(ctx, permissionName)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = rf(ctx, permissionName)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, permissionName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: ctx, permissionName
+func (_m *PermissionRepository) Delete(ctx context.Context, permissionName string) error {
+	ret := _m.Called(ctx, permissionName)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, permissionName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // FindByNames provides a mock function with given fields: ctx, permissionNames
 func (_m *PermissionRepository) FindByNames(ctx context.Context, permissionNames []string) ([]permission.Permission, error) {
 	ret := _m.Called(ctx, permissionNames)
@@ -37,6 +73,29 @@ func (_m *PermissionRepository) FindByNames(ctx context.Context, permissionNames
 	return r0, r1
 }
 
+// FindPageByPrefix provides a mock function with given fields: ctx, prefix, pageRequest
+func (_m *PermissionRepository) FindPageByPrefix(ctx context.Context, prefix string, pageRequest pagination.PageRequest) (*pagination.Page[permission.Permission], error) {
+	ret := _m.Called(ctx, prefix, pageRequest)
+
+	var r0 *pagination.Page[permission.Permission]
+	if rf, ok := ret.Get(0).(func(context.Context, string, pagination.PageRequest) *pagination.Page[permission.Permission]); ok {
+		r0 = rf(ctx, prefix, pageRequest)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pagination.Page[permission.Permission])
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, pagination.PageRequest) error); ok {
+		r1 = rf(ctx, prefix, pageRequest)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Save provides a mock function with given fields: ctx, _a1
 func (_m *PermissionRepository) Save(ctx context.Context, _a1 permission.Permission) error {
 	ret := _m.Called(ctx, _a1)
@@ -4,6 +4,7 @@ package mocks
 
 import (
 	context "context"
+	pagination "go-as/src/domain/pagination"
 	role "go-as/src/domain/role"
 
 	mock "github.com/stretchr/testify/mock"
@@ -14,6 +15,20 @@ type RoleRepository struct {
 	mock.Mock
 }
 
+// Delete provides a mock function with given fields: ctx, roleName
+func (_m *RoleRepository) Delete(ctx context.Context, roleName string) error {
+	ret := _m.Called(ctx, roleName)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, roleName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // FindByIDs provides a mock function with given fields: ctx, roleNames
 func (_m *RoleRepository) FindByNames(ctx context.Context, roleNames []string) ([]role.Role, error) {
 	ret := _m.Called(ctx, roleNames)
@@ -37,6 +52,29 @@ func (_m *RoleRepository) FindByNames(ctx context.Context, roleNames []string) (
 	return r0, r1
 }
 
+// FindPage provides a mock function with given fields: ctx, pageRequest
+func (_m *RoleRepository) FindPage(ctx context.Context, pageRequest pagination.PageRequest) (*pagination.Page[role.Role], error) {
+	ret := _m.Called(ctx, pageRequest)
+
+	var r0 *pagination.Page[role.Role]
+	if rf, ok := ret.Get(0).(func(context.Context, pagination.PageRequest) *pagination.Page[role.Role]); ok {
+		r0 = rf(ctx, pageRequest)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pagination.Page[role.Role])
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, pagination.PageRequest) error); ok {
+		r1 = rf(ctx, pageRequest)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Save provides a mock function with given fields: ctx, _a1
 func (_m *RoleRepository) Save(ctx context.Context, _a1 role.Role) error {
 	ret := _m.Called(ctx, _a1)
@@ -51,6 +89,20 @@ func (_m *RoleRepository) Save(ctx context.Context, _a1 role.Role) error {
 	return r0
 }
 
+// UpdatePermissions provides a mock function with given fields: ctx, _a1
+func (_m *RoleRepository) UpdatePermissions(ctx context.Context, _a1 role.Role) error {
+	ret := _m.Called(ctx, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, role.Role) error); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewRoleRepository interface {
 	mock.TestingT
 	Cleanup(func())
@@ -4,6 +4,7 @@ package mocks
 
 import (
 	context "context"
+	pagination "go-as/src/domain/pagination"
 	user "go-as/src/domain/user"
 
 	mock "github.com/stretchr/testify/mock"
@@ -15,6 +16,62 @@ type UserRepository struct {
 	mock.Mock
 }
 
+// ChangeEmail provides a mock function with given fields: ctx, email, newEmail
+func (_m *UserRepository) ChangeEmail(ctx context.Context, email string, newEmail string) error {
+	ret := _m.Called(ctx, email, newEmail)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, email, newEmail)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Delete provides a mock function with given fields: ctx, email
+func (_m *UserRepository) Delete(ctx context.Context, email string) error {
+	ret := _m.Called(ctx, email)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, email)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeletePermissionGrant provides a mock function with given fields: ctx, email, permissionName, version
+func (_m *UserRepository) DeletePermissionGrant(ctx context.Context, email string, permissionName string, version int64) error {
+	ret := _m.Called(ctx, email, permissionName, version)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64) error); ok {
+		r0 = rf(ctx, email, permissionName, version)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteRoleGrant provides a mock function with given fields: ctx, email, roleName, version
+func (_m *UserRepository) DeleteRoleGrant(ctx context.Context, email string, roleName string, version int64) error {
+	ret := _m.Called(ctx, email, roleName, version)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64) error); ok {
+		r0 = rf(ctx, email, roleName, version)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // FindByEmail provides a mock function with given fields: ctx, email
 func (_m *UserRepository) FindByEmail(ctx context.Context, email string) (*user.User, error) {
 	ret := _m.Called(ctx, email)
@@ -38,6 +95,52 @@ func (_m *UserRepository) FindByEmail(ctx context.Context, email string) (*user.
 	return r0, r1
 }
 
+// FindByEmails provides a mock function with given fields: ctx, emails
+func (_m *UserRepository) FindByEmails(ctx context.Context, emails []string) ([]user.User, error) {
+	ret := _m.Called(ctx, emails)
+
+	var r0 []user.User
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []user.User); ok {
+		r0 = rf(ctx, emails)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]user.User)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, emails)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindPage provides a mock function with given fields: ctx, filter, pageRequest
+func (_m *UserRepository) FindPage(ctx context.Context, filter user.UserFilter, pageRequest pagination.PageRequest) (*pagination.Page[user.User], error) {
+	ret := _m.Called(ctx, filter, pageRequest)
+
+	var r0 *pagination.Page[user.User]
+	if rf, ok := ret.Get(0).(func(context.Context, user.UserFilter, pagination.PageRequest) *pagination.Page[user.User]); ok {
+		r0 = rf(ctx, filter, pageRequest)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pagination.Page[user.User])
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, user.UserFilter, pagination.PageRequest) error); ok {
+		r1 = rf(ctx, filter, pageRequest)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Save provides a mock function with given fields: ctx, _a1
 func (_m *UserRepository) Save(ctx context.Context, _a1 user.User) error {
 	ret := _m.Called(ctx, _a1)
@@ -52,6 +155,34 @@ func (_m *UserRepository) Save(ctx context.Context, _a1 user.User) error {
 	return r0
 }
 
+// SavePermissionGrant provides a mock function with given fields: ctx, grant, version
+func (_m *UserRepository) SavePermissionGrant(ctx context.Context, grant user.UserPermission, version int64) error {
+	ret := _m.Called(ctx, grant, version)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, user.UserPermission, int64) error); ok {
+		r0 = rf(ctx, grant, version)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SaveRoleGrant provides a mock function with given fields: ctx, grant, version
+func (_m *UserRepository) SaveRoleGrant(ctx context.Context, grant user.UserRole, version int64) error {
+	ret := _m.Called(ctx, grant, version)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, user.UserRole, int64) error); ok {
+		r0 = rf(ctx, grant, version)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewUserRepository interface {
 	mock.TestingT
 	Cleanup(func())
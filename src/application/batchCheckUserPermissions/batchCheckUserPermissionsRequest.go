@@ -0,0 +1,9 @@
+package batchCheckUserPermissions
+
+import "go-as/src/domain/permission"
+
+type BatchCheckUserPermissionsRequest struct {
+	UserEmails      []string
+	PermissionNames []string
+	Resource        *permission.Resource
+}
@@ -0,0 +1,6 @@
+package batchCheckUserPermissions
+
+type BatchCheckUserPermissionsResponse struct {
+	Decisions    map[string]map[string]bool
+	MissingUsers []string
+}
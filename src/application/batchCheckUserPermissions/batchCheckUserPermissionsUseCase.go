@@ -0,0 +1,145 @@
+package batchCheckUserPermissions
+
+import (
+	"context"
+	"fmt"
+	"go-as/src/domain/decision"
+	"go-as/src/domain/internals"
+	"go-as/src/domain/permission"
+	"go-as/src/domain/user"
+)
+
+const (
+	MaxBatchSubjects    = 100
+	MaxBatchPermissions = 100
+	MaxBatchSize        = 1000
+)
+
+type BatchCheckUserPermissionsUseCase struct {
+	userRepo    user.UserRepository
+	decisionLog decision.DecisionLog
+	logger      internals.Logger
+}
+
+func (useCase *BatchCheckUserPermissionsUseCase) Execute(ctx context.Context, request any) internals.UseCaseResponse {
+	validatedRequest, errResponse := internals.ValidateUseCaseRequest[*BatchCheckUserPermissionsRequest](request)
+	if errResponse != nil {
+		return *errResponse
+	}
+
+	useCase.logger.Info(ctx, fmt.Sprintf("Starting batch checking permissions from %d users", len(validatedRequest.UserEmails)))
+	defer useCase.logger.Info(ctx, fmt.Sprintf("Finished batch checking permissions from %d users", len(validatedRequest.UserEmails)))
+
+	if err := validateBatchSize(validatedRequest); err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+
+	users, err := useCase.userRepo.FindByEmails(ctx, validatedRequest.UserEmails)
+	if err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+	usersByEmail := make(map[string]*user.User, len(users))
+	for i := range users {
+		usersByEmail[users[i].Email] = &users[i]
+	}
+
+	response := BatchCheckUserPermissionsResponse{
+		Decisions: make(map[string]map[string]bool, len(validatedRequest.UserEmails)),
+	}
+	for _, userEmail := range validatedRequest.UserEmails {
+		user, found := usersByEmail[userEmail]
+		if !found {
+			response.MissingUsers = append(response.MissingUsers, userEmail)
+		}
+		response.Decisions[userEmail] = useCase.checkUserPermissions(user, validatedRequest.PermissionNames, validatedRequest.Resource)
+		useCase.recordDecision(ctx, userEmail, user, validatedRequest.PermissionNames, response.Decisions[userEmail])
+	}
+	return internals.UseCaseResponse{
+		Content: &response,
+		Err:     nil,
+	}
+}
+
+func validateBatchSize(request *BatchCheckUserPermissionsRequest) error {
+	if err := validateBatchDimension("subjects", len(request.UserEmails), MaxBatchSubjects); err != nil {
+		return err
+	}
+	if err := validateBatchDimension("permissions", len(request.PermissionNames), MaxBatchPermissions); err != nil {
+		return err
+	}
+	return validateBatchDimension("checks", len(request.UserEmails)*len(request.PermissionNames), MaxBatchSize)
+}
+
+func validateBatchDimension(dimension string, size int, maxSize int) error {
+	if size == 0 {
+		return EmptyBatchError{Dimension: dimension}
+	}
+	if size > maxSize {
+		return BatchSizeExceededError{
+			Dimension: dimension,
+			Size:      size,
+			MaxSize:   maxSize,
+		}
+	}
+	return nil
+}
+
+func (useCase *BatchCheckUserPermissionsUseCase) checkUserPermissions(user *user.User, permissionNames []string, resource *permission.Resource) map[string]bool {
+	decisions := make(map[string]bool, len(permissionNames))
+	for _, permissionName := range permissionNames {
+		decisions[permissionName] = user != nil && useCase.checkUserPermission(user, permissionName, resource)
+	}
+	return decisions
+}
+
+func (*BatchCheckUserPermissionsUseCase) checkUserPermission(user *user.User, permissionName string, resource *permission.Resource) bool {
+	if resource == nil {
+		return user.HasPermission(permissionName)
+	}
+	return user.HasPermissionOnResource(permissionName, *resource)
+}
+
+func (useCase *BatchCheckUserPermissionsUseCase) recordDecision(ctx context.Context, userEmail string, checkedUser *user.User, permissionNames []string, decisions map[string]bool) {
+	allowed := true
+	for _, permissionDecision := range decisions {
+		allowed = allowed && permissionDecision
+	}
+	useCase.decisionLog.Record(ctx, decision.Decision{
+		Subject:     userEmail,
+		Permissions: permissionNames,
+		Allowed:     allowed,
+		Reason:      useCase.findDecisionReason(checkedUser, permissionNames, allowed),
+		Source:      "BatchCheckUserPermissions",
+	})
+}
+
+func (*BatchCheckUserPermissionsUseCase) findDecisionReason(checkedUser *user.User, permissionNames []string, allowed bool) string {
+	switch {
+	case checkedUser == nil:
+		return decision.UserNotFoundReason
+	case checkedUser.Disabled:
+		return decision.UserDisabledReason
+	case allowed && checkedUser.Superuser:
+		return decision.SuperuserReason
+	case allowed:
+		return decision.GrantedReason
+	}
+	for _, permissionName := range permissionNames {
+		if checkedUser.FindPermissionDenial(permissionName) != nil {
+			return decision.PermissionDeniedReason
+		}
+	}
+	return decision.NotGrantedReason
+}
+
+func (*BatchCheckUserPermissionsUseCase) RequiredPermissions() []string {
+	return []string{user.CheckOtherUserPermissionsPermission}
+}
+
+func NewBatchCheckUserPermissionsUseCase(userRepo user.UserRepository, decisionLog decision.DecisionLog, logger internals.Logger) *BatchCheckUserPermissionsUseCase {
+	return &BatchCheckUserPermissionsUseCase{
+		userRepo:    userRepo,
+		decisionLog: decisionLog,
+		logger:      logger,
+	}
+}
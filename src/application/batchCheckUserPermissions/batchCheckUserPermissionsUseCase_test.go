@@ -0,0 +1,199 @@
+package batchCheckUserPermissions
+
+import (
+	"context"
+	"errors"
+	"go-as/mocks"
+	"go-as/src/domain/decision"
+	"go-as/src/domain/permission"
+	"go-as/src/domain/role"
+	"go-as/src/domain/user"
+	"go-as/src/infrastructure/logging"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"go.elastic.co/apm/v2"
+)
+
+type testCase struct {
+	UserRepo    *mocks.UserRepository
+	DecisionLog *mocks.DecisionLog
+	UseCase     *BatchCheckUserPermissionsUseCase
+}
+
+func setUp(t *testing.T) testCase {
+	tracer := apm.DefaultTracer()
+	logger := logging.NewZapTracedLogger(tracer)
+	userRepoMock := mocks.NewUserRepository(t)
+	decisionLogMock := mocks.NewDecisionLog(t)
+	decisionLogMock.On("Record", mock.Anything, mock.Anything).Return().Maybe()
+	return testCase{
+		UserRepo:    userRepoMock,
+		DecisionLog: decisionLogMock,
+		UseCase:     NewBatchCheckUserPermissionsUseCase(userRepoMock, decisionLogMock, logger),
+	}
+}
+
+func TestExecuteWrongRequest(t *testing.T) {
+	testCase := setUp(t)
+	request := "wrongRequest"
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err == nil {
+		t.Fatal("Expected use case to return error")
+	}
+	testCase.UserRepo.AssertNotCalled(t, "FindByEmails")
+}
+
+func TestExecuteBatchSizeExceeded(t *testing.T) {
+	testCase := setUp(t)
+	request := BatchCheckUserPermissionsRequest{
+		UserEmails:      make([]string, MaxBatchSubjects),
+		PermissionNames: make([]string, MaxBatchSize/MaxBatchSubjects+1),
+	}
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if sizeError, isSizeError := response.Err.(BatchSizeExceededError); !isSizeError || sizeError.Dimension != "checks" {
+		t.Fatal("Expected use case to return a batch size exceeded error")
+	}
+	testCase.UserRepo.AssertNotCalled(t, "FindByEmails")
+}
+
+func TestExecuteTooManySubjects(t *testing.T) {
+	testCase := setUp(t)
+	request := BatchCheckUserPermissionsRequest{
+		UserEmails:      make([]string, MaxBatchSubjects+1),
+		PermissionNames: []string{"testPermission"},
+	}
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if sizeError, isSizeError := response.Err.(BatchSizeExceededError); !isSizeError || sizeError.Dimension != "subjects" {
+		t.Fatal("Expected use case to return a subjects batch size exceeded error")
+	}
+	testCase.UserRepo.AssertNotCalled(t, "FindByEmails")
+}
+
+func TestExecuteTooManyPermissions(t *testing.T) {
+	testCase := setUp(t)
+	request := BatchCheckUserPermissionsRequest{
+		UserEmails:      []string{"test@test.com"},
+		PermissionNames: make([]string, MaxBatchPermissions+1),
+	}
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if sizeError, isSizeError := response.Err.(BatchSizeExceededError); !isSizeError || sizeError.Dimension != "permissions" {
+		t.Fatal("Expected use case to return a permissions batch size exceeded error")
+	}
+	testCase.UserRepo.AssertNotCalled(t, "FindByEmails")
+}
+
+func TestExecuteEmptyPermissions(t *testing.T) {
+	testCase := setUp(t)
+	request := BatchCheckUserPermissionsRequest{
+		UserEmails:      make([]string, MaxBatchSubjects),
+		PermissionNames: []string{},
+	}
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if _, isEmptyError := response.Err.(EmptyBatchError); !isEmptyError {
+		t.Fatal("Expected use case to return an empty batch error")
+	}
+	testCase.UserRepo.AssertNotCalled(t, "FindByEmails")
+}
+
+func TestExecuteFindUsersError(t *testing.T) {
+	testCase := setUp(t)
+	request := BatchCheckUserPermissionsRequest{
+		UserEmails:      []string{"testEmail1"},
+		PermissionNames: []string{"testPermission1"},
+	}
+	testError := errors.New("Test error")
+	testCase.UserRepo.On("FindByEmails", mock.Anything, mock.Anything).Return(nil, testError)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != testError {
+		t.Fatal("Expected use case to return same error as the find users error")
+	}
+}
+
+func TestExecuteSuccess(t *testing.T) {
+	testCase := setUp(t)
+	request := BatchCheckUserPermissionsRequest{
+		UserEmails:      []string{"testEmail1", "testEmail2", "testEmail3", "missingEmail"},
+		PermissionNames: []string{"testPermission1", "testPermission2"},
+	}
+	testUsers := []user.User{
+		{Email: "testEmail1", Superuser: true},
+		{Email: "testEmail2", Permissions: []permission.Permission{{Name: "testPermission1"}}},
+		{Email: "testEmail3", Roles: []role.Role{{Name: "testRole", Permissions: []permission.Permission{{Name: "testPermission2"}}}}},
+	}
+	testCase.UserRepo.On("FindByEmails", mock.Anything, mock.Anything).Return(testUsers, nil)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != nil {
+		t.Fatal("Expected use case not to return error")
+	}
+	batchResponse := response.Content.(*BatchCheckUserPermissionsResponse)
+	expectedDecisions := map[string]map[string]bool{
+		"testEmail1":   {"testPermission1": true, "testPermission2": true},
+		"testEmail2":   {"testPermission1": true, "testPermission2": false},
+		"testEmail3":   {"testPermission1": false, "testPermission2": true},
+		"missingEmail": {"testPermission1": false, "testPermission2": false},
+	}
+	if !reflect.DeepEqual(batchResponse.Decisions, expectedDecisions) {
+		t.Fatal("Expected use case to return the decision matrix")
+	}
+	if !reflect.DeepEqual(batchResponse.MissingUsers, []string{"missingEmail"}) {
+		t.Fatal("Expected use case to return the missing users")
+	}
+	testCase.UserRepo.AssertNumberOfCalls(t, "FindByEmails", 1)
+	testCase.UserRepo.AssertCalled(t, "FindByEmails", ctx, request.UserEmails)
+}
+
+func TestExecuteRecordsOneDecisionPerSubject(t *testing.T) {
+	testCase := setUp(t)
+	testCase.UserRepo.On("FindByEmails", mock.Anything, mock.Anything).Return([]user.User{
+		{Email: "granted@test.com", Permissions: []permission.Permission{{Name: "testPermission"}}},
+		{Email: "refused@test.com"},
+	}, nil)
+	request := BatchCheckUserPermissionsRequest{
+		UserEmails:      []string{"granted@test.com", "refused@test.com", "missing@test.com"},
+		PermissionNames: []string{"testPermission"},
+	}
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != nil {
+		t.Fatal("Expected use case not to return error")
+	}
+	testCase.DecisionLog.AssertNumberOfCalls(t, "Record", 3)
+	expectedDecisions := []decision.Decision{
+		{Subject: "granted@test.com", Allowed: true, Reason: decision.GrantedReason},
+		{Subject: "refused@test.com", Allowed: false, Reason: decision.NotGrantedReason},
+		{Subject: "missing@test.com", Allowed: false, Reason: decision.UserNotFoundReason},
+	}
+	for _, expectedDecision := range expectedDecisions {
+		testCase.DecisionLog.AssertCalled(t, "Record", ctx, mock.MatchedBy(func(recordedDecision decision.Decision) bool {
+			return recordedDecision.Subject == expectedDecision.Subject &&
+				recordedDecision.Allowed == expectedDecision.Allowed &&
+				recordedDecision.Reason == expectedDecision.Reason &&
+				reflect.DeepEqual(recordedDecision.Permissions, request.PermissionNames)
+		}))
+	}
+}
@@ -0,0 +1,13 @@
+package batchCheckUserPermissions
+
+import "fmt"
+
+type BatchSizeExceededError struct {
+	Dimension string
+	Size      int
+	MaxSize   int
+}
+
+func (err BatchSizeExceededError) Error() string {
+	return fmt.Sprintf("Batch of %d %s exceeds the maximum of %d %s", err.Size, err.Dimension, err.MaxSize, err.Dimension)
+}
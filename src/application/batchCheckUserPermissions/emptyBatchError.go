@@ -0,0 +1,11 @@
+package batchCheckUserPermissions
+
+import "fmt"
+
+type EmptyBatchError struct {
+	Dimension string
+}
+
+func (err EmptyBatchError) Error() string {
+	return fmt.Sprintf("Batch must contain at least one of %s", err.Dimension)
+}
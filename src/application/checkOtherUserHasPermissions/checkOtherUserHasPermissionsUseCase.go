@@ -0,0 +1,37 @@
+package checkOtherUserHasPermissions
+
+import (
+	"context"
+	"fmt"
+	"go-as/src/application/checkUserHasPermissions"
+	"go-as/src/domain/internals"
+	"go-as/src/domain/user"
+)
+
+type CheckOtherUserHasPermissionUseCase struct {
+	checkUserHasPermissionUseCase *checkUserHasPermissions.CheckUserHasPermissionUseCase
+	logger                        internals.Logger
+}
+
+func (useCase *CheckOtherUserHasPermissionUseCase) Execute(ctx context.Context, request any) internals.UseCaseResponse {
+	validatedRequest, errResponse := internals.ValidateUseCaseRequest[*checkUserHasPermissions.CheckUserHasPermissionRequest](request)
+	if errResponse != nil {
+		return *errResponse
+	}
+
+	useCase.logger.Info(ctx, fmt.Sprintf("Starting checking permissions on behalf of user %s", validatedRequest.UserEmail))
+	defer useCase.logger.Info(ctx, fmt.Sprintf("Finished checking permissions on behalf of user %s", validatedRequest.UserEmail))
+
+	return useCase.checkUserHasPermissionUseCase.Execute(ctx, validatedRequest)
+}
+
+func (*CheckOtherUserHasPermissionUseCase) RequiredPermissions() []string {
+	return []string{user.CheckOtherUserPermissionsPermission}
+}
+
+func NewCheckOtherUserHasPermissionUseCase(checkUserHasPermissionUseCase *checkUserHasPermissions.CheckUserHasPermissionUseCase, logger internals.Logger) *CheckOtherUserHasPermissionUseCase {
+	return &CheckOtherUserHasPermissionUseCase{
+		checkUserHasPermissionUseCase: checkUserHasPermissionUseCase,
+		logger:                        logger,
+	}
+}
@@ -0,0 +1,86 @@
+package checkOtherUserHasPermissions
+
+import (
+	"context"
+	"go-as/mocks"
+	"go-as/src/application/checkUserHasPermissions"
+	"go-as/src/domain/permission"
+	"go-as/src/domain/user"
+	"go-as/src/infrastructure/logging"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"go.elastic.co/apm/v2"
+)
+
+type testCase struct {
+	UserRepo *mocks.UserRepository
+	UseCase  *CheckOtherUserHasPermissionUseCase
+}
+
+func setUp(t *testing.T) testCase {
+	tracer := apm.DefaultTracer()
+	logger := logging.NewZapTracedLogger(tracer)
+	userRepoMock := mocks.NewUserRepository(t)
+	decisionLogMock := mocks.NewDecisionLog(t)
+	decisionLogMock.On("Record", mock.Anything, mock.Anything).Maybe()
+	checkUseCase := checkUserHasPermissions.NewCheckUserHasPermissionUseCase(userRepoMock, decisionLogMock, logger)
+	return testCase{
+		UserRepo: userRepoMock,
+		UseCase:  NewCheckOtherUserHasPermissionUseCase(checkUseCase, logger),
+	}
+}
+
+func TestExecuteWrongRequest(t *testing.T) {
+	testCase := setUp(t)
+	request := "wrongRequest"
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err == nil {
+		t.Fatal("Expected use case to return error")
+	}
+	testCase.UserRepo.AssertNotCalled(t, "FindByEmail")
+}
+
+func TestExecuteChecksTargetUser(t *testing.T) {
+	testCase := setUp(t)
+	request := checkUserHasPermissions.CheckUserHasPermissionRequest{
+		UserEmail:       "targetEmail",
+		PermissionNames: []string{"testPermission1", "testPermission2"},
+	}
+	ctx := context.Background()
+	targetUser := user.User{
+		Email:       "targetEmail",
+		Superuser:   false,
+		Permissions: []permission.Permission{{Name: "testPermission1"}},
+	}
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(&targetUser, nil)
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != nil {
+		t.Fatal("Expected use case not to return error")
+	}
+	checkResponse := response.Content.(*checkUserHasPermissions.CheckUserHasPermissionResponse)
+	if checkResponse.Result {
+		t.Fatal("Expected use case to return false")
+	}
+	expectedDecisions := map[string]bool{"testPermission1": true, "testPermission2": false}
+	if !reflect.DeepEqual(checkResponse.Decisions, expectedDecisions) {
+		t.Fatal("Expected use case to return the decisions of the target user")
+	}
+	testCase.UserRepo.AssertCalled(t, "FindByEmail", ctx, "targetEmail")
+}
+
+func TestRequiredPermissions(t *testing.T) {
+	testCase := setUp(t)
+
+	requiredPermissions := testCase.UseCase.RequiredPermissions()
+
+	if !reflect.DeepEqual(requiredPermissions, []string{user.CheckOtherUserPermissionsPermission}) {
+		t.Fatal("Expected use case to require the check other user permissions permission")
+	}
+}
@@ -0,0 +1,8 @@
+package checkUserHasPermissions
+
+type CheckMode string
+
+const (
+	AllCheckMode CheckMode = "all"
+	AnyCheckMode CheckMode = "any"
+)
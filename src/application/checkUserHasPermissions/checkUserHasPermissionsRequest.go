@@ -1,6 +1,11 @@
 package checkUserHasPermissions
 
+import "go-as/src/domain/permission"
+
 type CheckUserHasPermissionRequest struct {
 	UserEmail       string
 	PermissionNames []string
+	Resource        *permission.Resource
+	Explain         bool
+	Mode            CheckMode
 }
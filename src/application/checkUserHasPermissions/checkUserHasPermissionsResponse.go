@@ -0,0 +1,10 @@
+package checkUserHasPermissions
+
+import "go-as/src/domain/user"
+
+type CheckUserHasPermissionResponse struct {
+	Result       bool
+	Decisions    map[string]bool
+	Denials      []user.PermissionDenial
+	Explanations []user.PermissionExplanation
+}
@@ -3,13 +3,16 @@ package checkUserHasPermissions
 import (
 	"context"
 	"fmt"
+	"go-as/src/domain/decision"
 	"go-as/src/domain/internals"
+	"go-as/src/domain/permission"
 	"go-as/src/domain/user"
 )
 
 type CheckUserHasPermissionUseCase struct {
-	userRepo user.UserRepository
-	logger   internals.Logger
+	userRepo    user.UserRepository
+	decisionLog decision.DecisionLog
+	logger      internals.Logger
 }
 
 func (useCase *CheckUserHasPermissionUseCase) Execute(ctx context.Context, request any) internals.UseCaseResponse {
@@ -21,36 +24,115 @@ func (useCase *CheckUserHasPermissionUseCase) Execute(ctx context.Context, reque
 	useCase.logger.Info(ctx, fmt.Sprintf("Starting checking permissions from user %s", validatedRequest.UserEmail))
 	defer useCase.logger.Info(ctx, fmt.Sprintf("Finished checking permissions from user %s", validatedRequest.UserEmail))
 
-	user, err := useCase.userRepo.FindByEmail(ctx, validatedRequest.UserEmail)
+	if validatedRequest.Mode == "" {
+		validatedRequest.Mode = AllCheckMode
+	}
+	if validatedRequest.Mode != AllCheckMode && validatedRequest.Mode != AnyCheckMode {
+		return internals.ErrorUseCaseResponse(InvalidCheckModeError{Mode: validatedRequest.Mode})
+	}
+
+	checkedUser, err := useCase.userRepo.FindByEmail(ctx, validatedRequest.UserEmail)
 	if err != nil {
 		return internals.ErrorUseCaseResponse(err)
 	}
-	if user == nil {
-		return internals.ErrorUseCaseResponse(fmt.Errorf("user %s not found", validatedRequest.UserEmail))
+	if checkedUser == nil {
+		useCase.recordDecision(ctx, validatedRequest, false, decision.UserNotFoundReason)
+		return internals.ErrorUseCaseResponse(user.UserNotFoundError{Email: validatedRequest.UserEmail})
 	}
 
+	response := useCase.checkUserHasPermissions(checkedUser, validatedRequest.PermissionNames, validatedRequest.Resource)
+	response.Result = useCase.combineDecisions(response.Decisions, validatedRequest.Mode)
+	useCase.recordDecision(ctx, validatedRequest, response.Result, useCase.findDecisionReason(checkedUser, response))
+	if validatedRequest.Explain {
+		response.Explanations = useCase.explainUserPermissions(checkedUser, validatedRequest.PermissionNames)
+	}
 	return internals.UseCaseResponse{
-		Content: useCase.checkUserHasPermissions(user, validatedRequest.PermissionNames),
+		Content: response,
 		Err:     nil,
 	}
 }
 
-func (*CheckUserHasPermissionUseCase) checkUserHasPermissions(user *user.User, permissionNames []string) bool {
+func (useCase *CheckUserHasPermissionUseCase) checkUserHasPermissions(user *user.User, permissionNames []string, resource *permission.Resource) *CheckUserHasPermissionResponse {
+	response := CheckUserHasPermissionResponse{
+		Decisions: make(map[string]bool, len(permissionNames)),
+	}
 	for _, permissionName := range permissionNames {
-		if !user.HasPermission(permissionName) {
+		decision := useCase.checkUserHasPermission(user, permissionName, resource)
+		if !decision {
+			if denial := user.FindPermissionDenial(permissionName); denial != nil {
+				response.Denials = append(response.Denials, *denial)
+			}
+		}
+		response.Decisions[permissionName] = decision
+	}
+	return &response
+}
+
+func (*CheckUserHasPermissionUseCase) combineDecisions(decisions map[string]bool, mode CheckMode) bool {
+	if mode == AnyCheckMode {
+		for _, decision := range decisions {
+			if decision {
+				return true
+			}
+		}
+		return false
+	}
+	for _, decision := range decisions {
+		if !decision {
 			return false
 		}
 	}
 	return true
 }
 
+func (useCase *CheckUserHasPermissionUseCase) recordDecision(ctx context.Context, request *CheckUserHasPermissionRequest, allowed bool, reason string) {
+	useCase.decisionLog.Record(ctx, decision.Decision{
+		Subject:     request.UserEmail,
+		Permissions: request.PermissionNames,
+		Allowed:     allowed,
+		Reason:      reason,
+		Source:      "CheckUserHasPermission",
+	})
+}
+
+func (*CheckUserHasPermissionUseCase) findDecisionReason(checkedUser *user.User, response *CheckUserHasPermissionResponse) string {
+	switch {
+	case checkedUser.Disabled:
+		return decision.UserDisabledReason
+	case response.Result && checkedUser.Superuser:
+		return decision.SuperuserReason
+	case response.Result:
+		return decision.GrantedReason
+	case len(response.Denials) > 0:
+		return decision.PermissionDeniedReason
+	default:
+		return decision.NotGrantedReason
+	}
+}
+
+func (*CheckUserHasPermissionUseCase) explainUserPermissions(targetUser *user.User, permissionNames []string) []user.PermissionExplanation {
+	explanations := make([]user.PermissionExplanation, 0, len(permissionNames))
+	for _, permissionName := range permissionNames {
+		explanations = append(explanations, targetUser.ExplainPermission(permissionName))
+	}
+	return explanations
+}
+
+func (*CheckUserHasPermissionUseCase) checkUserHasPermission(user *user.User, permissionName string, resource *permission.Resource) bool {
+	if resource == nil {
+		return user.HasPermission(permissionName)
+	}
+	return user.HasPermissionOnResource(permissionName, *resource)
+}
+
 func (*CheckUserHasPermissionUseCase) RequiredPermissions() []string {
 	return []string{}
 }
 
-func NewCheckUserHasPermissionUseCase(userRepo user.UserRepository, logger internals.Logger) *CheckUserHasPermissionUseCase {
+func NewCheckUserHasPermissionUseCase(userRepo user.UserRepository, decisionLog decision.DecisionLog, logger internals.Logger) *CheckUserHasPermissionUseCase {
 	return &CheckUserHasPermissionUseCase{
-		userRepo: userRepo,
-		logger:   logger,
+		userRepo:    userRepo,
+		decisionLog: decisionLog,
+		logger:      logger,
 	}
 }
@@ -4,28 +4,35 @@ import (
 	"context"
 	"errors"
 	"go-as/mocks"
+	"go-as/src/domain/decision"
 	"go-as/src/domain/permission"
 	"go-as/src/domain/role"
 	"go-as/src/domain/user"
 	"go-as/src/infrastructure/logging"
+	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 	"go.elastic.co/apm/v2"
 )
 
 type testCase struct {
-	UserRepo *mocks.UserRepository
-	UseCase  *CheckUserHasPermissionUseCase
+	UserRepo    *mocks.UserRepository
+	DecisionLog *mocks.DecisionLog
+	UseCase     *CheckUserHasPermissionUseCase
 }
 
 func setUp(t *testing.T) testCase {
 	tracer := apm.DefaultTracer()
 	logger := logging.NewZapTracedLogger(tracer)
 	userRepoMock := mocks.NewUserRepository(t)
+	decisionLogMock := mocks.NewDecisionLog(t)
+	decisionLogMock.On("Record", mock.Anything, mock.Anything).Maybe()
 	return testCase{
-		UserRepo: userRepoMock,
-		UseCase:  NewCheckUserHasPermissionUseCase(userRepoMock, logger),
+		UserRepo:    userRepoMock,
+		DecisionLog: decisionLogMock,
+		UseCase:     NewCheckUserHasPermissionUseCase(userRepoMock, decisionLogMock, logger),
 	}
 }
 
@@ -74,8 +81,8 @@ func TestExecuteUserNotFound(t *testing.T) {
 
 	response := testCase.UseCase.Execute(ctx, &request)
 
-	if response.Err == nil {
-		t.Fatal("Expected use case to return error")
+	if _, ok := response.Err.(user.UserNotFoundError); !ok {
+		t.Fatal("Expected use case to return UserNotFoundError")
 	}
 	testCase.UserRepo.AssertCalled(t, "FindByEmail", ctx, request.UserEmail)
 }
@@ -98,12 +105,37 @@ func TestExecuteSuperuserHasPermissions(t *testing.T) {
 	if response.Err != nil {
 		t.Fatal("Expected use case not to return error")
 	}
-	if !response.Content.(bool) {
+	if !response.Content.(*CheckUserHasPermissionResponse).Result {
 		t.Fatal("Expected use case to return true")
 	}
 	testCase.UserRepo.AssertCalled(t, "FindByEmail", ctx, request.UserEmail)
 }
 
+func TestExecuteDisabledSuperuserHasNotPermissions(t *testing.T) {
+	testCase := setUp(t)
+	request := CheckUserHasPermissionRequest{
+		UserEmail:       "testEmail",
+		PermissionNames: []string{"testPermission1"},
+	}
+	ctx := context.Background()
+	testUser := user.User{
+		Email:       "testEmail",
+		Superuser:   true,
+		Disabled:    true,
+		Permissions: []permission.Permission{{Name: "testPermission1"}},
+	}
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(&testUser, nil)
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != nil {
+		t.Fatal("Expected use case not to return error")
+	}
+	if response.Content.(*CheckUserHasPermissionResponse).Result {
+		t.Fatal("Expected use case to return false for a disabled user")
+	}
+}
+
 func TestExecuteUserHasPermissions(t *testing.T) {
 	testCase := setUp(t)
 	request := CheckUserHasPermissionRequest{
@@ -123,7 +155,7 @@ func TestExecuteUserHasPermissions(t *testing.T) {
 	if response.Err != nil {
 		t.Fatal("Expected use case not to return error")
 	}
-	if !response.Content.(bool) {
+	if !response.Content.(*CheckUserHasPermissionResponse).Result {
 		t.Fatal("Expected use case to return true")
 	}
 	testCase.UserRepo.AssertCalled(t, "FindByEmail", ctx, request.UserEmail)
@@ -149,7 +181,7 @@ func TestExecuteUserHasPermissionsInRoles(t *testing.T) {
 	if response.Err != nil {
 		t.Fatal("Expected use case not to return error")
 	}
-	if !response.Content.(bool) {
+	if !response.Content.(*CheckUserHasPermissionResponse).Result {
 		t.Fatal("Expected use case to return true")
 	}
 	testCase.UserRepo.AssertCalled(t, "FindByEmail", ctx, request.UserEmail)
@@ -175,8 +207,541 @@ func TestExecuteUserHasNotPermissions(t *testing.T) {
 	if response.Err != nil {
 		t.Fatal("Expected use case not to return error")
 	}
-	if response.Content.(bool) {
+	if response.Content.(*CheckUserHasPermissionResponse).Result {
 		t.Fatal("Expected use case to return false")
 	}
 	testCase.UserRepo.AssertCalled(t, "FindByEmail", ctx, request.UserEmail)
 }
+
+func TestExecuteUserHasPermissionsInInheritedRoles(t *testing.T) {
+	testCase := setUp(t)
+	request := CheckUserHasPermissionRequest{
+		UserEmail:       "testEmail",
+		PermissionNames: []string{"testPermission1", "testPermission2"},
+	}
+	ctx := context.Background()
+	grandparentRole := role.Role{Name: "testGrandparentRole", Permissions: []permission.Permission{{Name: "testPermission2"}}}
+	parentRole := role.Role{Name: "testParentRole", Permissions: []permission.Permission{{Name: "testPermission1"}}, Parents: []role.Role{grandparentRole}}
+	testUser := user.User{
+		Email:       "testEmail",
+		Superuser:   false,
+		Permissions: []permission.Permission{},
+		Roles:       []role.Role{{Name: "testRole", Parents: []role.Role{parentRole}}},
+	}
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(&testUser, nil)
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != nil {
+		t.Fatal("Expected use case not to return error")
+	}
+	if !response.Content.(*CheckUserHasPermissionResponse).Result {
+		t.Fatal("Expected use case to return true")
+	}
+	testCase.UserRepo.AssertCalled(t, "FindByEmail", ctx, request.UserEmail)
+}
+
+func TestExecuteUserHasPermissionsOnResource(t *testing.T) {
+	testCase := setUp(t)
+	request := CheckUserHasPermissionRequest{
+		UserEmail:       "testEmail",
+		PermissionNames: []string{"testPermission1", "testPermission2"},
+		Resource:        &permission.Resource{Type: "testResourceType", ID: "testResourceID"},
+	}
+	ctx := context.Background()
+	testUser := user.User{
+		Email:       "testEmail",
+		Superuser:   false,
+		Permissions: []permission.Permission{},
+		ResourcePermissions: []user.UserResourcePermission{{
+			UserEmail:     "testEmail",
+			ResourceGrant: permission.NewResourceGrant("testPermission1", "testResourceType", "testResourceID"),
+		}},
+		Roles: []role.Role{{
+			Name: "testRole",
+			ResourcePermissions: []role.RoleResourcePermission{{
+				RoleName:      "testRole",
+				ResourceGrant: permission.NewResourceGrant("testPermission2", "testResourceType", permission.AnyResourceID),
+			}},
+		}},
+	}
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(&testUser, nil)
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != nil {
+		t.Fatal("Expected use case not to return error")
+	}
+	if !response.Content.(*CheckUserHasPermissionResponse).Result {
+		t.Fatal("Expected use case to return true")
+	}
+	testCase.UserRepo.AssertCalled(t, "FindByEmail", ctx, request.UserEmail)
+}
+
+func TestExecuteUserHasGlobalPermissionsOnResource(t *testing.T) {
+	testCase := setUp(t)
+	request := CheckUserHasPermissionRequest{
+		UserEmail:       "testEmail",
+		PermissionNames: []string{"testPermission1"},
+		Resource:        &permission.Resource{Type: "testResourceType", ID: "testResourceID"},
+	}
+	ctx := context.Background()
+	testUser := user.User{
+		Email:       "testEmail",
+		Superuser:   false,
+		Permissions: []permission.Permission{{Name: "testPermission1"}},
+	}
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(&testUser, nil)
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != nil {
+		t.Fatal("Expected use case not to return error")
+	}
+	if !response.Content.(*CheckUserHasPermissionResponse).Result {
+		t.Fatal("Expected use case to return true")
+	}
+}
+
+func TestExecuteUserHasNotPermissionsOnOtherResource(t *testing.T) {
+	testCase := setUp(t)
+	request := CheckUserHasPermissionRequest{
+		UserEmail:       "testEmail",
+		PermissionNames: []string{"testPermission1"},
+		Resource:        &permission.Resource{Type: "testResourceType", ID: "testOtherResourceID"},
+	}
+	ctx := context.Background()
+	testUser := user.User{
+		Email:       "testEmail",
+		Superuser:   false,
+		Permissions: []permission.Permission{},
+		ResourcePermissions: []user.UserResourcePermission{{
+			UserEmail:     "testEmail",
+			ResourceGrant: permission.NewResourceGrant("testPermission1", "testResourceType", "testResourceID"),
+		}},
+	}
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(&testUser, nil)
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != nil {
+		t.Fatal("Expected use case not to return error")
+	}
+	if response.Content.(*CheckUserHasPermissionResponse).Result {
+		t.Fatal("Expected use case to return false")
+	}
+}
+
+func TestExecuteUserDeniedPermissionOverridesAllow(t *testing.T) {
+	testCase := setUp(t)
+	request := CheckUserHasPermissionRequest{
+		UserEmail:       "testEmail",
+		PermissionNames: []string{"testPermission1"},
+	}
+	ctx := context.Background()
+	testUser := user.User{
+		Email:             "testEmail",
+		Superuser:         false,
+		Permissions:       []permission.Permission{{Name: "testPermission1"}},
+		DeniedPermissions: []permission.Permission{{Name: "testPermission1"}},
+	}
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(&testUser, nil)
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != nil {
+		t.Fatal("Expected use case not to return error")
+	}
+	checkResponse := response.Content.(*CheckUserHasPermissionResponse)
+	if checkResponse.Result {
+		t.Fatal("Expected use case to return false")
+	}
+	expectedDenials := []user.PermissionDenial{{PermissionName: "testPermission1"}}
+	if !reflect.DeepEqual(checkResponse.Denials, expectedDenials) {
+		t.Fatal("Expected use case to return the user level denial")
+	}
+}
+
+func TestExecuteRoleDeniedPermissionOverridesAllow(t *testing.T) {
+	testCase := setUp(t)
+	request := CheckUserHasPermissionRequest{
+		UserEmail:       "testEmail",
+		PermissionNames: []string{"testPermission1", "testPermission2"},
+	}
+	ctx := context.Background()
+	parentRole := role.Role{Name: "testParentRole", DeniedPermissions: []permission.Permission{{Name: "testPermission2"}}}
+	testUser := user.User{
+		Email:       "testEmail",
+		Superuser:   false,
+		Permissions: []permission.Permission{{Name: "testPermission1"}, {Name: "testPermission2"}},
+		Roles:       []role.Role{{Name: "testRole", Parents: []role.Role{parentRole}}},
+	}
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(&testUser, nil)
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != nil {
+		t.Fatal("Expected use case not to return error")
+	}
+	checkResponse := response.Content.(*CheckUserHasPermissionResponse)
+	if checkResponse.Result {
+		t.Fatal("Expected use case to return false")
+	}
+	expectedDenials := []user.PermissionDenial{{PermissionName: "testPermission2", RoleName: "testParentRole"}}
+	if !reflect.DeepEqual(checkResponse.Denials, expectedDenials) {
+		t.Fatal("Expected use case to return the role level denial")
+	}
+}
+
+func TestExecuteUserHasNotExpiredPermissions(t *testing.T) {
+	testCase := setUp(t)
+	request := CheckUserHasPermissionRequest{
+		UserEmail:       "testEmail",
+		PermissionNames: []string{"testPermission1"},
+	}
+	ctx := context.Background()
+	expiredAt := time.Now().Add(-time.Minute)
+	testUser := user.User{
+		Email:            "testEmail",
+		Superuser:        false,
+		Permissions:      []permission.Permission{{Name: "testPermission1"}},
+		PermissionGrants: []user.UserPermission{{UserEmail: "testEmail", PermissionName: "testPermission1", GrantValidity: user.GrantValidity{ValidUntil: &expiredAt}}},
+	}
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(&testUser, nil)
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != nil {
+		t.Fatal("Expected use case not to return error")
+	}
+	if response.Content.(*CheckUserHasPermissionResponse).Result {
+		t.Fatal("Expected use case to return false")
+	}
+}
+
+func TestExecuteUserHasNotPermissionsInNotYetValidRoles(t *testing.T) {
+	testCase := setUp(t)
+	request := CheckUserHasPermissionRequest{
+		UserEmail:       "testEmail",
+		PermissionNames: []string{"testPermission1"},
+	}
+	ctx := context.Background()
+	validFrom := time.Now().Add(time.Hour)
+	testUser := user.User{
+		Email:      "testEmail",
+		Superuser:  false,
+		Roles:      []role.Role{{Name: "testRole", Permissions: []permission.Permission{{Name: "testPermission1"}}}},
+		RoleGrants: []user.UserRole{{UserEmail: "testEmail", RoleName: "testRole", GrantValidity: user.GrantValidity{ValidFrom: &validFrom}}},
+	}
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(&testUser, nil)
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != nil {
+		t.Fatal("Expected use case not to return error")
+	}
+	if response.Content.(*CheckUserHasPermissionResponse).Result {
+		t.Fatal("Expected use case to return false")
+	}
+}
+
+func TestExecuteExplainSuperuser(t *testing.T) {
+	testCase := setUp(t)
+	request := CheckUserHasPermissionRequest{
+		UserEmail:       "testEmail",
+		PermissionNames: []string{"testPermission1"},
+		Explain:         true,
+	}
+	ctx := context.Background()
+	testUser := user.User{
+		Email:     "testEmail",
+		Superuser: true,
+	}
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(&testUser, nil)
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != nil {
+		t.Fatal("Expected use case not to return error")
+	}
+	expectedExplanations := []user.PermissionExplanation{{PermissionName: "testPermission1", Granted: true, Source: user.SuperuserPermissionSource}}
+	if !reflect.DeepEqual(response.Content.(*CheckUserHasPermissionResponse).Explanations, expectedExplanations) {
+		t.Fatal("Expected use case to explain the permission is granted by the superuser flag")
+	}
+}
+
+func TestExecuteExplainDirectAndInheritedRolePermissions(t *testing.T) {
+	testCase := setUp(t)
+	request := CheckUserHasPermissionRequest{
+		UserEmail:       "testEmail",
+		PermissionNames: []string{"testPermission1", "testPermission2"},
+		Explain:         true,
+	}
+	ctx := context.Background()
+	parentRole := role.Role{Name: "testParentRole", Permissions: []permission.Permission{{Name: "testPermission2"}}}
+	testUser := user.User{
+		Email:       "testEmail",
+		Superuser:   false,
+		Permissions: []permission.Permission{{Name: "testPermission1"}},
+		Roles:       []role.Role{{Name: "testRole", Parents: []role.Role{parentRole}}},
+	}
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(&testUser, nil)
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != nil {
+		t.Fatal("Expected use case not to return error")
+	}
+	checkResponse := response.Content.(*CheckUserHasPermissionResponse)
+	if !checkResponse.Result {
+		t.Fatal("Expected use case to return true")
+	}
+	expectedExplanations := []user.PermissionExplanation{
+		{PermissionName: "testPermission1", Granted: true, Source: user.DirectPermissionSource},
+		{PermissionName: "testPermission2", Granted: true, Source: user.RolePermissionSource, RolePath: []string{"testRole", "testParentRole"}},
+	}
+	if !reflect.DeepEqual(checkResponse.Explanations, expectedExplanations) {
+		t.Fatal("Expected use case to explain the direct and the inherited role grants")
+	}
+}
+
+func TestExecuteExplainMissingPermissionWithClosestMatches(t *testing.T) {
+	testCase := setUp(t)
+	request := CheckUserHasPermissionRequest{
+		UserEmail:       "testEmail",
+		PermissionNames: []string{"UpdateUserPermission"},
+		Explain:         true,
+	}
+	ctx := context.Background()
+	testUser := user.User{
+		Email:       "testEmail",
+		Superuser:   false,
+		Permissions: []permission.Permission{{Name: "UpdateRolePermission"}, {Name: "CreatePermissionPermission"}},
+		Roles:       []role.Role{{Name: "testRole", Permissions: []permission.Permission{{Name: "UpdateUsersPermission"}}}},
+	}
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(&testUser, nil)
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != nil {
+		t.Fatal("Expected use case not to return error")
+	}
+	checkResponse := response.Content.(*CheckUserHasPermissionResponse)
+	if checkResponse.Result {
+		t.Fatal("Expected use case to return false")
+	}
+	expectedExplanations := []user.PermissionExplanation{
+		{PermissionName: "UpdateUserPermission", ClosestMatches: []string{"UpdateUsersPermission", "UpdateRolePermission"}},
+	}
+	if !reflect.DeepEqual(checkResponse.Explanations, expectedExplanations) {
+		t.Fatalf("Expected use case to explain the closest matches, got %+v", checkResponse.Explanations)
+	}
+}
+
+func TestExecuteExplainDeniedPermission(t *testing.T) {
+	testCase := setUp(t)
+	request := CheckUserHasPermissionRequest{
+		UserEmail:       "testEmail",
+		PermissionNames: []string{"testPermission1"},
+		Explain:         true,
+	}
+	ctx := context.Background()
+	testUser := user.User{
+		Email:       "testEmail",
+		Superuser:   false,
+		Permissions: []permission.Permission{{Name: "testPermission1"}},
+		Roles:       []role.Role{{Name: "testRole", DeniedPermissions: []permission.Permission{{Name: "testPermission1"}}}},
+	}
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(&testUser, nil)
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != nil {
+		t.Fatal("Expected use case not to return error")
+	}
+	expectedExplanations := []user.PermissionExplanation{
+		{PermissionName: "testPermission1", Denial: &user.PermissionDenial{PermissionName: "testPermission1", RoleName: "testRole"}},
+	}
+	if !reflect.DeepEqual(response.Content.(*CheckUserHasPermissionResponse).Explanations, expectedExplanations) {
+		t.Fatal("Expected use case to explain the permission is denied by the role")
+	}
+}
+
+func TestExecuteNotExplainedByDefault(t *testing.T) {
+	testCase := setUp(t)
+	request := CheckUserHasPermissionRequest{
+		UserEmail:       "testEmail",
+		PermissionNames: []string{"testPermission1"},
+	}
+	ctx := context.Background()
+	testUser := user.User{
+		Email:       "testEmail",
+		Superuser:   false,
+		Permissions: []permission.Permission{{Name: "testPermission1"}},
+	}
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(&testUser, nil)
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Content.(*CheckUserHasPermissionResponse).Explanations != nil {
+		t.Fatal("Expected use case not to return explanations")
+	}
+}
+
+func TestExecuteReturnsDecisionPerPermission(t *testing.T) {
+	testCase := setUp(t)
+	request := CheckUserHasPermissionRequest{
+		UserEmail:       "testEmail",
+		PermissionNames: []string{"testPermission1", "testPermission2", "testPermission3"},
+	}
+	ctx := context.Background()
+	testUser := user.User{
+		Email:       "testEmail",
+		Superuser:   false,
+		Permissions: []permission.Permission{{Name: "testPermission1"}},
+		Roles:       []role.Role{{Name: "testRole", Permissions: []permission.Permission{{Name: "testPermission3"}}}},
+	}
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(&testUser, nil)
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != nil {
+		t.Fatal("Expected use case not to return error")
+	}
+	checkResponse := response.Content.(*CheckUserHasPermissionResponse)
+	if checkResponse.Result {
+		t.Fatal("Expected use case to return false")
+	}
+	expectedDecisions := map[string]bool{"testPermission1": true, "testPermission2": false, "testPermission3": true}
+	if !reflect.DeepEqual(checkResponse.Decisions, expectedDecisions) {
+		t.Fatal("Expected use case to return the decision of each permission")
+	}
+}
+
+func TestExecuteAnyModeUserHasOnePermission(t *testing.T) {
+	testCase := setUp(t)
+	request := CheckUserHasPermissionRequest{
+		UserEmail:       "testEmail",
+		PermissionNames: []string{"testPermission1", "testPermission2"},
+		Mode:            AnyCheckMode,
+	}
+	ctx := context.Background()
+	testUser := user.User{
+		Email:       "testEmail",
+		Superuser:   false,
+		Permissions: []permission.Permission{{Name: "testPermission2"}},
+	}
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(&testUser, nil)
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != nil {
+		t.Fatal("Expected use case not to return error")
+	}
+	if !response.Content.(*CheckUserHasPermissionResponse).Result {
+		t.Fatal("Expected use case to return true")
+	}
+}
+
+func TestExecuteAnyModeUserHasNoPermission(t *testing.T) {
+	testCase := setUp(t)
+	request := CheckUserHasPermissionRequest{
+		UserEmail:       "testEmail",
+		PermissionNames: []string{"testPermission1", "testPermission2"},
+		Mode:            AnyCheckMode,
+	}
+	ctx := context.Background()
+	testUser := user.User{
+		Email:       "testEmail",
+		Superuser:   false,
+		Permissions: []permission.Permission{{Name: "testPermission3"}},
+	}
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(&testUser, nil)
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != nil {
+		t.Fatal("Expected use case not to return error")
+	}
+	if response.Content.(*CheckUserHasPermissionResponse).Result {
+		t.Fatal("Expected use case to return false")
+	}
+}
+
+func TestExecuteUnknownMode(t *testing.T) {
+	testCase := setUp(t)
+	request := CheckUserHasPermissionRequest{
+		UserEmail:       "testEmail",
+		PermissionNames: []string{"testPermission1"},
+		Mode:            "some",
+	}
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if _, ok := response.Err.(InvalidCheckModeError); !ok {
+		t.Fatal("Expected use case to return InvalidCheckModeError")
+	}
+	testCase.UserRepo.AssertNotCalled(t, "FindByEmail")
+}
+
+func TestExecuteRecordsGrantedDecision(t *testing.T) {
+	testCase := setUp(t)
+	testUser := &user.User{
+		Email:       "testEmail",
+		Permissions: []permission.Permission{{Name: "testPermission"}},
+	}
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(testUser, nil)
+	request := CheckUserHasPermissionRequest{
+		UserEmail:       "testEmail",
+		PermissionNames: []string{"testPermission"},
+	}
+	ctx := context.Background()
+
+	testCase.UseCase.Execute(ctx, &request)
+
+	testCase.DecisionLog.AssertCalled(t, "Record", ctx, decision.Decision{
+		Subject:     "testEmail",
+		Permissions: []string{"testPermission"},
+		Allowed:     true,
+		Reason:      decision.GrantedReason,
+		Source:      "CheckUserHasPermission",
+	})
+}
+
+func TestExecuteRecordsRoleDenialDecision(t *testing.T) {
+	testCase := setUp(t)
+	testUser := &user.User{
+		Email:       "testEmail",
+		Permissions: []permission.Permission{{Name: "testPermission"}},
+		Roles:       []role.Role{{Name: "testRole", DeniedPermissions: []permission.Permission{{Name: "testPermission"}}}},
+	}
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(testUser, nil)
+	request := CheckUserHasPermissionRequest{
+		UserEmail:       "testEmail",
+		PermissionNames: []string{"testPermission"},
+	}
+	ctx := context.Background()
+
+	testCase.UseCase.Execute(ctx, &request)
+
+	testCase.DecisionLog.AssertCalled(t, "Record", ctx, mock.MatchedBy(func(recordedDecision decision.Decision) bool {
+		return !recordedDecision.Allowed && recordedDecision.Reason == decision.PermissionDeniedReason
+	}))
+}
+
+func TestExecuteRecordsUserNotFoundDecision(t *testing.T) {
+	testCase := setUp(t)
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(nil, nil)
+	request := CheckUserHasPermissionRequest{
+		UserEmail:       "testEmail",
+		PermissionNames: []string{"testPermission"},
+	}
+	ctx := context.Background()
+
+	testCase.UseCase.Execute(ctx, &request)
+
+	testCase.DecisionLog.AssertCalled(t, "Record", ctx, mock.MatchedBy(func(recordedDecision decision.Decision) bool {
+		return !recordedDecision.Allowed && recordedDecision.Reason == decision.UserNotFoundReason
+	}))
+}
@@ -0,0 +1,11 @@
+package checkUserHasPermissions
+
+import "fmt"
+
+type InvalidCheckModeError struct {
+	Mode CheckMode
+}
+
+func (err InvalidCheckModeError) Error() string {
+	return fmt.Sprintf("Unknown permissions check mode %s", err.Mode)
+}
@@ -1,5 +1,7 @@
 package createPermission
 
 type CreatePermissionRequest struct {
-	Name string
+	Name        string
+	Description string
+	Service     string
 }
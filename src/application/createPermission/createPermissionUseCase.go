@@ -3,12 +3,15 @@ package createPermission
 import (
 	"context"
 	"fmt"
+	"go-as/src/domain/audit"
 	"go-as/src/domain/internals"
 	"go-as/src/domain/permission"
 )
 
 type CreatePermissionUseCase struct {
 	permissionRepository permission.PermissionRepository
+	changeRecorder       *audit.ChangeRecorder
+	transactionManager   internals.TransactionManager
 	logger               internals.Logger
 }
 
@@ -21,10 +24,28 @@ func (useCase *CreatePermissionUseCase) Execute(ctx context.Context, request any
 	useCase.logger.Info(ctx, fmt.Sprintf("Starting creating permission with name %s", validatedRequest.Name))
 	defer useCase.logger.Info(ctx, fmt.Sprintf("Finished creating permission with name %s", validatedRequest.Name))
 
-	permission := permission.Permission{
-		Name: validatedRequest.Name,
+	createdPermission := permission.Permission{
+		Name:        validatedRequest.Name,
+		Description: validatedRequest.Description,
+		Service:     validatedRequest.Service,
 	}
-	if err := useCase.permissionRepository.Save(ctx, permission); err != nil {
+	err := useCase.transactionManager.Transaction(ctx, func(ctx context.Context) error {
+		if err := useCase.permissionRepository.Save(ctx, createdPermission); err != nil {
+			return err
+		}
+		return useCase.changeRecorder.Record(ctx, audit.Change{
+			Action:     audit.CreatePermissionAction,
+			TargetType: audit.PermissionTargetType,
+			Target:     createdPermission.Name,
+			After:      createdPermission,
+			Event: permission.PermissionCreatedEvent{
+				Name:        createdPermission.Name,
+				Description: createdPermission.Description,
+				Service:     createdPermission.Service,
+			},
+		})
+	})
+	if err != nil {
 		return internals.ErrorUseCaseResponse(err)
 	}
 	return internals.EmptyUseCaseResponse()
@@ -34,9 +55,11 @@ func (*CreatePermissionUseCase) RequiredPermissions() []string {
 	return []string{permission.CreatePermissionPermission}
 }
 
-func NewCreatePermissionUseCase(permissionRepository permission.PermissionRepository, logger internals.Logger) *CreatePermissionUseCase {
+func NewCreatePermissionUseCase(permissionRepository permission.PermissionRepository, changeRecorder *audit.ChangeRecorder, transactionManager internals.TransactionManager, logger internals.Logger) *CreatePermissionUseCase {
 	useCase := CreatePermissionUseCase{
 		permissionRepository: permissionRepository,
+		changeRecorder:       changeRecorder,
+		transactionManager:   transactionManager,
 		logger:               logger,
 	}
 	return &useCase
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"go-as/mocks"
+	"go-as/src/domain/audit"
+	"go-as/src/domain/auth"
 	"go-as/src/domain/permission"
 	"go-as/src/infrastructure/logging"
 	"testing"
@@ -13,17 +15,29 @@ import (
 )
 
 type testCase struct {
-	PermissionRepo *mocks.PermissionRepository
-	UseCase        *CreatePermissionUseCase
+	PermissionRepo     *mocks.PermissionRepository
+	AuditRepo          *mocks.AuditRepository
+	EventPublisher     *mocks.EventPublisher
+	TransactionManager *mocks.TransactionManager
+	UseCase            *CreatePermissionUseCase
 }
 
 func setUp(t *testing.T) testCase {
 	tracer := apm.DefaultTracer()
 	logger := logging.NewZapTracedLogger(tracer)
 	permissionRepoMock := mocks.NewPermissionRepository(t)
+	auditRepoMock := mocks.NewAuditRepository(t)
+	eventPublisherMock := mocks.NewEventPublisher(t)
+	transactionManagerMock := mocks.NewTransactionManager(t)
+	transactionManagerMock.On("Transaction", mock.Anything, mock.Anything).Return(func(ctx context.Context, operation func(context.Context) error) error {
+		return operation(ctx)
+	}).Maybe()
 	return testCase{
-		PermissionRepo: permissionRepoMock,
-		UseCase:        NewCreatePermissionUseCase(permissionRepoMock, logger),
+		PermissionRepo:     permissionRepoMock,
+		AuditRepo:          auditRepoMock,
+		EventPublisher:     eventPublisherMock,
+		TransactionManager: transactionManagerMock,
+		UseCase:            NewCreatePermissionUseCase(permissionRepoMock, audit.NewChangeRecorder(auditRepoMock, eventPublisherMock), transactionManagerMock, logger),
 	}
 }
 
@@ -67,9 +81,13 @@ func TestExecutePermissionSaveError(t *testing.T) {
 func TestExecuteSuccess(t *testing.T) {
 	testCase := setUp(t)
 	testCase.PermissionRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+	testCase.AuditRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+	testCase.EventPublisher.On("Publish", mock.Anything, mock.Anything).Return(nil)
 	permissionName := "testPermission"
 	request := CreatePermissionRequest{
-		Name: permissionName,
+		Name:        permissionName,
+		Description: "Test description",
+		Service:     "Test service",
 	}
 	ctx := context.Background()
 
@@ -79,7 +97,79 @@ func TestExecuteSuccess(t *testing.T) {
 		t.Fatal("Expected use case to not return error")
 	}
 	expectedSavePermission := permission.Permission{
-		Name: permissionName,
+		Name:        permissionName,
+		Description: "Test description",
+		Service:     "Test service",
 	}
 	testCase.PermissionRepo.AssertCalled(t, "Save", ctx, expectedSavePermission)
 }
+
+func TestExecuteAuditSaveError(t *testing.T) {
+	testCase := setUp(t)
+	testCase.PermissionRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+	auditError := errors.New("Test audit error")
+	testCase.AuditRepo.On("Save", mock.Anything, mock.Anything).Return(auditError)
+	request := CreatePermissionRequest{
+		Name:        "testPermission",
+		Description: "Test description",
+		Service:     "Test service",
+	}
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != auditError {
+		t.Fatal("Error expected to be the same as the audit repository returned error")
+	}
+}
+
+func TestExecuteRecordsAuditEntry(t *testing.T) {
+	testCase := setUp(t)
+	testCase.PermissionRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+	testCase.AuditRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+	testCase.EventPublisher.On("Publish", mock.Anything, mock.Anything).Return(nil)
+	request := CreatePermissionRequest{
+		Name:        "testPermission",
+		Description: "Test description",
+		Service:     "Test service",
+	}
+	ctx := auth.WithActor(context.Background(), "actorEmail")
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != nil {
+		t.Fatal("Expected use case to not return error")
+	}
+	testCase.AuditRepo.AssertCalled(t, "Save", ctx, mock.MatchedBy(func(entry audit.AuditEntry) bool {
+		return entry.Actor == "actorEmail" &&
+			entry.Action == audit.CreatePermissionAction &&
+			entry.TargetType == audit.PermissionTargetType &&
+			entry.Target == "testPermission" &&
+			entry.Before == "null"
+	}))
+}
+
+func TestExecutePublishesPermissionCreatedEvent(t *testing.T) {
+	testCase := setUp(t)
+	testCase.PermissionRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+	testCase.AuditRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+	publishError := errors.New("Test publish error")
+	testCase.EventPublisher.On("Publish", mock.Anything, mock.Anything).Return(publishError)
+	request := CreatePermissionRequest{
+		Name:        "testPermission",
+		Description: "Test description",
+		Service:     "Test service",
+	}
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != publishError {
+		t.Fatal("Error expected to be the same as the event publisher returned error")
+	}
+	testCase.EventPublisher.AssertCalled(t, "Publish", ctx, permission.PermissionCreatedEvent{
+		Name:        "testPermission",
+		Description: "Test description",
+		Service:     "Test service",
+	})
+}
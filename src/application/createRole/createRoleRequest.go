@@ -1,6 +1,8 @@
 package createRole
 
 type CreateRoleRequest struct {
-	Name        string
-	Permissions []string
+	Name              string
+	Permissions       []string
+	DeniedPermissions []string
+	ParentNames       []string
 }
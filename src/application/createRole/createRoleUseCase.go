@@ -3,6 +3,7 @@ package createRole
 import (
 	"context"
 	"fmt"
+	"go-as/src/domain/audit"
 	"go-as/src/domain/internals"
 	"go-as/src/domain/permission"
 	"go-as/src/domain/role"
@@ -11,6 +12,8 @@ import (
 type CreateRoleUseCase struct {
 	roleRepository       role.RoleRepository
 	permissionRepository permission.PermissionRepository
+	changeRecorder       *audit.ChangeRecorder
+	transactionManager   internals.TransactionManager
 	logger               internals.Logger
 }
 
@@ -27,11 +30,45 @@ func (useCase *CreateRoleUseCase) Execute(ctx context.Context, request any) inte
 	if err != nil {
 		return internals.ErrorUseCaseResponse(err)
 	}
-	role := role.Role{
-		Name:        validatedRequest.Name,
-		Permissions: permissions,
+	deniedPermissions, err := useCase.findDeniedPermissions(ctx, validatedRequest.DeniedPermissions)
+	if err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+	parents, err := useCase.findParents(ctx, validatedRequest.ParentNames)
+	if err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+	createdRole := role.Role{
+		Name:              validatedRequest.Name,
+		Permissions:       permissions,
+		DeniedPermissions: deniedPermissions,
+		Parents:           parents,
 	}
-	if err = useCase.roleRepository.Save(ctx, role); err != nil {
+	if err = createdRole.CheckHierarchyCycles(); err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+	err = useCase.transactionManager.Transaction(ctx, func(ctx context.Context) error {
+		if err := useCase.roleRepository.Save(ctx, createdRole); err != nil {
+			return err
+		}
+		return useCase.changeRecorder.Record(ctx, audit.Change{
+			Action:     audit.CreateRoleAction,
+			TargetType: audit.RoleTargetType,
+			Target:     createdRole.Name,
+			After: map[string][]string{
+				"permissions":       permission.Names(createdRole.Permissions),
+				"deniedPermissions": permission.Names(createdRole.DeniedPermissions),
+				"parents":           role.Names(createdRole.Parents),
+			},
+			Event: role.RoleCreatedEvent{
+				Name:              createdRole.Name,
+				Permissions:       permission.Names(createdRole.Permissions),
+				DeniedPermissions: permission.Names(createdRole.DeniedPermissions),
+				Parents:           role.Names(createdRole.Parents),
+			},
+		})
+	})
+	if err != nil {
 		return internals.ErrorUseCaseResponse(err)
 	}
 	return internals.EmptyUseCaseResponse()
@@ -42,17 +79,53 @@ func (useCase *CreateRoleUseCase) findPermissions(ctx context.Context, permissio
 	if err != nil {
 		return nil, err
 	}
-	return permissions, nil
+	return permissions, checkPermissionsFound(permissionNames, permissions)
+}
+
+func (useCase *CreateRoleUseCase) findDeniedPermissions(ctx context.Context, permissionNames []string) ([]permission.Permission, error) {
+	if len(permissionNames) == 0 {
+		return nil, nil
+	}
+	return useCase.findPermissions(ctx, permissionNames)
+}
+
+func checkPermissionsFound(permissionNames []string, permissions []permission.Permission) error {
+	foundNames := make(map[string]bool, len(permissions))
+	for _, foundPermission := range permissions {
+		foundNames[foundPermission.Name] = true
+	}
+	for _, permissionName := range permissionNames {
+		if !foundNames[permissionName] {
+			return permission.PermissionNotFoundError{PermissionName: permissionName}
+		}
+	}
+	return nil
+}
+
+func (useCase *CreateRoleUseCase) findParents(ctx context.Context, parentNames []string) ([]role.Role, error) {
+	if len(parentNames) == 0 {
+		return nil, nil
+	}
+	parents, err := useCase.roleRepository.FindByNames(ctx, parentNames)
+	if err != nil {
+		return nil, err
+	}
+	if len(parents) != len(parentNames) {
+		return nil, fmt.Errorf("parent roles %s not found", parentNames)
+	}
+	return parents, nil
 }
 
 func (*CreateRoleUseCase) RequiredPermissions() []string {
 	return []string{role.CreateRolePermission}
 }
 
-func NewCreateRoleUseCase(roleRepository role.RoleRepository, permissionRepository permission.PermissionRepository, logger internals.Logger) *CreateRoleUseCase {
+func NewCreateRoleUseCase(roleRepository role.RoleRepository, permissionRepository permission.PermissionRepository, changeRecorder *audit.ChangeRecorder, transactionManager internals.TransactionManager, logger internals.Logger) *CreateRoleUseCase {
 	useCase := CreateRoleUseCase{
 		roleRepository:       roleRepository,
 		permissionRepository: permissionRepository,
+		changeRecorder:       changeRecorder,
+		transactionManager:   transactionManager,
 		logger:               logger,
 	}
 	return &useCase
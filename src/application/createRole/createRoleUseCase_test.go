@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"go-as/mocks"
+	"go-as/src/domain/audit"
+	"go-as/src/domain/auth"
 	"go-as/src/domain/permission"
 	"go-as/src/domain/role"
 	"go-as/src/infrastructure/logging"
@@ -15,9 +17,12 @@ import (
 )
 
 type testCase struct {
-	PermissionRepo *mocks.PermissionRepository
-	RoleRepo       *mocks.RoleRepository
-	UseCase        *CreateRoleUseCase
+	PermissionRepo     *mocks.PermissionRepository
+	RoleRepo           *mocks.RoleRepository
+	AuditRepo          *mocks.AuditRepository
+	EventPublisher     *mocks.EventPublisher
+	TransactionManager *mocks.TransactionManager
+	UseCase            *CreateRoleUseCase
 }
 
 func setUp(t *testing.T) testCase {
@@ -25,10 +30,19 @@ func setUp(t *testing.T) testCase {
 	logger := logging.NewZapTracedLogger(tracer)
 	permissionRepoMock := mocks.NewPermissionRepository(t)
 	roleRepoMock := mocks.NewRoleRepository(t)
+	auditRepoMock := mocks.NewAuditRepository(t)
+	eventPublisherMock := mocks.NewEventPublisher(t)
+	transactionManagerMock := mocks.NewTransactionManager(t)
+	transactionManagerMock.On("Transaction", mock.Anything, mock.Anything).Return(func(ctx context.Context, operation func(context.Context) error) error {
+		return operation(ctx)
+	}).Maybe()
 	return testCase{
-		PermissionRepo: permissionRepoMock,
-		RoleRepo:       roleRepoMock,
-		UseCase:        NewCreateRoleUseCase(roleRepoMock, permissionRepoMock, logger),
+		PermissionRepo:     permissionRepoMock,
+		RoleRepo:           roleRepoMock,
+		AuditRepo:          auditRepoMock,
+		EventPublisher:     eventPublisherMock,
+		TransactionManager: transactionManagerMock,
+		UseCase:            NewCreateRoleUseCase(roleRepoMock, permissionRepoMock, audit.NewChangeRecorder(auditRepoMock, eventPublisherMock), transactionManagerMock, logger),
 	}
 }
 
@@ -103,6 +117,8 @@ func TestExecuteRoleSaveError(t *testing.T) {
 func TestExecuteRoleSaveSuccess(t *testing.T) {
 	testCase := setUp(t)
 	testCase.RoleRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+	testCase.AuditRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+	testCase.EventPublisher.On("Publish", mock.Anything, mock.Anything).Return(nil)
 	permissionName := "Test permission"
 	permissions := []permission.Permission{{Name: permissionName}}
 	testCase.PermissionRepo.On("FindByNames", mock.Anything, mock.Anything).Return(permissions, nil)
@@ -124,3 +140,273 @@ func TestExecuteRoleSaveSuccess(t *testing.T) {
 		return role.Name == roleName && reflect.DeepEqual(role.Permissions, permissions)
 	}))
 }
+
+func TestExecuteRoleFindParentsError(t *testing.T) {
+	testCase := setUp(t)
+	findError := errors.New("Test find error")
+	permissionName := "Test permission"
+	permissions := []permission.Permission{{Name: permissionName}}
+	testCase.PermissionRepo.On("FindByNames", mock.Anything, mock.Anything).Return(permissions, nil)
+	testCase.RoleRepo.On("FindByNames", mock.Anything, mock.Anything).Return(nil, findError)
+	parentNames := []string{"Test parent"}
+	request := CreateRoleRequest{
+		Name:        "Test role",
+		Permissions: []string{permissionName},
+		ParentNames: parentNames,
+	}
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err == nil {
+		t.Fatal("Expected use case to return error")
+	}
+	if response.Err != findError {
+		t.Fatal("Error expected to be the same as the role repository returned error")
+	}
+	testCase.RoleRepo.AssertCalled(t, "FindByNames", ctx, parentNames)
+	testCase.RoleRepo.AssertNotCalled(t, "Save")
+}
+
+func TestExecuteRoleParentsNotFound(t *testing.T) {
+	testCase := setUp(t)
+	permissionName := "Test permission"
+	permissions := []permission.Permission{{Name: permissionName}}
+	testCase.PermissionRepo.On("FindByNames", mock.Anything, mock.Anything).Return(permissions, nil)
+	testCase.RoleRepo.On("FindByNames", mock.Anything, mock.Anything).Return([]role.Role{{Name: "Test parent 1"}}, nil)
+	parentNames := []string{"Test parent 1", "Test parent 2"}
+	request := CreateRoleRequest{
+		Name:        "Test role",
+		Permissions: []string{permissionName},
+		ParentNames: parentNames,
+	}
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err == nil {
+		t.Fatal("Expected use case to return error")
+	}
+	testCase.RoleRepo.AssertCalled(t, "FindByNames", ctx, parentNames)
+	testCase.RoleRepo.AssertNotCalled(t, "Save")
+}
+
+func TestExecuteRoleHierarchyCycle(t *testing.T) {
+	testCase := setUp(t)
+	roleName := "Test role"
+	permissionName := "Test permission"
+	permissions := []permission.Permission{{Name: permissionName}}
+	testCase.PermissionRepo.On("FindByNames", mock.Anything, mock.Anything).Return(permissions, nil)
+	parent := role.Role{
+		Name:    "Test parent",
+		Parents: []role.Role{{Name: "Test grandparent", Parents: []role.Role{{Name: roleName}}}},
+	}
+	testCase.RoleRepo.On("FindByNames", mock.Anything, mock.Anything).Return([]role.Role{parent}, nil)
+	request := CreateRoleRequest{
+		Name:        roleName,
+		Permissions: []string{permissionName},
+		ParentNames: []string{parent.Name},
+	}
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err == nil {
+		t.Fatal("Expected use case to return error")
+	}
+	if _, isCycleError := response.Err.(role.RoleHierarchyCycleError); !isCycleError {
+		t.Fatal("Expected use case to return a role hierarchy cycle error")
+	}
+	testCase.RoleRepo.AssertNotCalled(t, "Save")
+}
+
+func TestExecuteRoleWithParentsSaveSuccess(t *testing.T) {
+	testCase := setUp(t)
+	testCase.RoleRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+	testCase.AuditRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+	testCase.EventPublisher.On("Publish", mock.Anything, mock.Anything).Return(nil)
+	permissionName := "Test permission"
+	permissions := []permission.Permission{{Name: permissionName}}
+	testCase.PermissionRepo.On("FindByNames", mock.Anything, mock.Anything).Return(permissions, nil)
+	parents := []role.Role{{Name: "Test parent", Parents: []role.Role{{Name: "Test grandparent"}}}}
+	testCase.RoleRepo.On("FindByNames", mock.Anything, mock.Anything).Return(parents, nil)
+	roleName := "Test role"
+	request := CreateRoleRequest{
+		Name:        roleName,
+		Permissions: []string{permissionName},
+		ParentNames: []string{"Test parent"},
+	}
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != nil {
+		t.Fatal("Expected use case not to return error")
+	}
+	testCase.RoleRepo.AssertCalled(t, "Save", ctx, mock.MatchedBy(func(role role.Role) bool {
+		return role.Name == roleName && reflect.DeepEqual(role.Permissions, permissions) && reflect.DeepEqual(role.Parents, parents)
+	}))
+}
+
+func TestExecuteRoleDeniedPermissionsNotFound(t *testing.T) {
+	testCase := setUp(t)
+	permissionName := "Test permission"
+	permissions := []permission.Permission{{Name: permissionName}}
+	deniedPermissionNames := []string{"Test denied permission 1", "Test denied permission 2"}
+	testCase.PermissionRepo.On("FindByNames", mock.Anything, []string{permissionName}).Return(permissions, nil)
+	testCase.PermissionRepo.On("FindByNames", mock.Anything, deniedPermissionNames).Return([]permission.Permission{{Name: "Test denied permission 1"}}, nil)
+	request := CreateRoleRequest{
+		Name:              "Test role",
+		Permissions:       []string{permissionName},
+		DeniedPermissions: deniedPermissionNames,
+	}
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err == nil {
+		t.Fatal("Expected use case to return error")
+	}
+	if _, ok := response.Err.(permission.PermissionNotFoundError); !ok {
+		t.Fatal("Error expected to be PermissionNotFoundError")
+	}
+	testCase.PermissionRepo.AssertCalled(t, "FindByNames", ctx, deniedPermissionNames)
+	testCase.RoleRepo.AssertNotCalled(t, "Save")
+}
+
+func TestExecuteRolePermissionsNotFound(t *testing.T) {
+	testCase := setUp(t)
+	permissionNames := []string{"Test permission 1", "Test permission 2"}
+	testCase.PermissionRepo.On("FindByNames", mock.Anything, permissionNames).Return([]permission.Permission{{Name: "Test permission 1"}}, nil)
+	request := CreateRoleRequest{
+		Name:        "Test role",
+		Permissions: permissionNames,
+	}
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if err, ok := response.Err.(permission.PermissionNotFoundError); !ok || err.PermissionName != "Test permission 2" {
+		t.Fatal("Expected missing permission to be reported as PermissionNotFoundError")
+	}
+	testCase.RoleRepo.AssertNotCalled(t, "Save")
+}
+
+func TestExecuteRoleAlreadyExists(t *testing.T) {
+	testCase := setUp(t)
+	permissions := []permission.Permission{{Name: "Test permission"}}
+	testCase.PermissionRepo.On("FindByNames", mock.Anything, mock.Anything).Return(permissions, nil)
+	testCase.RoleRepo.On("Save", mock.Anything, mock.Anything).Return(role.RoleAlreadyExistsError{RoleName: "Test role"})
+	request := CreateRoleRequest{
+		Name:        "Test role",
+		Permissions: []string{"Test permission"},
+	}
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if _, ok := response.Err.(role.RoleAlreadyExistsError); !ok {
+		t.Fatal("Error expected to be RoleAlreadyExistsError")
+	}
+	testCase.AuditRepo.AssertNotCalled(t, "Save")
+}
+
+func TestExecuteRoleWithDeniedPermissionsSaveSuccess(t *testing.T) {
+	testCase := setUp(t)
+	testCase.RoleRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+	testCase.AuditRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+	testCase.EventPublisher.On("Publish", mock.Anything, mock.Anything).Return(nil)
+	permissionName := "Test permission"
+	permissions := []permission.Permission{{Name: permissionName}}
+	deniedPermissionName := "Test denied permission"
+	deniedPermissions := []permission.Permission{{Name: deniedPermissionName}}
+	testCase.PermissionRepo.On("FindByNames", mock.Anything, []string{permissionName}).Return(permissions, nil)
+	testCase.PermissionRepo.On("FindByNames", mock.Anything, []string{deniedPermissionName}).Return(deniedPermissions, nil)
+	roleName := "Test role"
+	request := CreateRoleRequest{
+		Name:              roleName,
+		Permissions:       []string{permissionName},
+		DeniedPermissions: []string{deniedPermissionName},
+	}
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != nil {
+		t.Fatal("Expected use case not to return error")
+	}
+	testCase.RoleRepo.AssertCalled(t, "Save", ctx, mock.MatchedBy(func(role role.Role) bool {
+		return role.Name == roleName && reflect.DeepEqual(role.Permissions, permissions) && reflect.DeepEqual(role.DeniedPermissions, deniedPermissions)
+	}))
+}
+
+func TestExecuteRoleAuditSaveError(t *testing.T) {
+	testCase := setUp(t)
+	testCase.RoleRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+	auditError := errors.New("Test audit error")
+	testCase.AuditRepo.On("Save", mock.Anything, mock.Anything).Return(auditError)
+	testCase.PermissionRepo.On("FindByNames", mock.Anything, mock.Anything).Return([]permission.Permission{{Name: "Test permission"}}, nil)
+	request := CreateRoleRequest{
+		Name:        "Test role",
+		Permissions: []string{"Test permission"},
+	}
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != auditError {
+		t.Fatal("Error expected to be the same as the audit repository returned error")
+	}
+}
+
+func TestExecuteRoleRecordsAuditEntry(t *testing.T) {
+	testCase := setUp(t)
+	testCase.RoleRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+	testCase.AuditRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+	testCase.EventPublisher.On("Publish", mock.Anything, mock.Anything).Return(nil)
+	testCase.PermissionRepo.On("FindByNames", mock.Anything, mock.Anything).Return([]permission.Permission{{Name: "Test permission"}}, nil)
+	request := CreateRoleRequest{
+		Name:        "Test role",
+		Permissions: []string{"Test permission"},
+	}
+	ctx := auth.WithActor(context.Background(), "actorEmail")
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != nil {
+		t.Fatal("Expected use case not to return error")
+	}
+	testCase.AuditRepo.AssertCalled(t, "Save", ctx, mock.MatchedBy(func(entry audit.AuditEntry) bool {
+		return entry.Actor == "actorEmail" &&
+			entry.Action == audit.CreateRoleAction &&
+			entry.TargetType == audit.RoleTargetType &&
+			entry.Target == "Test role" &&
+			entry.After == `{"deniedPermissions":[],"parents":[],"permissions":["Test permission"]}`
+	}))
+}
+
+func TestExecuteRolePublishesRoleCreatedEvent(t *testing.T) {
+	testCase := setUp(t)
+	testCase.RoleRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+	testCase.AuditRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+	publishError := errors.New("Test publish error")
+	testCase.EventPublisher.On("Publish", mock.Anything, mock.Anything).Return(publishError)
+	testCase.PermissionRepo.On("FindByNames", mock.Anything, mock.Anything).Return([]permission.Permission{{Name: "Test permission"}}, nil)
+	request := CreateRoleRequest{
+		Name:        "Test role",
+		Permissions: []string{"Test permission"},
+	}
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != publishError {
+		t.Fatal("Error expected to be the same as the event publisher returned error")
+	}
+	testCase.EventPublisher.AssertCalled(t, "Publish", ctx, role.RoleCreatedEvent{
+		Name:              "Test role",
+		Permissions:       []string{"Test permission"},
+		DeniedPermissions: []string{},
+		Parents:           []string{},
+	})
+}
@@ -3,13 +3,16 @@ package createUser
 import (
 	"context"
 	"fmt"
+	"go-as/src/domain/audit"
 	"go-as/src/domain/internals"
 	"go-as/src/domain/user"
 )
 
 type CreateUserUseCase struct {
-	userRepository user.UserRepository
-	logger         internals.Logger
+	userRepository     user.UserRepository
+	changeRecorder     *audit.ChangeRecorder
+	transactionManager internals.TransactionManager
+	logger             internals.Logger
 }
 
 func (useCase *CreateUserUseCase) Execute(ctx context.Context, request any) internals.UseCaseResponse {
@@ -21,11 +24,34 @@ func (useCase *CreateUserUseCase) Execute(ctx context.Context, request any) inte
 	useCase.logger.Info(ctx, fmt.Sprintf("Starting user creation for %s", validatedRequest.Email))
 	defer useCase.logger.Info(ctx, fmt.Sprintf("Finished user creation for %s", validatedRequest.Email))
 
-	user := user.User{
+	createdUser := user.User{
 		Email:     validatedRequest.Email,
 		Superuser: validatedRequest.Superuser,
 	}
-	if err := useCase.userRepository.Save(ctx, user); err != nil {
+	err := useCase.transactionManager.Transaction(ctx, func(ctx context.Context) error {
+		existingUser, err := useCase.userRepository.FindByEmail(ctx, createdUser.Email)
+		if err != nil {
+			return err
+		}
+		if existingUser != nil {
+			useCase.logger.Info(ctx, fmt.Sprintf("User %s already exists, keeping it unchanged", createdUser.Email))
+			return nil
+		}
+		if err := useCase.userRepository.Save(ctx, createdUser); err != nil {
+			return err
+		}
+		return useCase.changeRecorder.Record(ctx, audit.Change{
+			Action:     audit.CreateUserAction,
+			TargetType: audit.UserTargetType,
+			Target:     createdUser.Email,
+			After:      map[string]bool{"superuser": createdUser.Superuser},
+			Event: user.UserProvisionedEvent{
+				Email:     createdUser.Email,
+				Superuser: createdUser.Superuser,
+			},
+		})
+	})
+	if err != nil {
 		return internals.ErrorUseCaseResponse(err)
 	}
 	return internals.EmptyUseCaseResponse()
@@ -35,10 +61,12 @@ func (*CreateUserUseCase) RequiredPermissions() []string {
 	return []string{}
 }
 
-func NewCreateUserUseCase(userRepository user.UserRepository, logger internals.Logger) *CreateUserUseCase {
+func NewCreateUserUseCase(userRepository user.UserRepository, changeRecorder *audit.ChangeRecorder, transactionManager internals.TransactionManager, logger internals.Logger) *CreateUserUseCase {
 	useCase := CreateUserUseCase{
-		userRepository: userRepository,
-		logger:         logger,
+		userRepository:     userRepository,
+		changeRecorder:     changeRecorder,
+		transactionManager: transactionManager,
+		logger:             logger,
 	}
 	return &useCase
 }
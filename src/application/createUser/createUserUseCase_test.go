@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"go-as/mocks"
+	"go-as/src/domain/audit"
 	"go-as/src/domain/user"
 	"go-as/src/infrastructure/logging"
 	"testing"
@@ -13,17 +14,31 @@ import (
 )
 
 type testCase struct {
-	UserRepo *mocks.UserRepository
-	UseCase  *CreateUserUseCase
+	UserRepo           *mocks.UserRepository
+	AuditRepo          *mocks.AuditRepository
+	EventPublisher     *mocks.EventPublisher
+	TransactionManager *mocks.TransactionManager
+	UseCase            *CreateUserUseCase
 }
 
 func setUp(t *testing.T) testCase {
 	userRepositoryMock := mocks.NewUserRepository(t)
 	tracer := apm.DefaultTracer()
 	logger := logging.NewZapTracedLogger(tracer)
+	auditRepoMock := mocks.NewAuditRepository(t)
+	auditRepoMock.On("Save", mock.Anything, mock.Anything).Return(nil).Maybe()
+	eventPublisherMock := mocks.NewEventPublisher(t)
+	eventPublisherMock.On("Publish", mock.Anything, mock.Anything).Return(nil).Maybe()
+	transactionManagerMock := mocks.NewTransactionManager(t)
+	transactionManagerMock.On("Transaction", mock.Anything, mock.Anything).Return(func(ctx context.Context, operation func(context.Context) error) error {
+		return operation(ctx)
+	}).Maybe()
 	return testCase{
-		UserRepo: userRepositoryMock,
-		UseCase:  NewCreateUserUseCase(userRepositoryMock, logger),
+		UserRepo:           userRepositoryMock,
+		AuditRepo:          auditRepoMock,
+		EventPublisher:     eventPublisherMock,
+		TransactionManager: transactionManagerMock,
+		UseCase:            NewCreateUserUseCase(userRepositoryMock, audit.NewChangeRecorder(auditRepoMock, eventPublisherMock), transactionManagerMock, logger),
 	}
 }
 
@@ -40,9 +55,46 @@ func TestExecuteWrongRequest(t *testing.T) {
 	testCase.UserRepo.AssertNotCalled(t, "Save")
 }
 
+func TestExecuteFindByEmailError(t *testing.T) {
+	testCase := setUp(t)
+	findError := errors.New("Test find error")
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(nil, findError)
+	request := &CreateUserRequest{
+		Email: "testEmail",
+	}
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, request)
+
+	if response.Err != findError {
+		t.Fatal("Expected use case to return user repository find error")
+	}
+	testCase.UserRepo.AssertNotCalled(t, "Save")
+}
+
+func TestExecuteExistingUserIsKept(t *testing.T) {
+	testCase := setUp(t)
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(&user.User{Email: "testEmail", Superuser: false, Version: 3}, nil)
+	request := &CreateUserRequest{
+		Email:     "testEmail",
+		Superuser: true,
+	}
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, request)
+
+	if response.Err != nil {
+		t.Fatal("Expected use case not to return error")
+	}
+	testCase.UserRepo.AssertNotCalled(t, "Save")
+	testCase.AuditRepo.AssertNotCalled(t, "Save")
+	testCase.EventPublisher.AssertNotCalled(t, "Publish")
+}
+
 func TestExecuteSaveError(t *testing.T) {
 	testCase := setUp(t)
 	saveError := errors.New("Test save error")
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(nil, nil)
 	testCase.UserRepo.On("Save", mock.Anything, mock.Anything).Return(saveError)
 	testIsSuperuser := false
 	testEmail := "testEmail"
@@ -67,6 +119,7 @@ func TestExecuteSaveError(t *testing.T) {
 
 func TestExecuteSuccess(t *testing.T) {
 	testCase := setUp(t)
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(nil, nil)
 	testCase.UserRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
 	testIsSuperuser := false
 	testEmail := "testEmail"
@@ -84,4 +137,8 @@ func TestExecuteSuccess(t *testing.T) {
 	testCase.UserRepo.AssertCalled(t, "Save", ctx, mock.MatchedBy(func(user user.User) bool {
 		return user.Email == testEmail && user.Superuser == testIsSuperuser
 	}))
+	testCase.AuditRepo.AssertCalled(t, "Save", mock.Anything, mock.MatchedBy(func(entry audit.AuditEntry) bool {
+		return entry.Action == audit.CreateUserAction
+	}))
+	testCase.EventPublisher.AssertCalled(t, "Publish", mock.Anything, mock.AnythingOfType("user.UserProvisionedEvent"))
 }
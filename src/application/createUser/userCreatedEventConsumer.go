@@ -11,54 +11,48 @@ import (
 )
 
 type UserCreatedEventConsumer struct {
+	eventName         string
 	eventListener     events.EventListener
+	eventDeduplicator *events.EventDeduplicator
+	eventOrdering     *events.EventOrdering
 	createUserUseCase *CreateUserUseCase
 	logger            *zap.Logger
 }
 
 func (consumer *UserCreatedEventConsumer) Consume() error {
-	eventMapChannel := make(chan map[string]interface{})
-	err := consumer.eventListener.Listen(eventMapChannel)
-	if err != nil {
-		return err
-	}
-	go consumer.consumeEventMaps(eventMapChannel)
-	return nil
+	return consumer.eventListener.Listen(consumer.eventDeduplicator.Deduplicate(consumer.eventName, events.HandleTypedEnvelope(consumer.handleEvent)))
 }
 
-func (consumer *UserCreatedEventConsumer) consumeEventMaps(eventMapChannel chan map[string]interface{}) {
-	for eventMap := range eventMapChannel {
-		err := consumer.handleEventMap(eventMap)
-		if err != nil {
-			consumer.logger.Warn(fmt.Sprintf("Error handling event: %s", err.Error()))
+func (consumer *UserCreatedEventConsumer) handleEvent(ctx context.Context, envelope events.EventEnvelope, event *user.UserCreatedEvent) error {
+	orderingKey := user.EventOrderingKey(event.Email)
+	applied, err := consumer.eventOrdering.Apply(ctx, envelope.OccurredAt, []string{orderingKey}, func(ctx context.Context, positions map[string]*events.EventPosition) error {
+		createUserRequest := CreateUserRequest{
+			Email:     event.Email,
+			Superuser: event.Superuser,
 		}
+		if useCaseResponse := consumer.createUserUseCase.Execute(ctx, &createUserRequest); useCaseResponse.Err != nil {
+			return useCaseResponse.Err
+		}
+		positions[orderingKey].Deleted = false
+		return nil
+	})
+	if err == nil && !applied {
+		consumer.logger.Info(fmt.Sprintf("Skipped stale %s %s, a newer event was already applied to user %s", consumer.eventName, envelope.ID, event.Email))
 	}
+	return err
 }
 
-func (consumer *UserCreatedEventConsumer) handleEventMap(eventMap map[string]interface{}) error {
-	event, err := user.UserCreatedEventFromMap(eventMap)
-	if err != nil {
-		return err
-	}
-	createUserRequest := CreateUserRequest{
-		Email:     event.Email,
-		Superuser: event.Superuser,
-	}
-	context := context.Background()
-	if useCaseResponse := consumer.createUserUseCase.Execute(context, &createUserRequest); useCaseResponse.Err != nil {
-		return useCaseResponse.Err
-	}
-	return nil
-}
-
-func NewUserCreatedEventConsumer(eventListenerFactory events.EventListenerFactory, useCase *CreateUserUseCase, logger *zap.Logger) *UserCreatedEventConsumer {
+func NewUserCreatedEventConsumer(eventListenerFactory events.EventListenerFactory, eventDeduplicator *events.EventDeduplicator, eventOrdering *events.EventOrdering, useCase *CreateUserUseCase, logger *zap.Logger) *UserCreatedEventConsumer {
 	eventName := reflect.TypeOf(user.UserCreatedEvent{}).Name()
 	eventListener, err := eventListenerFactory.CreateListener(eventName)
 	if err != nil {
 		logger.Fatal(fmt.Sprintf("Error creating listener %s: %s", eventName, err.Error()))
 	}
 	return &UserCreatedEventConsumer{
+		eventName:         eventName,
 		eventListener:     eventListener,
+		eventDeduplicator: eventDeduplicator,
+		eventOrdering:     eventOrdering,
 		createUserUseCase: useCase,
 		logger:            logger,
 	}
@@ -0,0 +1,6 @@
+package deletePermission
+
+type DeletePermissionRequest struct {
+	Name  string
+	Force bool
+}
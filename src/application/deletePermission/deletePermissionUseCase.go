@@ -0,0 +1,75 @@
+package deletePermission
+
+import (
+	"context"
+	"fmt"
+	"go-as/src/domain/audit"
+	"go-as/src/domain/internals"
+	"go-as/src/domain/permission"
+)
+
+type DeletePermissionUseCase struct {
+	permissionRepository permission.PermissionRepository
+	changeRecorder       *audit.ChangeRecorder
+	transactionManager   internals.TransactionManager
+	logger               internals.Logger
+}
+
+func (useCase *DeletePermissionUseCase) Execute(ctx context.Context, request any) internals.UseCaseResponse {
+	validatedRequest, errResponse := internals.ValidateUseCaseRequest[*DeletePermissionRequest](request)
+	if errResponse != nil {
+		return *errResponse
+	}
+
+	useCase.logger.Info(ctx, fmt.Sprintf("Starting deletion of permission %s", validatedRequest.Name))
+	defer useCase.logger.Info(ctx, fmt.Sprintf("Finished deletion of permission %s", validatedRequest.Name))
+
+	permissions, err := useCase.permissionRepository.FindByNames(ctx, []string{validatedRequest.Name})
+	if err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+	if len(permissions) == 0 {
+		return internals.ErrorUseCaseResponse(permission.PermissionNotFoundError{PermissionName: validatedRequest.Name})
+	}
+	if !validatedRequest.Force {
+		references, err := useCase.permissionRepository.CountReferences(ctx, validatedRequest.Name)
+		if err != nil {
+			return internals.ErrorUseCaseResponse(err)
+		}
+		if references > 0 {
+			return internals.ErrorUseCaseResponse(permission.PermissionInUseError{
+				PermissionName: validatedRequest.Name,
+				References:     references,
+			})
+		}
+	}
+	err = useCase.transactionManager.Transaction(ctx, func(ctx context.Context) error {
+		if err := useCase.permissionRepository.Delete(ctx, validatedRequest.Name); err != nil {
+			return err
+		}
+		return useCase.changeRecorder.Record(ctx, audit.Change{
+			Action:     audit.DeletePermissionAction,
+			TargetType: audit.PermissionTargetType,
+			Target:     validatedRequest.Name,
+			Before:     permissions[0],
+			Event:      permission.PermissionDeletedEvent{Name: validatedRequest.Name},
+		})
+	})
+	if err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+	return internals.EmptyUseCaseResponse()
+}
+
+func (*DeletePermissionUseCase) RequiredPermissions() []string {
+	return []string{permission.DeletePermissionPermission}
+}
+
+func NewDeletePermissionUseCase(permissionRepository permission.PermissionRepository, changeRecorder *audit.ChangeRecorder, transactionManager internals.TransactionManager, logger internals.Logger) *DeletePermissionUseCase {
+	return &DeletePermissionUseCase{
+		permissionRepository: permissionRepository,
+		changeRecorder:       changeRecorder,
+		transactionManager:   transactionManager,
+		logger:               logger,
+	}
+}
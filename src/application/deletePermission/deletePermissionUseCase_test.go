@@ -0,0 +1,142 @@
+package deletePermission
+
+import (
+	"context"
+	"errors"
+	"go-as/mocks"
+	"go-as/src/domain/audit"
+	"go-as/src/domain/permission"
+	"go-as/src/infrastructure/logging"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"go.elastic.co/apm/v2"
+)
+
+type testCase struct {
+	PermissionRepo     *mocks.PermissionRepository
+	AuditRepo          *mocks.AuditRepository
+	EventPublisher     *mocks.EventPublisher
+	TransactionManager *mocks.TransactionManager
+	UseCase            *DeletePermissionUseCase
+}
+
+func setUp(t *testing.T) testCase {
+	tracer := apm.DefaultTracer()
+	logger := logging.NewZapTracedLogger(tracer)
+	permissionRepoMock := mocks.NewPermissionRepository(t)
+	auditRepoMock := mocks.NewAuditRepository(t)
+	auditRepoMock.On("Save", mock.Anything, mock.Anything).Return(nil).Maybe()
+	eventPublisherMock := mocks.NewEventPublisher(t)
+	eventPublisherMock.On("Publish", mock.Anything, mock.Anything).Return(nil).Maybe()
+	transactionManagerMock := mocks.NewTransactionManager(t)
+	transactionManagerMock.On("Transaction", mock.Anything, mock.Anything).Return(func(ctx context.Context, operation func(context.Context) error) error {
+		return operation(ctx)
+	}).Maybe()
+	return testCase{
+		PermissionRepo:     permissionRepoMock,
+		AuditRepo:          auditRepoMock,
+		EventPublisher:     eventPublisherMock,
+		TransactionManager: transactionManagerMock,
+		UseCase:            NewDeletePermissionUseCase(permissionRepoMock, audit.NewChangeRecorder(auditRepoMock, eventPublisherMock), transactionManagerMock, logger),
+	}
+}
+
+func TestExecuteWrongRequest(t *testing.T) {
+	testCase := setUp(t)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, "wrongRequest")
+
+	if response.Err == nil {
+		t.Fatal("Expected use case to return error")
+	}
+	testCase.PermissionRepo.AssertNotCalled(t, "Delete")
+}
+
+func TestExecutePermissionNotFound(t *testing.T) {
+	testCase := setUp(t)
+	testCase.PermissionRepo.On("FindByNames", mock.Anything, mock.Anything).Return([]permission.Permission{}, nil)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &DeletePermissionRequest{Name: "TestPermission"})
+
+	if _, ok := response.Err.(permission.PermissionNotFoundError); !ok {
+		t.Fatal("Expected use case to return permission not found error")
+	}
+	testCase.PermissionRepo.AssertNotCalled(t, "Delete")
+}
+
+func TestExecutePermissionInUse(t *testing.T) {
+	testCase := setUp(t)
+	permissionName := "TestPermission"
+	testCase.PermissionRepo.On("FindByNames", mock.Anything, mock.Anything).Return([]permission.Permission{{Name: permissionName}}, nil)
+	testCase.PermissionRepo.On("CountReferences", mock.Anything, mock.Anything).Return(int64(2), nil)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &DeletePermissionRequest{Name: permissionName})
+
+	inUseError, ok := response.Err.(permission.PermissionInUseError)
+	if !ok {
+		t.Fatal("Expected use case to return permission in use error")
+	}
+	if inUseError.References != 2 {
+		t.Fatal("Expected permission in use error to carry the references count")
+	}
+	testCase.PermissionRepo.AssertCalled(t, "CountReferences", ctx, permissionName)
+	testCase.PermissionRepo.AssertNotCalled(t, "Delete")
+}
+
+func TestExecuteCountReferencesError(t *testing.T) {
+	testCase := setUp(t)
+	countError := errors.New("Test count error")
+	testCase.PermissionRepo.On("FindByNames", mock.Anything, mock.Anything).Return([]permission.Permission{{Name: "TestPermission"}}, nil)
+	testCase.PermissionRepo.On("CountReferences", mock.Anything, mock.Anything).Return(int64(0), countError)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &DeletePermissionRequest{Name: "TestPermission"})
+
+	if response.Err != countError {
+		t.Fatal("Error expected to be the same as the permission repository returned error")
+	}
+	testCase.PermissionRepo.AssertNotCalled(t, "Delete")
+}
+
+func TestExecuteUnreferencedSuccess(t *testing.T) {
+	testCase := setUp(t)
+	permissionName := "TestPermission"
+	testCase.PermissionRepo.On("FindByNames", mock.Anything, mock.Anything).Return([]permission.Permission{{Name: permissionName}}, nil)
+	testCase.PermissionRepo.On("CountReferences", mock.Anything, mock.Anything).Return(int64(0), nil)
+	testCase.PermissionRepo.On("Delete", mock.Anything, mock.Anything).Return(nil)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &DeletePermissionRequest{Name: permissionName})
+
+	if response.Err != nil {
+		t.Fatal("Expected use case not to return error")
+	}
+	testCase.PermissionRepo.AssertCalled(t, "Delete", ctx, permissionName)
+	testCase.AuditRepo.AssertCalled(t, "Save", mock.Anything, mock.MatchedBy(func(entry audit.AuditEntry) bool {
+		return entry.Action == audit.DeletePermissionAction
+	}))
+	testCase.EventPublisher.AssertCalled(t, "Publish", mock.Anything, mock.AnythingOfType("permission.PermissionDeletedEvent"))
+}
+
+func TestExecuteForcedSuccess(t *testing.T) {
+	testCase := setUp(t)
+	permissionName := "TestPermission"
+	testCase.PermissionRepo.On("FindByNames", mock.Anything, mock.Anything).Return([]permission.Permission{{Name: permissionName}}, nil)
+	testCase.PermissionRepo.On("Delete", mock.Anything, mock.Anything).Return(nil)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &DeletePermissionRequest{Name: permissionName, Force: true})
+
+	if response.Err != nil {
+		t.Fatal("Expected use case not to return error")
+	}
+	testCase.PermissionRepo.AssertNotCalled(t, "CountReferences")
+	testCase.PermissionRepo.AssertCalled(t, "Delete", ctx, permissionName)
+	testCase.AuditRepo.AssertCalled(t, "Save", mock.Anything, mock.MatchedBy(func(entry audit.AuditEntry) bool {
+		return entry.Action == audit.DeletePermissionAction
+	}))
+}
@@ -0,0 +1,6 @@
+package deleteRole
+
+type DeleteRoleRequest struct {
+	Name            string
+	ExpectedVersion *int64
+}
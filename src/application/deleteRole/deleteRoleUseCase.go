@@ -0,0 +1,72 @@
+package deleteRole
+
+import (
+	"context"
+	"fmt"
+	"go-as/src/domain/audit"
+	"go-as/src/domain/internals"
+	"go-as/src/domain/permission"
+	"go-as/src/domain/role"
+)
+
+type DeleteRoleUseCase struct {
+	roleRepository     role.RoleRepository
+	changeRecorder     *audit.ChangeRecorder
+	transactionManager internals.TransactionManager
+	logger             internals.Logger
+}
+
+func (useCase *DeleteRoleUseCase) Execute(ctx context.Context, request any) internals.UseCaseResponse {
+	validatedRequest, errResponse := internals.ValidateUseCaseRequest[*DeleteRoleRequest](request)
+	if errResponse != nil {
+		return *errResponse
+	}
+
+	useCase.logger.Info(ctx, fmt.Sprintf("Starting deletion of role %s", validatedRequest.Name))
+	defer useCase.logger.Info(ctx, fmt.Sprintf("Finished deletion of role %s", validatedRequest.Name))
+
+	roles, err := useCase.roleRepository.FindByNames(ctx, []string{validatedRequest.Name})
+	if err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+	if len(roles) == 0 {
+		return internals.ErrorUseCaseResponse(role.RoleNotFoundError{RoleName: validatedRequest.Name})
+	}
+	if err = roles[0].CheckVersion(validatedRequest.ExpectedVersion); err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+	deletedRole := roles[0]
+	err = useCase.transactionManager.Transaction(ctx, func(ctx context.Context) error {
+		if err := useCase.roleRepository.Delete(ctx, deletedRole.Name); err != nil {
+			return err
+		}
+		return useCase.changeRecorder.Record(ctx, audit.Change{
+			Action:     audit.DeleteRoleAction,
+			TargetType: audit.RoleTargetType,
+			Target:     deletedRole.Name,
+			Before: map[string][]string{
+				"permissions":       permission.Names(deletedRole.Permissions),
+				"deniedPermissions": permission.Names(deletedRole.DeniedPermissions),
+				"parents":           role.Names(deletedRole.Parents),
+			},
+			Event: role.RoleDeletedEvent{Name: deletedRole.Name},
+		})
+	})
+	if err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+	return internals.EmptyUseCaseResponse()
+}
+
+func (*DeleteRoleUseCase) RequiredPermissions() []string {
+	return []string{role.DeleteRolePermission}
+}
+
+func NewDeleteRoleUseCase(roleRepository role.RoleRepository, changeRecorder *audit.ChangeRecorder, transactionManager internals.TransactionManager, logger internals.Logger) *DeleteRoleUseCase {
+	return &DeleteRoleUseCase{
+		roleRepository:     roleRepository,
+		changeRecorder:     changeRecorder,
+		transactionManager: transactionManager,
+		logger:             logger,
+	}
+}
@@ -0,0 +1,115 @@
+package deleteRole
+
+import (
+	"context"
+	"errors"
+	"go-as/mocks"
+	"go-as/src/domain/audit"
+	"go-as/src/domain/role"
+	"go-as/src/infrastructure/logging"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"go.elastic.co/apm/v2"
+)
+
+type testCase struct {
+	RoleRepo           *mocks.RoleRepository
+	AuditRepo          *mocks.AuditRepository
+	EventPublisher     *mocks.EventPublisher
+	TransactionManager *mocks.TransactionManager
+	UseCase            *DeleteRoleUseCase
+}
+
+func setUp(t *testing.T) testCase {
+	tracer := apm.DefaultTracer()
+	logger := logging.NewZapTracedLogger(tracer)
+	roleRepoMock := mocks.NewRoleRepository(t)
+	auditRepoMock := mocks.NewAuditRepository(t)
+	auditRepoMock.On("Save", mock.Anything, mock.Anything).Return(nil).Maybe()
+	eventPublisherMock := mocks.NewEventPublisher(t)
+	eventPublisherMock.On("Publish", mock.Anything, mock.Anything).Return(nil).Maybe()
+	transactionManagerMock := mocks.NewTransactionManager(t)
+	transactionManagerMock.On("Transaction", mock.Anything, mock.Anything).Return(func(ctx context.Context, operation func(context.Context) error) error {
+		return operation(ctx)
+	}).Maybe()
+	return testCase{
+		RoleRepo:           roleRepoMock,
+		AuditRepo:          auditRepoMock,
+		EventPublisher:     eventPublisherMock,
+		TransactionManager: transactionManagerMock,
+		UseCase:            NewDeleteRoleUseCase(roleRepoMock, audit.NewChangeRecorder(auditRepoMock, eventPublisherMock), transactionManagerMock, logger),
+	}
+}
+
+func TestExecuteWrongRequest(t *testing.T) {
+	testCase := setUp(t)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, "wrongRequest")
+
+	if response.Err == nil {
+		t.Fatal("Expected use case to return error")
+	}
+	testCase.RoleRepo.AssertNotCalled(t, "Delete")
+}
+
+func TestExecuteRoleNotFound(t *testing.T) {
+	testCase := setUp(t)
+	testCase.RoleRepo.On("FindByNames", mock.Anything, mock.Anything).Return([]role.Role{}, nil)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &DeleteRoleRequest{Name: "Test role"})
+
+	if _, ok := response.Err.(role.RoleNotFoundError); !ok {
+		t.Fatal("Expected use case to return role not found error")
+	}
+	testCase.RoleRepo.AssertNotCalled(t, "Delete")
+}
+
+func TestExecuteDeleteError(t *testing.T) {
+	testCase := setUp(t)
+	deleteError := errors.New("Test delete error")
+	testCase.RoleRepo.On("FindByNames", mock.Anything, mock.Anything).Return([]role.Role{{Name: "Test role"}}, nil)
+	testCase.RoleRepo.On("Delete", mock.Anything, mock.Anything).Return(deleteError)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &DeleteRoleRequest{Name: "Test role"})
+
+	if response.Err != deleteError {
+		t.Fatal("Error expected to be the same as the role repository returned error")
+	}
+}
+
+func TestExecuteSuccess(t *testing.T) {
+	testCase := setUp(t)
+	roleName := "Test role"
+	testCase.RoleRepo.On("FindByNames", mock.Anything, mock.Anything).Return([]role.Role{{Name: roleName}}, nil)
+	testCase.RoleRepo.On("Delete", mock.Anything, mock.Anything).Return(nil)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &DeleteRoleRequest{Name: roleName})
+
+	if response.Err != nil {
+		t.Fatal("Expected use case not to return error")
+	}
+	testCase.RoleRepo.AssertCalled(t, "Delete", ctx, roleName)
+	testCase.AuditRepo.AssertCalled(t, "Save", mock.Anything, mock.MatchedBy(func(entry audit.AuditEntry) bool {
+		return entry.Action == audit.DeleteRoleAction
+	}))
+	testCase.EventPublisher.AssertCalled(t, "Publish", mock.Anything, mock.AnythingOfType("role.RoleDeletedEvent"))
+}
+
+func TestExecuteVersionMismatch(t *testing.T) {
+	testCase := setUp(t)
+	testCase.RoleRepo.On("FindByNames", mock.Anything, mock.Anything).Return([]role.Role{{Name: "Test role", Version: 3}}, nil)
+	expectedVersion := int64(2)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &DeleteRoleRequest{Name: "Test role", ExpectedVersion: &expectedVersion})
+
+	if _, isMismatch := response.Err.(role.RoleVersionMismatchError); !isMismatch {
+		t.Fatal("Expected use case to return a version mismatch error")
+	}
+	testCase.RoleRepo.AssertNotCalled(t, "Delete")
+}
@@ -0,0 +1,6 @@
+package deleteUser
+
+type DeleteUserRequest struct {
+	Email           string
+	ExpectedVersion *int64
+}
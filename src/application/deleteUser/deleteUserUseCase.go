@@ -0,0 +1,74 @@
+package deleteUser
+
+import (
+	"context"
+	"fmt"
+	"go-as/src/domain/audit"
+	"go-as/src/domain/internals"
+	"go-as/src/domain/permission"
+	"go-as/src/domain/role"
+	"go-as/src/domain/user"
+)
+
+type DeleteUserUseCase struct {
+	userRepository     user.UserRepository
+	changeRecorder     *audit.ChangeRecorder
+	transactionManager internals.TransactionManager
+	logger             internals.Logger
+}
+
+func (useCase *DeleteUserUseCase) Execute(ctx context.Context, request any) internals.UseCaseResponse {
+	validatedRequest, errResponse := internals.ValidateUseCaseRequest[*DeleteUserRequest](request)
+	if errResponse != nil {
+		return *errResponse
+	}
+
+	useCase.logger.Info(ctx, fmt.Sprintf("Starting deletion of user %s", validatedRequest.Email))
+	defer useCase.logger.Info(ctx, fmt.Sprintf("Finished deletion of user %s", validatedRequest.Email))
+
+	foundUser, err := useCase.userRepository.FindByEmail(ctx, validatedRequest.Email)
+	if err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+	if foundUser == nil {
+		return internals.ErrorUseCaseResponse(user.UserNotFoundError{Email: validatedRequest.Email})
+	}
+	if err = foundUser.CheckVersion(validatedRequest.ExpectedVersion); err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+	err = useCase.transactionManager.Transaction(ctx, func(ctx context.Context) error {
+		if err := useCase.userRepository.Delete(ctx, foundUser.Email); err != nil {
+			return err
+		}
+		return useCase.changeRecorder.Record(ctx, audit.Change{
+			Action:     audit.DeleteUserAction,
+			TargetType: audit.UserTargetType,
+			Target:     foundUser.Email,
+			Before: map[string]any{
+				"superuser":         foundUser.Superuser,
+				"disabled":          foundUser.Disabled,
+				"permissions":       permission.Names(foundUser.Permissions),
+				"deniedPermissions": permission.Names(foundUser.DeniedPermissions),
+				"roles":             role.Names(foundUser.Roles),
+			},
+			Event: user.UserDeprovisionedEvent{Email: foundUser.Email},
+		})
+	})
+	if err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+	return internals.EmptyUseCaseResponse()
+}
+
+func (*DeleteUserUseCase) RequiredPermissions() []string {
+	return []string{user.DeleteUserPermission}
+}
+
+func NewDeleteUserUseCase(userRepository user.UserRepository, changeRecorder *audit.ChangeRecorder, transactionManager internals.TransactionManager, logger internals.Logger) *DeleteUserUseCase {
+	return &DeleteUserUseCase{
+		userRepository:     userRepository,
+		changeRecorder:     changeRecorder,
+		transactionManager: transactionManager,
+		logger:             logger,
+	}
+}
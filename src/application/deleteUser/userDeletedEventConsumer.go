@@ -0,0 +1,69 @@
+package deleteUser
+
+import (
+	"context"
+	"fmt"
+	"go-as/src/domain/events"
+	"go-as/src/domain/user"
+	"reflect"
+
+	"go.uber.org/zap"
+)
+
+type UserDeletedEventConsumer struct {
+	eventName         string
+	eventListener     events.EventListener
+	eventDeduplicator *events.EventDeduplicator
+	eventOrdering     *events.EventOrdering
+	deleteUserUseCase *DeleteUserUseCase
+	logger            *zap.Logger
+}
+
+func (consumer *UserDeletedEventConsumer) Consume() error {
+	return consumer.eventListener.Listen(consumer.eventDeduplicator.Deduplicate(consumer.eventName, events.HandleTypedEnvelope(consumer.handleEvent)))
+}
+
+func (consumer *UserDeletedEventConsumer) handleEvent(ctx context.Context, envelope events.EventEnvelope, event *user.UserDeletedEvent) error {
+	orderingKey := user.EventOrderingKey(event.Email)
+	applied, err := consumer.eventOrdering.Apply(ctx, envelope.OccurredAt, []string{orderingKey}, func(ctx context.Context, positions map[string]*events.EventPosition) error {
+		if err := consumer.deleteUser(ctx, event); err != nil {
+			return err
+		}
+		positions[orderingKey].Deleted = true
+		return nil
+	})
+	if err == nil && !applied {
+		consumer.logger.Info(fmt.Sprintf("Skipped stale %s %s, a newer event was already applied to user %s", consumer.eventName, envelope.ID, event.Email))
+	}
+	return err
+}
+
+func (consumer *UserDeletedEventConsumer) deleteUser(ctx context.Context, event *user.UserDeletedEvent) error {
+	deleteUserRequest := DeleteUserRequest{
+		Email: event.Email,
+	}
+	if useCaseResponse := consumer.deleteUserUseCase.Execute(ctx, &deleteUserRequest); useCaseResponse.Err != nil {
+		if _, notFound := useCaseResponse.Err.(user.UserNotFoundError); notFound {
+			consumer.logger.Info(fmt.Sprintf("User %s was already deleted", event.Email))
+			return nil
+		}
+		return useCaseResponse.Err
+	}
+	return nil
+}
+
+func NewUserDeletedEventConsumer(eventListenerFactory events.EventListenerFactory, eventDeduplicator *events.EventDeduplicator, eventOrdering *events.EventOrdering, useCase *DeleteUserUseCase, logger *zap.Logger) *UserDeletedEventConsumer {
+	eventName := reflect.TypeOf(user.UserDeletedEvent{}).Name()
+	eventListener, err := eventListenerFactory.CreateListener(eventName)
+	if err != nil {
+		logger.Fatal(fmt.Sprintf("Error creating listener %s: %s", eventName, err.Error()))
+	}
+	return &UserDeletedEventConsumer{
+		eventName:         eventName,
+		eventListener:     eventListener,
+		eventDeduplicator: eventDeduplicator,
+		eventOrdering:     eventOrdering,
+		deleteUserUseCase: useCase,
+		logger:            logger,
+	}
+}
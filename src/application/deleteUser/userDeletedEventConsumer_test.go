@@ -0,0 +1,82 @@
+package deleteUser
+
+import (
+	"context"
+	"errors"
+	"go-as/mocks"
+	"go-as/src/domain/events"
+	"go-as/src/domain/user"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func setUpConsumer(t *testing.T) (testCase, *UserDeletedEventConsumer, *mocks.EventPositionRepository) {
+	testCase := setUp(t)
+	positionRepositoryMock := mocks.NewEventPositionRepository(t)
+	positionRepositoryMock.On("Lock", mock.Anything, "user:testEmail").Return(&events.EventPosition{Key: "user:testEmail", OccurredAt: time.Unix(100, 0)}, nil).Maybe()
+	positionRepositoryMock.On("Save", mock.Anything, mock.Anything).Return(nil).Maybe()
+	consumer := &UserDeletedEventConsumer{
+		eventName:         "UserDeletedEvent",
+		eventOrdering:     events.NewEventOrdering(positionRepositoryMock, testCase.TransactionManager),
+		deleteUserUseCase: testCase.UseCase,
+		logger:            zap.NewNop(),
+	}
+	return testCase, consumer, positionRepositoryMock
+}
+
+var testEnvelope = events.EventEnvelope{ID: "testID", OccurredAt: time.Unix(200, 0)}
+
+func TestHandleEventDeletesUser(t *testing.T) {
+	testCase, consumer, positionRepository := setUpConsumer(t)
+	testCase.UserRepo.On("FindByEmail", mock.Anything, "testEmail").Return(&user.User{Email: "testEmail"}, nil)
+	testCase.UserRepo.On("Delete", mock.Anything, "testEmail").Return(nil)
+	ctx := context.Background()
+
+	err := consumer.handleEvent(ctx, testEnvelope, &user.UserDeletedEvent{Email: "testEmail"})
+
+	if err != nil {
+		t.Fatal("Expected event to be handled")
+	}
+	testCase.UserRepo.AssertCalled(t, "Delete", ctx, "testEmail")
+	positionRepository.AssertCalled(t, "Save", mock.Anything, events.EventPosition{Key: "user:testEmail", OccurredAt: time.Unix(200, 0), Deleted: true})
+}
+
+func TestHandleEventUserNotFoundIsSuccess(t *testing.T) {
+	testCase, consumer, _ := setUpConsumer(t)
+	testCase.UserRepo.On("FindByEmail", mock.Anything, "testEmail").Return(nil, nil)
+
+	err := consumer.handleEvent(context.Background(), testEnvelope, &user.UserDeletedEvent{Email: "testEmail"})
+
+	if err != nil {
+		t.Fatal("Expected an already deleted user to be acknowledged")
+	}
+	testCase.UserRepo.AssertNotCalled(t, "Delete")
+}
+
+func TestHandleEventDeleteError(t *testing.T) {
+	testCase, consumer, _ := setUpConsumer(t)
+	deleteError := errors.New("Test delete error")
+	testCase.UserRepo.On("FindByEmail", mock.Anything, "testEmail").Return(&user.User{Email: "testEmail"}, nil)
+	testCase.UserRepo.On("Delete", mock.Anything, "testEmail").Return(deleteError)
+
+	err := consumer.handleEvent(context.Background(), testEnvelope, &user.UserDeletedEvent{Email: "testEmail"})
+
+	if err != deleteError {
+		t.Fatal("Expected delete errors to be returned so the event is retried")
+	}
+}
+
+func TestHandleEventSkipsStaleDeletion(t *testing.T) {
+	testCase, consumer, _ := setUpConsumer(t)
+
+	err := consumer.handleEvent(context.Background(), events.EventEnvelope{ID: "testID", OccurredAt: time.Unix(50, 0)}, &user.UserDeletedEvent{Email: "testEmail"})
+
+	if err != nil {
+		t.Fatal("Expected a stale deletion to be acknowledged")
+	}
+	testCase.UserRepo.AssertNotCalled(t, "FindByEmail")
+	testCase.UserRepo.AssertNotCalled(t, "Delete")
+}
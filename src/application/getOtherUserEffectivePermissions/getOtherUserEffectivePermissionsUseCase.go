@@ -0,0 +1,37 @@
+package getOtherUserEffectivePermissions
+
+import (
+	"context"
+	"fmt"
+	"go-as/src/application/getUserEffectivePermissions"
+	"go-as/src/domain/internals"
+	"go-as/src/domain/user"
+)
+
+type GetOtherUserEffectivePermissionsUseCase struct {
+	getUserEffectivePermissionsUseCase *getUserEffectivePermissions.GetUserEffectivePermissionsUseCase
+	logger                             internals.Logger
+}
+
+func (useCase *GetOtherUserEffectivePermissionsUseCase) Execute(ctx context.Context, request any) internals.UseCaseResponse {
+	validatedRequest, errResponse := internals.ValidateUseCaseRequest[*getUserEffectivePermissions.GetUserEffectivePermissionsRequest](request)
+	if errResponse != nil {
+		return *errResponse
+	}
+
+	useCase.logger.Info(ctx, fmt.Sprintf("Starting getting effective permissions on behalf of user %s", validatedRequest.UserEmail))
+	defer useCase.logger.Info(ctx, fmt.Sprintf("Finished getting effective permissions on behalf of user %s", validatedRequest.UserEmail))
+
+	return useCase.getUserEffectivePermissionsUseCase.Execute(ctx, validatedRequest)
+}
+
+func (*GetOtherUserEffectivePermissionsUseCase) RequiredPermissions() []string {
+	return []string{user.ReadUserPermission}
+}
+
+func NewGetOtherUserEffectivePermissionsUseCase(getUserEffectivePermissionsUseCase *getUserEffectivePermissions.GetUserEffectivePermissionsUseCase, logger internals.Logger) *GetOtherUserEffectivePermissionsUseCase {
+	return &GetOtherUserEffectivePermissionsUseCase{
+		getUserEffectivePermissionsUseCase: getUserEffectivePermissionsUseCase,
+		logger:                             logger,
+	}
+}
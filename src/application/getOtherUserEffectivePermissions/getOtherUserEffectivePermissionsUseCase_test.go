@@ -0,0 +1,72 @@
+package getOtherUserEffectivePermissions
+
+import (
+	"context"
+	"go-as/mocks"
+	"go-as/src/application/getUserEffectivePermissions"
+	"go-as/src/domain/user"
+	"go-as/src/infrastructure/logging"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"go.elastic.co/apm/v2"
+)
+
+type testCase struct {
+	UserRepo *mocks.UserRepository
+	UseCase  *GetOtherUserEffectivePermissionsUseCase
+}
+
+func setUp(t *testing.T) testCase {
+	tracer := apm.DefaultTracer()
+	logger := logging.NewZapTracedLogger(tracer)
+	userRepoMock := mocks.NewUserRepository(t)
+	getUseCase := getUserEffectivePermissions.NewGetUserEffectivePermissionsUseCase(userRepoMock, logger)
+	return testCase{
+		UserRepo: userRepoMock,
+		UseCase:  NewGetOtherUserEffectivePermissionsUseCase(getUseCase, logger),
+	}
+}
+
+func TestExecuteWrongRequest(t *testing.T) {
+	testCase := setUp(t)
+	request := "wrongRequest"
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err == nil {
+		t.Fatal("Expected use case to return error")
+	}
+	testCase.UserRepo.AssertNotCalled(t, "FindByEmail")
+}
+
+func TestExecuteGetsTargetUser(t *testing.T) {
+	testCase := setUp(t)
+	request := getUserEffectivePermissions.GetUserEffectivePermissionsRequest{
+		UserEmail: "targetEmail",
+	}
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(&user.User{Email: "targetEmail", Superuser: true}, nil)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != nil {
+		t.Fatal("Expected use case not to return error")
+	}
+	if !response.Content.(*getUserEffectivePermissions.GetUserEffectivePermissionsResponse).Superuser {
+		t.Fatal("Expected use case to return the target user as superuser")
+	}
+	testCase.UserRepo.AssertCalled(t, "FindByEmail", ctx, "targetEmail")
+}
+
+func TestRequiredPermissions(t *testing.T) {
+	testCase := setUp(t)
+
+	requiredPermissions := testCase.UseCase.RequiredPermissions()
+
+	if !reflect.DeepEqual(requiredPermissions, []string{user.ReadUserPermission}) {
+		t.Fatal("Expected use case to require the read user permission")
+	}
+}
@@ -0,0 +1,5 @@
+package getPermission
+
+type GetPermissionRequest struct {
+	Name string
+}
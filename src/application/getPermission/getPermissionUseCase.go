@@ -0,0 +1,46 @@
+package getPermission
+
+import (
+	"context"
+	"fmt"
+	"go-as/src/domain/internals"
+	"go-as/src/domain/permission"
+)
+
+type GetPermissionUseCase struct {
+	permissionRepository permission.PermissionRepository
+	logger               internals.Logger
+}
+
+func (useCase *GetPermissionUseCase) Execute(ctx context.Context, request any) internals.UseCaseResponse {
+	validatedRequest, errResponse := internals.ValidateUseCaseRequest[*GetPermissionRequest](request)
+	if errResponse != nil {
+		return *errResponse
+	}
+
+	useCase.logger.Info(ctx, fmt.Sprintf("Starting getting permission %s", validatedRequest.Name))
+	defer useCase.logger.Info(ctx, fmt.Sprintf("Finished getting permission %s", validatedRequest.Name))
+
+	permissions, err := useCase.permissionRepository.FindByNames(ctx, []string{validatedRequest.Name})
+	if err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+	if len(permissions) == 0 {
+		return internals.ErrorUseCaseResponse(permission.PermissionNotFoundError{PermissionName: validatedRequest.Name})
+	}
+	return internals.UseCaseResponse{
+		Content: &permissions[0],
+		Err:     nil,
+	}
+}
+
+func (*GetPermissionUseCase) RequiredPermissions() []string {
+	return []string{permission.ReadPermissionPermission}
+}
+
+func NewGetPermissionUseCase(permissionRepository permission.PermissionRepository, logger internals.Logger) *GetPermissionUseCase {
+	return &GetPermissionUseCase{
+		permissionRepository: permissionRepository,
+		logger:               logger,
+	}
+}
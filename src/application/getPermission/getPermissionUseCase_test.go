@@ -0,0 +1,86 @@
+package getPermission
+
+import (
+	"context"
+	"errors"
+	"go-as/mocks"
+	"go-as/src/domain/permission"
+	"go-as/src/infrastructure/logging"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"go.elastic.co/apm/v2"
+)
+
+type testCase struct {
+	PermissionRepo *mocks.PermissionRepository
+	UseCase        *GetPermissionUseCase
+}
+
+func setUp(t *testing.T) testCase {
+	tracer := apm.DefaultTracer()
+	logger := logging.NewZapTracedLogger(tracer)
+	permissionRepoMock := mocks.NewPermissionRepository(t)
+	return testCase{
+		PermissionRepo: permissionRepoMock,
+		UseCase:        NewGetPermissionUseCase(permissionRepoMock, logger),
+	}
+}
+
+func TestExecuteWrongRequest(t *testing.T) {
+	testCase := setUp(t)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, "wrongRequest")
+
+	if response.Err == nil {
+		t.Fatal("Expected use case to return error")
+	}
+	testCase.PermissionRepo.AssertNotCalled(t, "FindByNames")
+}
+
+func TestExecuteFindError(t *testing.T) {
+	testCase := setUp(t)
+	findError := errors.New("Test find error")
+	testCase.PermissionRepo.On("FindByNames", mock.Anything, mock.Anything).Return(nil, findError)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &GetPermissionRequest{Name: "TestPermission"})
+
+	if response.Err != findError {
+		t.Fatal("Error expected to be the same as the permission repository returned error")
+	}
+}
+
+func TestExecutePermissionNotFound(t *testing.T) {
+	testCase := setUp(t)
+	testCase.PermissionRepo.On("FindByNames", mock.Anything, mock.Anything).Return([]permission.Permission{}, nil)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &GetPermissionRequest{Name: "TestPermission"})
+
+	if _, ok := response.Err.(permission.PermissionNotFoundError); !ok {
+		t.Fatal("Expected use case to return permission not found error")
+	}
+}
+
+func TestExecuteSuccess(t *testing.T) {
+	testCase := setUp(t)
+	foundPermission := permission.Permission{
+		Name:        "TestPermission",
+		Description: "Test description",
+		Service:     "Test service",
+	}
+	testCase.PermissionRepo.On("FindByNames", mock.Anything, mock.Anything).Return([]permission.Permission{foundPermission}, nil)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &GetPermissionRequest{Name: foundPermission.Name})
+
+	if response.Err != nil {
+		t.Fatal("Expected use case not to return error")
+	}
+	if content, ok := response.Content.(*permission.Permission); !ok || *content != foundPermission {
+		t.Fatal("Expected use case to return the found permission")
+	}
+	testCase.PermissionRepo.AssertCalled(t, "FindByNames", ctx, []string{foundPermission.Name})
+}
@@ -0,0 +1,5 @@
+package getRole
+
+type GetRoleRequest struct {
+	Name string
+}
@@ -0,0 +1,46 @@
+package getRole
+
+import (
+	"context"
+	"fmt"
+	"go-as/src/domain/internals"
+	"go-as/src/domain/role"
+)
+
+type GetRoleUseCase struct {
+	roleRepository role.RoleRepository
+	logger         internals.Logger
+}
+
+func (useCase *GetRoleUseCase) Execute(ctx context.Context, request any) internals.UseCaseResponse {
+	validatedRequest, errResponse := internals.ValidateUseCaseRequest[*GetRoleRequest](request)
+	if errResponse != nil {
+		return *errResponse
+	}
+
+	useCase.logger.Info(ctx, fmt.Sprintf("Starting getting role %s", validatedRequest.Name))
+	defer useCase.logger.Info(ctx, fmt.Sprintf("Finished getting role %s", validatedRequest.Name))
+
+	roles, err := useCase.roleRepository.FindByNames(ctx, []string{validatedRequest.Name})
+	if err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+	if len(roles) == 0 {
+		return internals.ErrorUseCaseResponse(role.RoleNotFoundError{RoleName: validatedRequest.Name})
+	}
+	return internals.UseCaseResponse{
+		Content: &roles[0],
+		Err:     nil,
+	}
+}
+
+func (*GetRoleUseCase) RequiredPermissions() []string {
+	return []string{role.ReadRolePermission}
+}
+
+func NewGetRoleUseCase(roleRepository role.RoleRepository, logger internals.Logger) *GetRoleUseCase {
+	return &GetRoleUseCase{
+		roleRepository: roleRepository,
+		logger:         logger,
+	}
+}
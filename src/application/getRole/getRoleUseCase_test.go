@@ -0,0 +1,82 @@
+package getRole
+
+import (
+	"context"
+	"errors"
+	"go-as/mocks"
+	"go-as/src/domain/role"
+	"go-as/src/infrastructure/logging"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"go.elastic.co/apm/v2"
+)
+
+type testCase struct {
+	RoleRepo *mocks.RoleRepository
+	UseCase  *GetRoleUseCase
+}
+
+func setUp(t *testing.T) testCase {
+	tracer := apm.DefaultTracer()
+	logger := logging.NewZapTracedLogger(tracer)
+	roleRepoMock := mocks.NewRoleRepository(t)
+	return testCase{
+		RoleRepo: roleRepoMock,
+		UseCase:  NewGetRoleUseCase(roleRepoMock, logger),
+	}
+}
+
+func TestExecuteWrongRequest(t *testing.T) {
+	testCase := setUp(t)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, "wrongRequest")
+
+	if response.Err == nil {
+		t.Fatal("Expected use case to return error")
+	}
+	testCase.RoleRepo.AssertNotCalled(t, "FindByNames")
+}
+
+func TestExecuteFindError(t *testing.T) {
+	testCase := setUp(t)
+	findError := errors.New("Test find error")
+	testCase.RoleRepo.On("FindByNames", mock.Anything, mock.Anything).Return(nil, findError)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &GetRoleRequest{Name: "Test role"})
+
+	if response.Err != findError {
+		t.Fatal("Error expected to be the same as the role repository returned error")
+	}
+}
+
+func TestExecuteRoleNotFound(t *testing.T) {
+	testCase := setUp(t)
+	testCase.RoleRepo.On("FindByNames", mock.Anything, mock.Anything).Return([]role.Role{}, nil)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &GetRoleRequest{Name: "Test role"})
+
+	if _, ok := response.Err.(role.RoleNotFoundError); !ok {
+		t.Fatal("Expected use case to return role not found error")
+	}
+}
+
+func TestExecuteSuccess(t *testing.T) {
+	testCase := setUp(t)
+	roleName := "Test role"
+	testCase.RoleRepo.On("FindByNames", mock.Anything, mock.Anything).Return([]role.Role{{Name: roleName}}, nil)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &GetRoleRequest{Name: roleName})
+
+	if response.Err != nil {
+		t.Fatal("Expected use case not to return error")
+	}
+	if foundRole, ok := response.Content.(*role.Role); !ok || foundRole.Name != roleName {
+		t.Fatal("Expected use case to return the found role")
+	}
+	testCase.RoleRepo.AssertCalled(t, "FindByNames", ctx, []string{roleName})
+}
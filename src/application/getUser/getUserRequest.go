@@ -0,0 +1,5 @@
+package getUser
+
+type GetUserRequest struct {
+	Email string
+}
@@ -0,0 +1,46 @@
+package getUser
+
+import (
+	"context"
+	"fmt"
+	"go-as/src/domain/internals"
+	"go-as/src/domain/user"
+)
+
+type GetUserUseCase struct {
+	userRepository user.UserRepository
+	logger         internals.Logger
+}
+
+func (useCase *GetUserUseCase) Execute(ctx context.Context, request any) internals.UseCaseResponse {
+	validatedRequest, errResponse := internals.ValidateUseCaseRequest[*GetUserRequest](request)
+	if errResponse != nil {
+		return *errResponse
+	}
+
+	useCase.logger.Info(ctx, fmt.Sprintf("Starting getting user %s", validatedRequest.Email))
+	defer useCase.logger.Info(ctx, fmt.Sprintf("Finished getting user %s", validatedRequest.Email))
+
+	foundUser, err := useCase.userRepository.FindByEmail(ctx, validatedRequest.Email)
+	if err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+	if foundUser == nil {
+		return internals.ErrorUseCaseResponse(user.UserNotFoundError{Email: validatedRequest.Email})
+	}
+	return internals.UseCaseResponse{
+		Content: foundUser,
+		Err:     nil,
+	}
+}
+
+func (*GetUserUseCase) RequiredPermissions() []string {
+	return []string{user.ReadUserPermission}
+}
+
+func NewGetUserUseCase(userRepository user.UserRepository, logger internals.Logger) *GetUserUseCase {
+	return &GetUserUseCase{
+		userRepository: userRepository,
+		logger:         logger,
+	}
+}
@@ -0,0 +1,81 @@
+package getUser
+
+import (
+	"context"
+	"errors"
+	"go-as/mocks"
+	"go-as/src/domain/user"
+	"go-as/src/infrastructure/logging"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"go.elastic.co/apm/v2"
+)
+
+type testCase struct {
+	UserRepo *mocks.UserRepository
+	UseCase  *GetUserUseCase
+}
+
+func setUp(t *testing.T) testCase {
+	tracer := apm.DefaultTracer()
+	logger := logging.NewZapTracedLogger(tracer)
+	userRepoMock := mocks.NewUserRepository(t)
+	return testCase{
+		UserRepo: userRepoMock,
+		UseCase:  NewGetUserUseCase(userRepoMock, logger),
+	}
+}
+
+func TestExecuteWrongRequest(t *testing.T) {
+	testCase := setUp(t)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, "wrongRequest")
+
+	if response.Err == nil {
+		t.Fatal("Expected use case to return error")
+	}
+	testCase.UserRepo.AssertNotCalled(t, "FindByEmail")
+}
+
+func TestExecuteFindError(t *testing.T) {
+	testCase := setUp(t)
+	findError := errors.New("Test find error")
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(nil, findError)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &GetUserRequest{Email: "testEmail"})
+
+	if response.Err != findError {
+		t.Fatal("Error expected to be the same as the user repository returned error")
+	}
+}
+
+func TestExecuteUserNotFound(t *testing.T) {
+	testCase := setUp(t)
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(nil, nil)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &GetUserRequest{Email: "testEmail"})
+
+	if _, ok := response.Err.(user.UserNotFoundError); !ok {
+		t.Fatal("Expected use case to return user not found error")
+	}
+}
+
+func TestExecuteSuccess(t *testing.T) {
+	testCase := setUp(t)
+	foundUser := user.User{Email: "testEmail"}
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(&foundUser, nil)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &GetUserRequest{Email: foundUser.Email})
+
+	if response.Err != nil {
+		t.Fatal("Expected use case not to return error")
+	}
+	if response.Content != &foundUser {
+		t.Fatal("Expected use case to return the found user")
+	}
+}
@@ -0,0 +1,5 @@
+package getUserEffectivePermissions
+
+type GetUserEffectivePermissionsRequest struct {
+	UserEmail string
+}
@@ -0,0 +1,8 @@
+package getUserEffectivePermissions
+
+import "go-as/src/domain/user"
+
+type GetUserEffectivePermissionsResponse struct {
+	Superuser   bool
+	Permissions []user.EffectivePermission
+}
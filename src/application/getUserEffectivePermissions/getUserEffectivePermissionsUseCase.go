@@ -0,0 +1,50 @@
+package getUserEffectivePermissions
+
+import (
+	"context"
+	"fmt"
+	"go-as/src/domain/internals"
+	"go-as/src/domain/user"
+)
+
+type GetUserEffectivePermissionsUseCase struct {
+	userRepo user.UserRepository
+	logger   internals.Logger
+}
+
+func (useCase *GetUserEffectivePermissionsUseCase) Execute(ctx context.Context, request any) internals.UseCaseResponse {
+	validatedRequest, errResponse := internals.ValidateUseCaseRequest[*GetUserEffectivePermissionsRequest](request)
+	if errResponse != nil {
+		return *errResponse
+	}
+
+	useCase.logger.Info(ctx, fmt.Sprintf("Starting getting effective permissions of user %s", validatedRequest.UserEmail))
+	defer useCase.logger.Info(ctx, fmt.Sprintf("Finished getting effective permissions of user %s", validatedRequest.UserEmail))
+
+	user, err := useCase.userRepo.FindByEmail(ctx, validatedRequest.UserEmail)
+	if err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+	if user == nil {
+		return internals.ErrorUseCaseResponse(fmt.Errorf("user %s not found", validatedRequest.UserEmail))
+	}
+
+	return internals.UseCaseResponse{
+		Content: &GetUserEffectivePermissionsResponse{
+			Superuser:   user.Superuser,
+			Permissions: user.EffectivePermissions(),
+		},
+		Err: nil,
+	}
+}
+
+func (*GetUserEffectivePermissionsUseCase) RequiredPermissions() []string {
+	return []string{}
+}
+
+func NewGetUserEffectivePermissionsUseCase(userRepo user.UserRepository, logger internals.Logger) *GetUserEffectivePermissionsUseCase {
+	return &GetUserEffectivePermissionsUseCase{
+		userRepo: userRepo,
+		logger:   logger,
+	}
+}
@@ -0,0 +1,119 @@
+package getUserEffectivePermissions
+
+import (
+	"context"
+	"errors"
+	"go-as/mocks"
+	"go-as/src/domain/permission"
+	"go-as/src/domain/role"
+	"go-as/src/domain/user"
+	"go-as/src/infrastructure/logging"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"go.elastic.co/apm/v2"
+)
+
+type testCase struct {
+	UserRepo *mocks.UserRepository
+	UseCase  *GetUserEffectivePermissionsUseCase
+}
+
+func setUp(t *testing.T) testCase {
+	tracer := apm.DefaultTracer()
+	logger := logging.NewZapTracedLogger(tracer)
+	userRepoMock := mocks.NewUserRepository(t)
+	return testCase{
+		UserRepo: userRepoMock,
+		UseCase:  NewGetUserEffectivePermissionsUseCase(userRepoMock, logger),
+	}
+}
+
+func TestExecuteWrongRequest(t *testing.T) {
+	testCase := setUp(t)
+	request := "wrongRequest"
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err == nil {
+		t.Fatal("Expected use case to return error")
+	}
+	testCase.UserRepo.AssertNotCalled(t, "FindByEmail")
+}
+
+func TestExecuteFindUserError(t *testing.T) {
+	testCase := setUp(t)
+	request := GetUserEffectivePermissionsRequest{
+		UserEmail: "testEmail",
+	}
+	testError := errors.New("Test error")
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(nil, testError)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != testError {
+		t.Fatal("Expected use case to return same error as the find user error")
+	}
+}
+
+func TestExecuteUserNotFound(t *testing.T) {
+	testCase := setUp(t)
+	request := GetUserEffectivePermissionsRequest{
+		UserEmail: "testEmail",
+	}
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(nil, nil)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err == nil {
+		t.Fatal("Expected use case to return error")
+	}
+}
+
+func TestExecuteSuccess(t *testing.T) {
+	testCase := setUp(t)
+	request := GetUserEffectivePermissionsRequest{
+		UserEmail: "testEmail",
+	}
+	expiredAt := time.Now().Add(-time.Minute)
+	parentRole := role.Role{
+		Name:        "testParentRole",
+		Permissions: []permission.Permission{{Name: "testPermission1"}, {Name: "testPermission3"}, {Name: "testPermission4"}},
+	}
+	testUser := user.User{
+		Email:             "testEmail",
+		Permissions:       []permission.Permission{{Name: "testPermission1"}, {Name: "testPermission5"}},
+		DeniedPermissions: []permission.Permission{{Name: "testPermission4"}},
+		PermissionGrants:  []user.UserPermission{{UserEmail: "testEmail", PermissionName: "testPermission5", GrantValidity: user.GrantValidity{ValidUntil: &expiredAt}}},
+		Roles:             []role.Role{{Name: "testRole", Permissions: []permission.Permission{{Name: "testPermission2"}}, Parents: []role.Role{parentRole}}},
+	}
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(&testUser, nil)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != nil {
+		t.Fatal("Expected use case not to return error")
+	}
+	expectedPermissions := []user.EffectivePermission{
+		{PermissionName: "testPermission1", Sources: []user.PermissionSource{
+			{Source: user.DirectPermissionSource},
+			{Source: user.RolePermissionSource, RolePath: []string{"testRole", "testParentRole"}},
+		}},
+		{PermissionName: "testPermission2", Sources: []user.PermissionSource{{Source: user.RolePermissionSource, RolePath: []string{"testRole"}}}},
+		{PermissionName: "testPermission3", Sources: []user.PermissionSource{{Source: user.RolePermissionSource, RolePath: []string{"testRole", "testParentRole"}}}},
+	}
+	effectivePermissionsResponse := response.Content.(*GetUserEffectivePermissionsResponse)
+	if effectivePermissionsResponse.Superuser {
+		t.Fatal("Expected use case not to return the user as superuser")
+	}
+	if !reflect.DeepEqual(effectivePermissionsResponse.Permissions, expectedPermissions) {
+		t.Fatalf("Expected use case to return the effective permissions, got %+v", effectivePermissionsResponse.Permissions)
+	}
+	testCase.UserRepo.AssertCalled(t, "FindByEmail", ctx, request.UserEmail)
+}
@@ -0,0 +1,9 @@
+package grantRoleResourcePermission
+
+type GrantRoleResourcePermissionRequest struct {
+	RoleName        string
+	PermissionName  string
+	ResourceType    string
+	ResourceID      string
+	ExpectedVersion *int64
+}
@@ -0,0 +1,92 @@
+package grantRoleResourcePermission
+
+import (
+	"context"
+	"fmt"
+	"go-as/src/domain/audit"
+	"go-as/src/domain/internals"
+	"go-as/src/domain/permission"
+	"go-as/src/domain/role"
+)
+
+type GrantRoleResourcePermissionUseCase struct {
+	roleRepository       role.RoleRepository
+	permissionRepository permission.PermissionRepository
+	changeRecorder       *audit.ChangeRecorder
+	transactionManager   internals.TransactionManager
+	logger               internals.Logger
+}
+
+func (useCase *GrantRoleResourcePermissionUseCase) Execute(ctx context.Context, request any) internals.UseCaseResponse {
+	validatedRequest, errResponse := internals.ValidateUseCaseRequest[*GrantRoleResourcePermissionRequest](request)
+	if errResponse != nil {
+		return *errResponse
+	}
+
+	useCase.logger.Info(ctx, fmt.Sprintf("Starting granting permission %s on %s resources to role %s", validatedRequest.PermissionName, validatedRequest.ResourceType, validatedRequest.RoleName))
+	defer useCase.logger.Info(ctx, fmt.Sprintf("Finished granting permission %s on %s resources to role %s", validatedRequest.PermissionName, validatedRequest.ResourceType, validatedRequest.RoleName))
+
+	roles, err := useCase.roleRepository.FindByNames(ctx, []string{validatedRequest.RoleName})
+	if err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+	if len(roles) == 0 {
+		return internals.ErrorUseCaseResponse(fmt.Errorf("role %s not found", validatedRequest.RoleName))
+	}
+	if err = roles[0].CheckVersion(validatedRequest.ExpectedVersion); err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+
+	permissions, err := useCase.permissionRepository.FindByNames(ctx, []string{validatedRequest.PermissionName})
+	if err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+	if len(permissions) == 0 {
+		return internals.ErrorUseCaseResponse(fmt.Errorf("permission %s not found", validatedRequest.PermissionName))
+	}
+
+	foundRole := roles[0]
+	grant := permission.NewResourceGrant(validatedRequest.PermissionName, validatedRequest.ResourceType, validatedRequest.ResourceID)
+	if foundRole.HasResourceGrant(grant) {
+		return internals.EmptyUseCaseResponse()
+	}
+	foundRole.ResourcePermissions = append(foundRole.ResourcePermissions, role.RoleResourcePermission{
+		RoleName:      foundRole.Name,
+		ResourceGrant: grant,
+	})
+	err = useCase.transactionManager.Transaction(ctx, func(ctx context.Context) error {
+		if err := useCase.roleRepository.Save(ctx, foundRole); err != nil {
+			return err
+		}
+		return useCase.changeRecorder.Record(ctx, audit.Change{
+			Action:     audit.GrantRoleResourcePermissionAction,
+			TargetType: audit.RoleTargetType,
+			Target:     foundRole.Name,
+			After:      grant,
+			Event: role.RoleResourcePermissionGrantedEvent{
+				RoleName:       foundRole.Name,
+				PermissionName: grant.PermissionName,
+				ResourceType:   grant.ResourceType,
+				ResourceID:     grant.ResourceID,
+			},
+		})
+	})
+	if err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+	return internals.EmptyUseCaseResponse()
+}
+
+func (*GrantRoleResourcePermissionUseCase) RequiredPermissions() []string {
+	return []string{role.UpdateRolePermission}
+}
+
+func NewGrantRoleResourcePermissionUseCase(roleRepository role.RoleRepository, permissionRepository permission.PermissionRepository, changeRecorder *audit.ChangeRecorder, transactionManager internals.TransactionManager, logger internals.Logger) *GrantRoleResourcePermissionUseCase {
+	return &GrantRoleResourcePermissionUseCase{
+		roleRepository:       roleRepository,
+		permissionRepository: permissionRepository,
+		changeRecorder:       changeRecorder,
+		transactionManager:   transactionManager,
+		logger:               logger,
+	}
+}
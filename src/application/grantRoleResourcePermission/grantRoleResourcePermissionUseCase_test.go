@@ -0,0 +1,178 @@
+package grantRoleResourcePermission
+
+import (
+	"context"
+	"errors"
+	"go-as/mocks"
+	"go-as/src/domain/audit"
+	"go-as/src/domain/permission"
+	"go-as/src/domain/role"
+	"go-as/src/infrastructure/logging"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"go.elastic.co/apm/v2"
+)
+
+type testCase struct {
+	RoleRepo           *mocks.RoleRepository
+	PermissionRepo     *mocks.PermissionRepository
+	AuditRepo          *mocks.AuditRepository
+	EventPublisher     *mocks.EventPublisher
+	TransactionManager *mocks.TransactionManager
+	UseCase            *GrantRoleResourcePermissionUseCase
+}
+
+func setUp(t *testing.T) testCase {
+	tracer := apm.DefaultTracer()
+	logger := logging.NewZapTracedLogger(tracer)
+	roleRepoMock := mocks.NewRoleRepository(t)
+	permissionRepoMock := mocks.NewPermissionRepository(t)
+	auditRepoMock := mocks.NewAuditRepository(t)
+	auditRepoMock.On("Save", mock.Anything, mock.Anything).Return(nil).Maybe()
+	eventPublisherMock := mocks.NewEventPublisher(t)
+	eventPublisherMock.On("Publish", mock.Anything, mock.Anything).Return(nil).Maybe()
+	transactionManagerMock := mocks.NewTransactionManager(t)
+	transactionManagerMock.On("Transaction", mock.Anything, mock.Anything).Return(func(ctx context.Context, operation func(context.Context) error) error {
+		return operation(ctx)
+	}).Maybe()
+	return testCase{
+		RoleRepo:           roleRepoMock,
+		PermissionRepo:     permissionRepoMock,
+		AuditRepo:          auditRepoMock,
+		EventPublisher:     eventPublisherMock,
+		TransactionManager: transactionManagerMock,
+		UseCase:            NewGrantRoleResourcePermissionUseCase(roleRepoMock, permissionRepoMock, audit.NewChangeRecorder(auditRepoMock, eventPublisherMock), transactionManagerMock, logger),
+	}
+}
+
+func TestExecuteWrongRequest(t *testing.T) {
+	testCase := setUp(t)
+	request := "wrongRequest"
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err == nil {
+		t.Fatal("Expected use case to return error")
+	}
+	testCase.RoleRepo.AssertNotCalled(t, "FindByNames")
+	testCase.PermissionRepo.AssertNotCalled(t, "FindByNames")
+	testCase.RoleRepo.AssertNotCalled(t, "Save")
+}
+
+func TestExecuteRoleNotFound(t *testing.T) {
+	testCase := setUp(t)
+	request := GrantRoleResourcePermissionRequest{
+		RoleName:       "testRole",
+		PermissionName: "testPermission",
+		ResourceType:   "testResourceType",
+	}
+	testCase.RoleRepo.On("FindByNames", mock.Anything, mock.Anything).Return(make([]role.Role, 0), nil)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err == nil {
+		t.Fatal("Expected use case to return error")
+	}
+	testCase.RoleRepo.AssertCalled(t, "FindByNames", ctx, []string{request.RoleName})
+	testCase.PermissionRepo.AssertNotCalled(t, "FindByNames")
+	testCase.RoleRepo.AssertNotCalled(t, "Save")
+}
+
+func TestExecuteVersionMismatch(t *testing.T) {
+	testCase := setUp(t)
+	expectedVersion := int64(2)
+	request := GrantRoleResourcePermissionRequest{
+		RoleName:        "testRole",
+		PermissionName:  "testPermission",
+		ResourceType:    "testResourceType",
+		ExpectedVersion: &expectedVersion,
+	}
+	testCase.RoleRepo.On("FindByNames", mock.Anything, mock.Anything).Return([]role.Role{{Name: "testRole", Version: 3}}, nil)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if _, isMismatch := response.Err.(role.RoleVersionMismatchError); !isMismatch {
+		t.Fatal("Expected use case to return a version mismatch error")
+	}
+	testCase.PermissionRepo.AssertNotCalled(t, "FindByNames")
+	testCase.RoleRepo.AssertNotCalled(t, "Save")
+}
+
+func TestExecutePermissionNotFound(t *testing.T) {
+	testCase := setUp(t)
+	request := GrantRoleResourcePermissionRequest{
+		RoleName:       "testRole",
+		PermissionName: "testPermission",
+		ResourceType:   "testResourceType",
+	}
+	testCase.RoleRepo.On("FindByNames", mock.Anything, mock.Anything).Return([]role.Role{{Name: "testRole"}}, nil)
+	testCase.PermissionRepo.On("FindByNames", mock.Anything, mock.Anything).Return(make([]permission.Permission, 0), nil)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err == nil {
+		t.Fatal("Expected use case to return error")
+	}
+	testCase.PermissionRepo.AssertCalled(t, "FindByNames", ctx, []string{request.PermissionName})
+	testCase.RoleRepo.AssertNotCalled(t, "Save")
+}
+
+func TestExecuteSaveRoleError(t *testing.T) {
+	testCase := setUp(t)
+	request := GrantRoleResourcePermissionRequest{
+		RoleName:       "testRole",
+		PermissionName: "testPermission",
+		ResourceType:   "testResourceType",
+	}
+	testCase.RoleRepo.On("FindByNames", mock.Anything, mock.Anything).Return([]role.Role{{Name: "testRole"}}, nil)
+	testCase.PermissionRepo.On("FindByNames", mock.Anything, mock.Anything).Return([]permission.Permission{{Name: "testPermission"}}, nil)
+	testError := errors.New("Test error")
+	testCase.RoleRepo.On("Save", mock.Anything, mock.Anything).Return(testError)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err == nil {
+		t.Fatal("Expected use case to return error")
+	}
+	if response.Err != testError {
+		t.Fatal("Expected use case to return same error as save role error")
+	}
+}
+
+func TestExecuteSuccess(t *testing.T) {
+	testCase := setUp(t)
+	request := GrantRoleResourcePermissionRequest{
+		RoleName:       "testRole",
+		PermissionName: "testPermission",
+		ResourceType:   "testResourceType",
+		ResourceID:     "testResourceID",
+	}
+	testCase.RoleRepo.On("FindByNames", mock.Anything, mock.Anything).Return([]role.Role{{Name: "testRole"}}, nil)
+	testCase.PermissionRepo.On("FindByNames", mock.Anything, mock.Anything).Return([]permission.Permission{{Name: "testPermission"}}, nil)
+	testCase.RoleRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != nil {
+		t.Fatal("Expected use case not to return error")
+	}
+	testCase.RoleRepo.AssertCalled(t, "Save", ctx, mock.MatchedBy(func(savedRole role.Role) bool {
+		expectedGrant := permission.ResourceGrant{
+			PermissionName: "testPermission",
+			ResourceType:   "testResourceType",
+			ResourceID:     "testResourceID",
+		}
+		return savedRole.Name == request.RoleName && len(savedRole.ResourcePermissions) == 1 && savedRole.ResourcePermissions[0].ResourceGrant == expectedGrant
+	}))
+	testCase.AuditRepo.AssertCalled(t, "Save", mock.Anything, mock.MatchedBy(func(entry audit.AuditEntry) bool {
+		return entry.Action == audit.GrantRoleResourcePermissionAction
+	}))
+	testCase.EventPublisher.AssertCalled(t, "Publish", mock.Anything, mock.AnythingOfType("role.RoleResourcePermissionGrantedEvent"))
+}
@@ -0,0 +1,10 @@
+package grantUserPermission
+
+import "go-as/src/domain/user"
+
+type GrantUserPermissionRequest struct {
+	UserEmail       string
+	PermissionName  string
+	Validity        user.GrantValidity
+	ExpectedVersion *int64
+}
@@ -0,0 +1,91 @@
+package grantUserPermission
+
+import (
+	"context"
+	"fmt"
+	"go-as/src/domain/audit"
+	"go-as/src/domain/internals"
+	"go-as/src/domain/permission"
+	"go-as/src/domain/user"
+)
+
+type GrantUserPermissionUseCase struct {
+	userRepository       user.UserRepository
+	permissionRepository permission.PermissionRepository
+	changeRecorder       *audit.ChangeRecorder
+	transactionManager   internals.TransactionManager
+	logger               internals.Logger
+}
+
+func (useCase *GrantUserPermissionUseCase) Execute(ctx context.Context, request any) internals.UseCaseResponse {
+	validatedRequest, errResponse := internals.ValidateUseCaseRequest[*GrantUserPermissionRequest](request)
+	if errResponse != nil {
+		return *errResponse
+	}
+
+	useCase.logger.Info(ctx, fmt.Sprintf("Starting granting permission %s to %s", validatedRequest.PermissionName, validatedRequest.UserEmail))
+	defer useCase.logger.Info(ctx, fmt.Sprintf("Finished granting permission %s to %s", validatedRequest.PermissionName, validatedRequest.UserEmail))
+
+	if err := validatedRequest.Validity.Validate(); err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+	foundUser, err := useCase.userRepository.FindByEmail(ctx, validatedRequest.UserEmail)
+	if err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+	if foundUser == nil {
+		return internals.ErrorUseCaseResponse(user.UserNotFoundError{Email: validatedRequest.UserEmail})
+	}
+	if err = foundUser.CheckVersion(validatedRequest.ExpectedVersion); err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+	permissions, err := useCase.permissionRepository.FindByNames(ctx, []string{validatedRequest.PermissionName})
+	if err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+	if len(permissions) == 0 {
+		return internals.ErrorUseCaseResponse(permission.PermissionNotFoundError{PermissionName: validatedRequest.PermissionName})
+	}
+
+	grant := user.UserPermission{
+		UserEmail:      validatedRequest.UserEmail,
+		PermissionName: validatedRequest.PermissionName,
+		GrantValidity:  validatedRequest.Validity,
+	}
+	err = useCase.transactionManager.Transaction(ctx, func(ctx context.Context) error {
+		if err := useCase.userRepository.SavePermissionGrant(ctx, grant, foundUser.Version); err != nil {
+			return err
+		}
+		return useCase.changeRecorder.Record(ctx, audit.Change{
+			Action:     audit.GrantUserPermissionAction,
+			TargetType: audit.UserTargetType,
+			Target:     foundUser.Email,
+			Before:     foundUser.FindPermissionGrant(grant.PermissionName),
+			After:      grant,
+			Event: user.UserPermissionGrantedEvent{
+				Email:          foundUser.Email,
+				PermissionName: grant.PermissionName,
+				ValidFrom:      grant.ValidFrom,
+				ValidUntil:     grant.ValidUntil,
+			},
+		})
+	})
+	if err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+	return internals.EmptyUseCaseResponse()
+}
+
+func (*GrantUserPermissionUseCase) RequiredPermissions() []string {
+	return []string{user.UpdateUserPermission}
+}
+
+func NewGrantUserPermissionUseCase(userRepository user.UserRepository, permissionRepository permission.PermissionRepository, changeRecorder *audit.ChangeRecorder, transactionManager internals.TransactionManager, logger internals.Logger) *GrantUserPermissionUseCase {
+	return &GrantUserPermissionUseCase{
+		userRepository:       userRepository,
+		permissionRepository: permissionRepository,
+		changeRecorder:       changeRecorder,
+		transactionManager:   transactionManager,
+		logger:               logger,
+	}
+}
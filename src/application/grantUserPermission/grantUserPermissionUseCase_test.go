@@ -0,0 +1,166 @@
+package grantUserPermission
+
+import (
+	"context"
+	"errors"
+	"go-as/mocks"
+	"go-as/src/domain/audit"
+	"go-as/src/domain/permission"
+	"go-as/src/domain/user"
+	"go-as/src/infrastructure/logging"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"go.elastic.co/apm/v2"
+)
+
+type testCase struct {
+	UserRepo           *mocks.UserRepository
+	PermissionRepo     *mocks.PermissionRepository
+	AuditRepo          *mocks.AuditRepository
+	EventPublisher     *mocks.EventPublisher
+	TransactionManager *mocks.TransactionManager
+	UseCase            *GrantUserPermissionUseCase
+}
+
+func setUp(t *testing.T) testCase {
+	tracer := apm.DefaultTracer()
+	logger := logging.NewZapTracedLogger(tracer)
+	userRepoMock := mocks.NewUserRepository(t)
+	permissionRepoMock := mocks.NewPermissionRepository(t)
+	auditRepoMock := mocks.NewAuditRepository(t)
+	auditRepoMock.On("Save", mock.Anything, mock.Anything).Return(nil).Maybe()
+	eventPublisherMock := mocks.NewEventPublisher(t)
+	eventPublisherMock.On("Publish", mock.Anything, mock.Anything).Return(nil).Maybe()
+	transactionManagerMock := mocks.NewTransactionManager(t)
+	transactionManagerMock.On("Transaction", mock.Anything, mock.Anything).Return(func(ctx context.Context, operation func(context.Context) error) error {
+		return operation(ctx)
+	}).Maybe()
+	return testCase{
+		UserRepo:           userRepoMock,
+		PermissionRepo:     permissionRepoMock,
+		AuditRepo:          auditRepoMock,
+		EventPublisher:     eventPublisherMock,
+		TransactionManager: transactionManagerMock,
+		UseCase:            NewGrantUserPermissionUseCase(userRepoMock, permissionRepoMock, audit.NewChangeRecorder(auditRepoMock, eventPublisherMock), transactionManagerMock, logger),
+	}
+}
+
+func TestExecuteWrongRequest(t *testing.T) {
+	testCase := setUp(t)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, "wrongRequest")
+
+	if response.Err == nil {
+		t.Fatal("Expected use case to return error")
+	}
+	testCase.UserRepo.AssertNotCalled(t, "SavePermissionGrant")
+}
+
+func TestExecuteInvalidValidity(t *testing.T) {
+	testCase := setUp(t)
+	validFrom := time.Now()
+	validUntil := validFrom.Add(-time.Hour)
+	request := GrantUserPermissionRequest{
+		UserEmail:      "testEmail",
+		PermissionName: "testPermission",
+		Validity:       user.GrantValidity{ValidFrom: &validFrom, ValidUntil: &validUntil},
+	}
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if _, ok := response.Err.(user.InvalidGrantValidityError); !ok {
+		t.Fatal("Expected use case to return invalid grant validity error")
+	}
+	testCase.UserRepo.AssertNotCalled(t, "FindByEmail")
+}
+
+func TestExecuteUserNotFound(t *testing.T) {
+	testCase := setUp(t)
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(nil, nil)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &GrantUserPermissionRequest{UserEmail: "testEmail", PermissionName: "testPermission"})
+
+	if _, ok := response.Err.(user.UserNotFoundError); !ok {
+		t.Fatal("Expected use case to return user not found error")
+	}
+	testCase.UserRepo.AssertNotCalled(t, "SavePermissionGrant")
+}
+
+func TestExecutePermissionNotFound(t *testing.T) {
+	testCase := setUp(t)
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(&user.User{Email: "testEmail", Version: 3}, nil)
+	testCase.PermissionRepo.On("FindByNames", mock.Anything, mock.Anything).Return([]permission.Permission{}, nil)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &GrantUserPermissionRequest{UserEmail: "testEmail", PermissionName: "testPermission"})
+
+	if _, ok := response.Err.(permission.PermissionNotFoundError); !ok {
+		t.Fatal("Expected use case to return permission not found error")
+	}
+	testCase.UserRepo.AssertNotCalled(t, "SavePermissionGrant")
+}
+
+func TestExecuteSaveGrantError(t *testing.T) {
+	testCase := setUp(t)
+	saveError := errors.New("Test save error")
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(&user.User{Email: "testEmail", Version: 3}, nil)
+	testCase.PermissionRepo.On("FindByNames", mock.Anything, mock.Anything).Return([]permission.Permission{{Name: "testPermission"}}, nil)
+	testCase.UserRepo.On("SavePermissionGrant", mock.Anything, mock.Anything, mock.Anything).Return(saveError)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &GrantUserPermissionRequest{UserEmail: "testEmail", PermissionName: "testPermission"})
+
+	if response.Err != saveError {
+		t.Fatal("Error expected to be the same as the user repository returned error")
+	}
+}
+
+func TestExecuteSuccess(t *testing.T) {
+	testCase := setUp(t)
+	validUntil := time.Now().Add(time.Hour)
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(&user.User{Email: "testEmail", Version: 3}, nil)
+	testCase.PermissionRepo.On("FindByNames", mock.Anything, mock.Anything).Return([]permission.Permission{{Name: "testPermission"}}, nil)
+	testCase.UserRepo.On("SavePermissionGrant", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	request := GrantUserPermissionRequest{
+		UserEmail:      "testEmail",
+		PermissionName: "testPermission",
+		Validity:       user.GrantValidity{ValidUntil: &validUntil},
+	}
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != nil {
+		t.Fatal("Expected use case not to return error")
+	}
+	expectedGrant := user.UserPermission{
+		UserEmail:      "testEmail",
+		PermissionName: "testPermission",
+		GrantValidity:  user.GrantValidity{ValidUntil: &validUntil},
+	}
+	testCase.UserRepo.AssertCalled(t, "SavePermissionGrant", ctx, expectedGrant, int64(3))
+	testCase.UserRepo.AssertNotCalled(t, "Save")
+	testCase.AuditRepo.AssertCalled(t, "Save", mock.Anything, mock.MatchedBy(func(entry audit.AuditEntry) bool {
+		return entry.Action == audit.GrantUserPermissionAction
+	}))
+	testCase.EventPublisher.AssertCalled(t, "Publish", mock.Anything, mock.AnythingOfType("user.UserPermissionGrantedEvent"))
+}
+
+func TestExecuteVersionMismatch(t *testing.T) {
+	testCase := setUp(t)
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(&user.User{Email: "testEmail", Version: 3}, nil)
+	expectedVersion := int64(2)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &GrantUserPermissionRequest{UserEmail: "testEmail", PermissionName: "testPermission", ExpectedVersion: &expectedVersion})
+
+	if _, isMismatch := response.Err.(user.UserVersionMismatchError); !isMismatch {
+		t.Fatal("Expected use case to return a version mismatch error")
+	}
+	testCase.UserRepo.AssertNotCalled(t, "SavePermissionGrant")
+}
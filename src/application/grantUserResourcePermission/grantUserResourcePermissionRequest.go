@@ -0,0 +1,9 @@
+package grantUserResourcePermission
+
+type GrantUserResourcePermissionRequest struct {
+	UserEmail       string
+	PermissionName  string
+	ResourceType    string
+	ResourceID      string
+	ExpectedVersion *int64
+}
@@ -0,0 +1,91 @@
+package grantUserResourcePermission
+
+import (
+	"context"
+	"fmt"
+	"go-as/src/domain/audit"
+	"go-as/src/domain/internals"
+	"go-as/src/domain/permission"
+	"go-as/src/domain/user"
+)
+
+type GrantUserResourcePermissionUseCase struct {
+	userRepository       user.UserRepository
+	permissionRepository permission.PermissionRepository
+	changeRecorder       *audit.ChangeRecorder
+	transactionManager   internals.TransactionManager
+	logger               internals.Logger
+}
+
+func (useCase *GrantUserResourcePermissionUseCase) Execute(ctx context.Context, request any) internals.UseCaseResponse {
+	validatedRequest, errResponse := internals.ValidateUseCaseRequest[*GrantUserResourcePermissionRequest](request)
+	if errResponse != nil {
+		return *errResponse
+	}
+
+	useCase.logger.Info(ctx, fmt.Sprintf("Starting granting permission %s on %s resources to %s", validatedRequest.PermissionName, validatedRequest.ResourceType, validatedRequest.UserEmail))
+	defer useCase.logger.Info(ctx, fmt.Sprintf("Finished granting permission %s on %s resources to %s", validatedRequest.PermissionName, validatedRequest.ResourceType, validatedRequest.UserEmail))
+
+	foundUser, err := useCase.userRepository.FindByEmail(ctx, validatedRequest.UserEmail)
+	if err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+	if foundUser == nil {
+		return internals.ErrorUseCaseResponse(fmt.Errorf("user %s not found", validatedRequest.UserEmail))
+	}
+	if err = foundUser.CheckVersion(validatedRequest.ExpectedVersion); err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+
+	permissions, err := useCase.permissionRepository.FindByNames(ctx, []string{validatedRequest.PermissionName})
+	if err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+	if len(permissions) == 0 {
+		return internals.ErrorUseCaseResponse(fmt.Errorf("permission %s not found", validatedRequest.PermissionName))
+	}
+
+	grant := permission.NewResourceGrant(validatedRequest.PermissionName, validatedRequest.ResourceType, validatedRequest.ResourceID)
+	if foundUser.HasResourceGrant(grant) {
+		return internals.EmptyUseCaseResponse()
+	}
+	foundUser.ResourcePermissions = append(foundUser.ResourcePermissions, user.UserResourcePermission{
+		UserEmail:     foundUser.Email,
+		ResourceGrant: grant,
+	})
+	err = useCase.transactionManager.Transaction(ctx, func(ctx context.Context) error {
+		if err := useCase.userRepository.Save(ctx, *foundUser); err != nil {
+			return err
+		}
+		return useCase.changeRecorder.Record(ctx, audit.Change{
+			Action:     audit.GrantUserResourcePermissionAction,
+			TargetType: audit.UserTargetType,
+			Target:     foundUser.Email,
+			After:      grant,
+			Event: user.UserResourcePermissionGrantedEvent{
+				Email:          foundUser.Email,
+				PermissionName: grant.PermissionName,
+				ResourceType:   grant.ResourceType,
+				ResourceID:     grant.ResourceID,
+			},
+		})
+	})
+	if err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+	return internals.EmptyUseCaseResponse()
+}
+
+func (*GrantUserResourcePermissionUseCase) RequiredPermissions() []string {
+	return []string{user.UpdateUserPermission}
+}
+
+func NewGrantUserResourcePermissionUseCase(userRepository user.UserRepository, permissionRepository permission.PermissionRepository, changeRecorder *audit.ChangeRecorder, transactionManager internals.TransactionManager, logger internals.Logger) *GrantUserResourcePermissionUseCase {
+	return &GrantUserResourcePermissionUseCase{
+		userRepository:       userRepository,
+		permissionRepository: permissionRepository,
+		changeRecorder:       changeRecorder,
+		transactionManager:   transactionManager,
+		logger:               logger,
+	}
+}
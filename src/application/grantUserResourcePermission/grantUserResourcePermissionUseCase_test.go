@@ -0,0 +1,204 @@
+package grantUserResourcePermission
+
+import (
+	"context"
+	"errors"
+	"go-as/mocks"
+	"go-as/src/domain/audit"
+	"go-as/src/domain/permission"
+	"go-as/src/domain/user"
+	"go-as/src/infrastructure/logging"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"go.elastic.co/apm/v2"
+)
+
+type testCase struct {
+	UserRepo           *mocks.UserRepository
+	PermissionRepo     *mocks.PermissionRepository
+	AuditRepo          *mocks.AuditRepository
+	EventPublisher     *mocks.EventPublisher
+	TransactionManager *mocks.TransactionManager
+	UseCase            *GrantUserResourcePermissionUseCase
+}
+
+func setUp(t *testing.T) testCase {
+	tracer := apm.DefaultTracer()
+	logger := logging.NewZapTracedLogger(tracer)
+	userRepoMock := mocks.NewUserRepository(t)
+	permissionRepoMock := mocks.NewPermissionRepository(t)
+	auditRepoMock := mocks.NewAuditRepository(t)
+	auditRepoMock.On("Save", mock.Anything, mock.Anything).Return(nil).Maybe()
+	eventPublisherMock := mocks.NewEventPublisher(t)
+	eventPublisherMock.On("Publish", mock.Anything, mock.Anything).Return(nil).Maybe()
+	transactionManagerMock := mocks.NewTransactionManager(t)
+	transactionManagerMock.On("Transaction", mock.Anything, mock.Anything).Return(func(ctx context.Context, operation func(context.Context) error) error {
+		return operation(ctx)
+	}).Maybe()
+	return testCase{
+		UserRepo:           userRepoMock,
+		PermissionRepo:     permissionRepoMock,
+		AuditRepo:          auditRepoMock,
+		EventPublisher:     eventPublisherMock,
+		TransactionManager: transactionManagerMock,
+		UseCase:            NewGrantUserResourcePermissionUseCase(userRepoMock, permissionRepoMock, audit.NewChangeRecorder(auditRepoMock, eventPublisherMock), transactionManagerMock, logger),
+	}
+}
+
+func TestExecuteWrongRequest(t *testing.T) {
+	testCase := setUp(t)
+	request := "wrongRequest"
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err == nil {
+		t.Fatal("Expected use case to return error")
+	}
+	testCase.UserRepo.AssertNotCalled(t, "FindByEmail")
+	testCase.PermissionRepo.AssertNotCalled(t, "FindByNames")
+	testCase.UserRepo.AssertNotCalled(t, "Save")
+}
+
+func TestExecuteUserNotFound(t *testing.T) {
+	testCase := setUp(t)
+	request := GrantUserResourcePermissionRequest{
+		UserEmail:      "testEmail",
+		PermissionName: "testPermission",
+		ResourceType:   "testResourceType",
+	}
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(nil, nil)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err == nil {
+		t.Fatal("Expected use case to return error")
+	}
+	testCase.UserRepo.AssertCalled(t, "FindByEmail", ctx, request.UserEmail)
+	testCase.PermissionRepo.AssertNotCalled(t, "FindByNames")
+	testCase.UserRepo.AssertNotCalled(t, "Save")
+}
+
+func TestExecuteVersionMismatch(t *testing.T) {
+	testCase := setUp(t)
+	expectedVersion := int64(2)
+	request := GrantUserResourcePermissionRequest{
+		UserEmail:       "testEmail",
+		PermissionName:  "testPermission",
+		ResourceType:    "testResourceType",
+		ExpectedVersion: &expectedVersion,
+	}
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(&user.User{Email: "testEmail", Version: 3}, nil)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if _, isMismatch := response.Err.(user.UserVersionMismatchError); !isMismatch {
+		t.Fatal("Expected use case to return a version mismatch error")
+	}
+	testCase.PermissionRepo.AssertNotCalled(t, "FindByNames")
+	testCase.UserRepo.AssertNotCalled(t, "Save")
+}
+
+func TestExecutePermissionNotFound(t *testing.T) {
+	testCase := setUp(t)
+	request := GrantUserResourcePermissionRequest{
+		UserEmail:      "testEmail",
+		PermissionName: "testPermission",
+		ResourceType:   "testResourceType",
+	}
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(&user.User{Email: "testEmail"}, nil)
+	testCase.PermissionRepo.On("FindByNames", mock.Anything, mock.Anything).Return(make([]permission.Permission, 0), nil)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err == nil {
+		t.Fatal("Expected use case to return error")
+	}
+	testCase.PermissionRepo.AssertCalled(t, "FindByNames", ctx, []string{request.PermissionName})
+	testCase.UserRepo.AssertNotCalled(t, "Save")
+}
+
+func TestExecuteAlreadyGranted(t *testing.T) {
+	testCase := setUp(t)
+	request := GrantUserResourcePermissionRequest{
+		UserEmail:      "testEmail",
+		PermissionName: "testPermission",
+		ResourceType:   "testResourceType",
+		ResourceID:     "testResourceID",
+	}
+	testUser := &user.User{
+		Email: "testEmail",
+		ResourcePermissions: []user.UserResourcePermission{{
+			UserEmail:     "testEmail",
+			ResourceGrant: permission.NewResourceGrant("testPermission", "testResourceType", "testResourceID"),
+		}},
+	}
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(testUser, nil)
+	testCase.PermissionRepo.On("FindByNames", mock.Anything, mock.Anything).Return([]permission.Permission{{Name: "testPermission"}}, nil)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != nil {
+		t.Fatal("Expected use case not to return error")
+	}
+	testCase.UserRepo.AssertNotCalled(t, "Save")
+}
+
+func TestExecuteSaveUserError(t *testing.T) {
+	testCase := setUp(t)
+	request := GrantUserResourcePermissionRequest{
+		UserEmail:      "testEmail",
+		PermissionName: "testPermission",
+		ResourceType:   "testResourceType",
+	}
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(&user.User{Email: "testEmail"}, nil)
+	testCase.PermissionRepo.On("FindByNames", mock.Anything, mock.Anything).Return([]permission.Permission{{Name: "testPermission"}}, nil)
+	testError := errors.New("Test error")
+	testCase.UserRepo.On("Save", mock.Anything, mock.Anything).Return(testError)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err == nil {
+		t.Fatal("Expected use case to return error")
+	}
+	if response.Err != testError {
+		t.Fatal("Expected use case to return same error as save user error")
+	}
+}
+
+func TestExecuteSuccess(t *testing.T) {
+	testCase := setUp(t)
+	request := GrantUserResourcePermissionRequest{
+		UserEmail:      "testEmail",
+		PermissionName: "testPermission",
+		ResourceType:   "testResourceType",
+	}
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(&user.User{Email: "testEmail"}, nil)
+	testCase.PermissionRepo.On("FindByNames", mock.Anything, mock.Anything).Return([]permission.Permission{{Name: "testPermission"}}, nil)
+	testCase.UserRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != nil {
+		t.Fatal("Expected use case not to return error")
+	}
+	testCase.UserRepo.AssertCalled(t, "Save", ctx, mock.MatchedBy(func(savedUser user.User) bool {
+		expectedGrant := permission.ResourceGrant{
+			PermissionName: "testPermission",
+			ResourceType:   "testResourceType",
+			ResourceID:     permission.AnyResourceID,
+		}
+		return savedUser.Email == request.UserEmail && len(savedUser.ResourcePermissions) == 1 && savedUser.ResourcePermissions[0].ResourceGrant == expectedGrant
+	}))
+	testCase.AuditRepo.AssertCalled(t, "Save", mock.Anything, mock.MatchedBy(func(entry audit.AuditEntry) bool {
+		return entry.Action == audit.GrantUserResourcePermissionAction
+	}))
+	testCase.EventPublisher.AssertCalled(t, "Publish", mock.Anything, mock.AnythingOfType("user.UserResourcePermissionGrantedEvent"))
+}
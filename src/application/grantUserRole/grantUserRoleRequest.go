@@ -0,0 +1,10 @@
+package grantUserRole
+
+import "go-as/src/domain/user"
+
+type GrantUserRoleRequest struct {
+	UserEmail       string
+	RoleName        string
+	Validity        user.GrantValidity
+	ExpectedVersion *int64
+}
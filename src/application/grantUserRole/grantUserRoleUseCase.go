@@ -0,0 +1,91 @@
+package grantUserRole
+
+import (
+	"context"
+	"fmt"
+	"go-as/src/domain/audit"
+	"go-as/src/domain/internals"
+	"go-as/src/domain/role"
+	"go-as/src/domain/user"
+)
+
+type GrantUserRoleUseCase struct {
+	userRepository     user.UserRepository
+	roleRepository     role.RoleRepository
+	changeRecorder     *audit.ChangeRecorder
+	transactionManager internals.TransactionManager
+	logger             internals.Logger
+}
+
+func (useCase *GrantUserRoleUseCase) Execute(ctx context.Context, request any) internals.UseCaseResponse {
+	validatedRequest, errResponse := internals.ValidateUseCaseRequest[*GrantUserRoleRequest](request)
+	if errResponse != nil {
+		return *errResponse
+	}
+
+	useCase.logger.Info(ctx, fmt.Sprintf("Starting granting role %s to %s", validatedRequest.RoleName, validatedRequest.UserEmail))
+	defer useCase.logger.Info(ctx, fmt.Sprintf("Finished granting role %s to %s", validatedRequest.RoleName, validatedRequest.UserEmail))
+
+	if err := validatedRequest.Validity.Validate(); err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+	foundUser, err := useCase.userRepository.FindByEmail(ctx, validatedRequest.UserEmail)
+	if err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+	if foundUser == nil {
+		return internals.ErrorUseCaseResponse(user.UserNotFoundError{Email: validatedRequest.UserEmail})
+	}
+	if err = foundUser.CheckVersion(validatedRequest.ExpectedVersion); err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+	roles, err := useCase.roleRepository.FindByNames(ctx, []string{validatedRequest.RoleName})
+	if err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+	if len(roles) == 0 {
+		return internals.ErrorUseCaseResponse(role.RoleNotFoundError{RoleName: validatedRequest.RoleName})
+	}
+
+	grant := user.UserRole{
+		UserEmail:     validatedRequest.UserEmail,
+		RoleName:      validatedRequest.RoleName,
+		GrantValidity: validatedRequest.Validity,
+	}
+	err = useCase.transactionManager.Transaction(ctx, func(ctx context.Context) error {
+		if err := useCase.userRepository.SaveRoleGrant(ctx, grant, foundUser.Version); err != nil {
+			return err
+		}
+		return useCase.changeRecorder.Record(ctx, audit.Change{
+			Action:     audit.GrantUserRoleAction,
+			TargetType: audit.UserTargetType,
+			Target:     foundUser.Email,
+			Before:     foundUser.FindRoleGrant(grant.RoleName),
+			After:      grant,
+			Event: user.UserRoleGrantedEvent{
+				Email:      foundUser.Email,
+				RoleName:   grant.RoleName,
+				ValidFrom:  grant.ValidFrom,
+				ValidUntil: grant.ValidUntil,
+			},
+		})
+	})
+	if err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+	return internals.EmptyUseCaseResponse()
+}
+
+func (*GrantUserRoleUseCase) RequiredPermissions() []string {
+	return []string{user.UpdateUserPermission}
+}
+
+func NewGrantUserRoleUseCase(userRepository user.UserRepository, roleRepository role.RoleRepository, changeRecorder *audit.ChangeRecorder, transactionManager internals.TransactionManager, logger internals.Logger) *GrantUserRoleUseCase {
+	return &GrantUserRoleUseCase{
+		userRepository:     userRepository,
+		roleRepository:     roleRepository,
+		changeRecorder:     changeRecorder,
+		transactionManager: transactionManager,
+		logger:             logger,
+	}
+}
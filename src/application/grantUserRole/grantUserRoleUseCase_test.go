@@ -0,0 +1,147 @@
+package grantUserRole
+
+import (
+	"context"
+	"errors"
+	"go-as/mocks"
+	"go-as/src/domain/audit"
+	"go-as/src/domain/role"
+	"go-as/src/domain/user"
+	"go-as/src/infrastructure/logging"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"go.elastic.co/apm/v2"
+)
+
+type testCase struct {
+	UserRepo           *mocks.UserRepository
+	RoleRepo           *mocks.RoleRepository
+	AuditRepo          *mocks.AuditRepository
+	EventPublisher     *mocks.EventPublisher
+	TransactionManager *mocks.TransactionManager
+	UseCase            *GrantUserRoleUseCase
+}
+
+func setUp(t *testing.T) testCase {
+	tracer := apm.DefaultTracer()
+	logger := logging.NewZapTracedLogger(tracer)
+	userRepoMock := mocks.NewUserRepository(t)
+	roleRepoMock := mocks.NewRoleRepository(t)
+	auditRepoMock := mocks.NewAuditRepository(t)
+	auditRepoMock.On("Save", mock.Anything, mock.Anything).Return(nil).Maybe()
+	eventPublisherMock := mocks.NewEventPublisher(t)
+	eventPublisherMock.On("Publish", mock.Anything, mock.Anything).Return(nil).Maybe()
+	transactionManagerMock := mocks.NewTransactionManager(t)
+	transactionManagerMock.On("Transaction", mock.Anything, mock.Anything).Return(func(ctx context.Context, operation func(context.Context) error) error {
+		return operation(ctx)
+	}).Maybe()
+	return testCase{
+		UserRepo:           userRepoMock,
+		RoleRepo:           roleRepoMock,
+		AuditRepo:          auditRepoMock,
+		EventPublisher:     eventPublisherMock,
+		TransactionManager: transactionManagerMock,
+		UseCase:            NewGrantUserRoleUseCase(userRepoMock, roleRepoMock, audit.NewChangeRecorder(auditRepoMock, eventPublisherMock), transactionManagerMock, logger),
+	}
+}
+
+func TestExecuteWrongRequest(t *testing.T) {
+	testCase := setUp(t)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, "wrongRequest")
+
+	if response.Err == nil {
+		t.Fatal("Expected use case to return error")
+	}
+	testCase.UserRepo.AssertNotCalled(t, "SaveRoleGrant")
+}
+
+func TestExecuteUserNotFound(t *testing.T) {
+	testCase := setUp(t)
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(nil, nil)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &GrantUserRoleRequest{UserEmail: "testEmail", RoleName: "testRole"})
+
+	if _, ok := response.Err.(user.UserNotFoundError); !ok {
+		t.Fatal("Expected use case to return user not found error")
+	}
+	testCase.UserRepo.AssertNotCalled(t, "SaveRoleGrant")
+}
+
+func TestExecuteRoleNotFound(t *testing.T) {
+	testCase := setUp(t)
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(&user.User{Email: "testEmail", Version: 3}, nil)
+	testCase.RoleRepo.On("FindByNames", mock.Anything, mock.Anything).Return([]role.Role{}, nil)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &GrantUserRoleRequest{UserEmail: "testEmail", RoleName: "testRole"})
+
+	if _, ok := response.Err.(role.RoleNotFoundError); !ok {
+		t.Fatal("Expected use case to return role not found error")
+	}
+	testCase.UserRepo.AssertNotCalled(t, "SaveRoleGrant")
+}
+
+func TestExecuteSaveGrantError(t *testing.T) {
+	testCase := setUp(t)
+	saveError := errors.New("Test save error")
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(&user.User{Email: "testEmail", Version: 3}, nil)
+	testCase.RoleRepo.On("FindByNames", mock.Anything, mock.Anything).Return([]role.Role{{Name: "testRole"}}, nil)
+	testCase.UserRepo.On("SaveRoleGrant", mock.Anything, mock.Anything, mock.Anything).Return(saveError)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &GrantUserRoleRequest{UserEmail: "testEmail", RoleName: "testRole"})
+
+	if response.Err != saveError {
+		t.Fatal("Error expected to be the same as the user repository returned error")
+	}
+}
+
+func TestExecuteSuccess(t *testing.T) {
+	testCase := setUp(t)
+	validFrom := time.Now().Add(time.Hour)
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(&user.User{Email: "testEmail", Version: 3}, nil)
+	testCase.RoleRepo.On("FindByNames", mock.Anything, mock.Anything).Return([]role.Role{{Name: "testRole"}}, nil)
+	testCase.UserRepo.On("SaveRoleGrant", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	request := GrantUserRoleRequest{
+		UserEmail: "testEmail",
+		RoleName:  "testRole",
+		Validity:  user.GrantValidity{ValidFrom: &validFrom},
+	}
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != nil {
+		t.Fatal("Expected use case not to return error")
+	}
+	expectedGrant := user.UserRole{
+		UserEmail:     "testEmail",
+		RoleName:      "testRole",
+		GrantValidity: user.GrantValidity{ValidFrom: &validFrom},
+	}
+	testCase.UserRepo.AssertCalled(t, "SaveRoleGrant", ctx, expectedGrant, int64(3))
+	testCase.UserRepo.AssertNotCalled(t, "Save")
+	testCase.AuditRepo.AssertCalled(t, "Save", mock.Anything, mock.MatchedBy(func(entry audit.AuditEntry) bool {
+		return entry.Action == audit.GrantUserRoleAction
+	}))
+	testCase.EventPublisher.AssertCalled(t, "Publish", mock.Anything, mock.AnythingOfType("user.UserRoleGrantedEvent"))
+}
+
+func TestExecuteVersionMismatch(t *testing.T) {
+	testCase := setUp(t)
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(&user.User{Email: "testEmail", Version: 3}, nil)
+	expectedVersion := int64(2)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &GrantUserRoleRequest{UserEmail: "testEmail", RoleName: "testRole", ExpectedVersion: &expectedVersion})
+
+	if _, isMismatch := response.Err.(user.UserVersionMismatchError); !isMismatch {
+		t.Fatal("Expected use case to return a version mismatch error")
+	}
+	testCase.UserRepo.AssertNotCalled(t, "SaveRoleGrant")
+}
@@ -0,0 +1,14 @@
+package listAuditEntries
+
+import "time"
+
+type ListAuditEntriesRequest struct {
+	Actor      string
+	Action     string
+	TargetType string
+	Target     string
+	From       *time.Time
+	To         *time.Time
+	Page       int
+	Size       int
+}
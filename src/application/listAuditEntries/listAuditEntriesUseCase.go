@@ -0,0 +1,53 @@
+package listAuditEntries
+
+import (
+	"context"
+	"fmt"
+	"go-as/src/domain/audit"
+	"go-as/src/domain/internals"
+	"go-as/src/domain/pagination"
+)
+
+type ListAuditEntriesUseCase struct {
+	auditRepository audit.AuditRepository
+	logger          internals.Logger
+}
+
+func (useCase *ListAuditEntriesUseCase) Execute(ctx context.Context, request any) internals.UseCaseResponse {
+	validatedRequest, errResponse := internals.ValidateUseCaseRequest[*ListAuditEntriesRequest](request)
+	if errResponse != nil {
+		return *errResponse
+	}
+
+	pageRequest := pagination.NewPageRequest(validatedRequest.Page, validatedRequest.Size)
+	useCase.logger.Info(ctx, fmt.Sprintf("Starting listing audit entries page %d", pageRequest.Page))
+	defer useCase.logger.Info(ctx, fmt.Sprintf("Finished listing audit entries page %d", pageRequest.Page))
+
+	filter := audit.AuditFilter{
+		Actor:      validatedRequest.Actor,
+		Action:     validatedRequest.Action,
+		TargetType: validatedRequest.TargetType,
+		Target:     validatedRequest.Target,
+		From:       validatedRequest.From,
+		To:         validatedRequest.To,
+	}
+	page, err := useCase.auditRepository.FindPage(ctx, filter, pageRequest)
+	if err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+	return internals.UseCaseResponse{
+		Content: page,
+		Err:     nil,
+	}
+}
+
+func (*ListAuditEntriesUseCase) RequiredPermissions() []string {
+	return []string{audit.ReadAuditPermission}
+}
+
+func NewListAuditEntriesUseCase(auditRepository audit.AuditRepository, logger internals.Logger) *ListAuditEntriesUseCase {
+	return &ListAuditEntriesUseCase{
+		auditRepository: auditRepository,
+		logger:          logger,
+	}
+}
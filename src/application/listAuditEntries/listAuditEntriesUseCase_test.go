@@ -0,0 +1,93 @@
+package listAuditEntries
+
+import (
+	"context"
+	"errors"
+	"go-as/mocks"
+	"go-as/src/domain/audit"
+	"go-as/src/domain/pagination"
+	"go-as/src/infrastructure/logging"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"go.elastic.co/apm/v2"
+)
+
+type testCase struct {
+	AuditRepo *mocks.AuditRepository
+	UseCase   *ListAuditEntriesUseCase
+}
+
+func setUp(t *testing.T) testCase {
+	tracer := apm.DefaultTracer()
+	logger := logging.NewZapTracedLogger(tracer)
+	auditRepoMock := mocks.NewAuditRepository(t)
+	return testCase{
+		AuditRepo: auditRepoMock,
+		UseCase:   NewListAuditEntriesUseCase(auditRepoMock, logger),
+	}
+}
+
+func TestExecuteWrongRequest(t *testing.T) {
+	testCase := setUp(t)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, "wrongRequest")
+
+	if response.Err == nil {
+		t.Fatal("Expected use case to return error")
+	}
+	testCase.AuditRepo.AssertNotCalled(t, "FindPage")
+}
+
+func TestExecuteFindPageError(t *testing.T) {
+	testCase := setUp(t)
+	findError := errors.New("Test find error")
+	testCase.AuditRepo.On("FindPage", mock.Anything, mock.Anything, mock.Anything).Return(nil, findError)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &ListAuditEntriesRequest{})
+
+	if response.Err != findError {
+		t.Fatal("Error expected to be the same as the audit repository returned error")
+	}
+}
+
+func TestExecuteSuccess(t *testing.T) {
+	testCase := setUp(t)
+	page := pagination.Page[audit.AuditEntry]{
+		Items:       []audit.AuditEntry{{ID: 1, Actor: "testActor"}},
+		Total:       1,
+		PageRequest: pagination.PageRequest{Page: 2, Size: 10},
+	}
+	testCase.AuditRepo.On("FindPage", mock.Anything, mock.Anything, mock.Anything).Return(&page, nil)
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	request := ListAuditEntriesRequest{
+		Actor:      "testActor",
+		Action:     audit.UpdateUserRolesAction,
+		TargetType: audit.UserTargetType,
+		Target:     "testEmail",
+		From:       &from,
+		Page:       2,
+		Size:       10,
+	}
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != nil {
+		t.Fatal("Expected use case not to return error")
+	}
+	if response.Content != &page {
+		t.Fatal("Expected use case to return the repository page")
+	}
+	expectedFilter := audit.AuditFilter{
+		Actor:      "testActor",
+		Action:     audit.UpdateUserRolesAction,
+		TargetType: audit.UserTargetType,
+		Target:     "testEmail",
+		From:       &from,
+	}
+	testCase.AuditRepo.AssertCalled(t, "FindPage", ctx, expectedFilter, pagination.PageRequest{Page: 2, Size: 10})
+}
@@ -0,0 +1,7 @@
+package listPermissions
+
+type ListPermissionsRequest struct {
+	Prefix string
+	Page   int
+	Size   int
+}
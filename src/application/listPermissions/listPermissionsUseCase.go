@@ -0,0 +1,45 @@
+package listPermissions
+
+import (
+	"context"
+	"fmt"
+	"go-as/src/domain/internals"
+	"go-as/src/domain/pagination"
+	"go-as/src/domain/permission"
+)
+
+type ListPermissionsUseCase struct {
+	permissionRepository permission.PermissionRepository
+	logger               internals.Logger
+}
+
+func (useCase *ListPermissionsUseCase) Execute(ctx context.Context, request any) internals.UseCaseResponse {
+	validatedRequest, errResponse := internals.ValidateUseCaseRequest[*ListPermissionsRequest](request)
+	if errResponse != nil {
+		return *errResponse
+	}
+
+	pageRequest := pagination.NewPageRequest(validatedRequest.Page, validatedRequest.Size)
+	useCase.logger.Info(ctx, fmt.Sprintf("Starting listing permissions with prefix %q page %d", validatedRequest.Prefix, pageRequest.Page))
+	defer useCase.logger.Info(ctx, fmt.Sprintf("Finished listing permissions with prefix %q page %d", validatedRequest.Prefix, pageRequest.Page))
+
+	page, err := useCase.permissionRepository.FindPageByPrefix(ctx, validatedRequest.Prefix, pageRequest)
+	if err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+	return internals.UseCaseResponse{
+		Content: page,
+		Err:     nil,
+	}
+}
+
+func (*ListPermissionsUseCase) RequiredPermissions() []string {
+	return []string{permission.ReadPermissionPermission}
+}
+
+func NewListPermissionsUseCase(permissionRepository permission.PermissionRepository, logger internals.Logger) *ListPermissionsUseCase {
+	return &ListPermissionsUseCase{
+		permissionRepository: permissionRepository,
+		logger:               logger,
+	}
+}
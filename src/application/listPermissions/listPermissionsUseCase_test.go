@@ -0,0 +1,75 @@
+package listPermissions
+
+import (
+	"context"
+	"errors"
+	"go-as/mocks"
+	"go-as/src/domain/pagination"
+	"go-as/src/domain/permission"
+	"go-as/src/infrastructure/logging"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"go.elastic.co/apm/v2"
+)
+
+type testCase struct {
+	PermissionRepo *mocks.PermissionRepository
+	UseCase        *ListPermissionsUseCase
+}
+
+func setUp(t *testing.T) testCase {
+	tracer := apm.DefaultTracer()
+	logger := logging.NewZapTracedLogger(tracer)
+	permissionRepoMock := mocks.NewPermissionRepository(t)
+	return testCase{
+		PermissionRepo: permissionRepoMock,
+		UseCase:        NewListPermissionsUseCase(permissionRepoMock, logger),
+	}
+}
+
+func TestExecuteWrongRequest(t *testing.T) {
+	testCase := setUp(t)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, "wrongRequest")
+
+	if response.Err == nil {
+		t.Fatal("Expected use case to return error")
+	}
+	testCase.PermissionRepo.AssertNotCalled(t, "FindPageByPrefix")
+}
+
+func TestExecuteFindPageError(t *testing.T) {
+	testCase := setUp(t)
+	findError := errors.New("Test find error")
+	testCase.PermissionRepo.On("FindPageByPrefix", mock.Anything, mock.Anything, mock.Anything).Return(nil, findError)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &ListPermissionsRequest{Prefix: "Test"})
+
+	if response.Err != findError {
+		t.Fatal("Error expected to be the same as the permission repository returned error")
+	}
+}
+
+func TestExecuteSuccess(t *testing.T) {
+	testCase := setUp(t)
+	page := pagination.Page[permission.Permission]{
+		Items:       []permission.Permission{{Name: "TestPermission"}},
+		Total:       1,
+		PageRequest: pagination.PageRequest{Page: 1, Size: pagination.DefaultPageSize},
+	}
+	testCase.PermissionRepo.On("FindPageByPrefix", mock.Anything, mock.Anything, mock.Anything).Return(&page, nil)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &ListPermissionsRequest{Prefix: "Test"})
+
+	if response.Err != nil {
+		t.Fatal("Expected use case not to return error")
+	}
+	if response.Content != &page {
+		t.Fatal("Expected use case to return the repository page")
+	}
+	testCase.PermissionRepo.AssertCalled(t, "FindPageByPrefix", ctx, "Test", pagination.PageRequest{Page: 1, Size: pagination.DefaultPageSize})
+}
@@ -0,0 +1,6 @@
+package listRoles
+
+type ListRolesRequest struct {
+	Page int
+	Size int
+}
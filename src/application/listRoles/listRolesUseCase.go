@@ -0,0 +1,45 @@
+package listRoles
+
+import (
+	"context"
+	"fmt"
+	"go-as/src/domain/internals"
+	"go-as/src/domain/pagination"
+	"go-as/src/domain/role"
+)
+
+type ListRolesUseCase struct {
+	roleRepository role.RoleRepository
+	logger         internals.Logger
+}
+
+func (useCase *ListRolesUseCase) Execute(ctx context.Context, request any) internals.UseCaseResponse {
+	validatedRequest, errResponse := internals.ValidateUseCaseRequest[*ListRolesRequest](request)
+	if errResponse != nil {
+		return *errResponse
+	}
+
+	pageRequest := pagination.NewPageRequest(validatedRequest.Page, validatedRequest.Size)
+	useCase.logger.Info(ctx, fmt.Sprintf("Starting listing roles page %d", pageRequest.Page))
+	defer useCase.logger.Info(ctx, fmt.Sprintf("Finished listing roles page %d", pageRequest.Page))
+
+	page, err := useCase.roleRepository.FindPage(ctx, pageRequest)
+	if err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+	return internals.UseCaseResponse{
+		Content: page,
+		Err:     nil,
+	}
+}
+
+func (*ListRolesUseCase) RequiredPermissions() []string {
+	return []string{role.ReadRolePermission}
+}
+
+func NewListRolesUseCase(roleRepository role.RoleRepository, logger internals.Logger) *ListRolesUseCase {
+	return &ListRolesUseCase{
+		roleRepository: roleRepository,
+		logger:         logger,
+	}
+}
@@ -0,0 +1,89 @@
+package listRoles
+
+import (
+	"context"
+	"errors"
+	"go-as/mocks"
+	"go-as/src/domain/pagination"
+	"go-as/src/domain/role"
+	"go-as/src/infrastructure/logging"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"go.elastic.co/apm/v2"
+)
+
+type testCase struct {
+	RoleRepo *mocks.RoleRepository
+	UseCase  *ListRolesUseCase
+}
+
+func setUp(t *testing.T) testCase {
+	tracer := apm.DefaultTracer()
+	logger := logging.NewZapTracedLogger(tracer)
+	roleRepoMock := mocks.NewRoleRepository(t)
+	return testCase{
+		RoleRepo: roleRepoMock,
+		UseCase:  NewListRolesUseCase(roleRepoMock, logger),
+	}
+}
+
+func TestExecuteWrongRequest(t *testing.T) {
+	testCase := setUp(t)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, "wrongRequest")
+
+	if response.Err == nil {
+		t.Fatal("Expected use case to return error")
+	}
+	testCase.RoleRepo.AssertNotCalled(t, "FindPage")
+}
+
+func TestExecuteFindPageError(t *testing.T) {
+	testCase := setUp(t)
+	findError := errors.New("Test find error")
+	testCase.RoleRepo.On("FindPage", mock.Anything, mock.Anything).Return(nil, findError)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &ListRolesRequest{Page: 2, Size: 10})
+
+	if response.Err != findError {
+		t.Fatal("Error expected to be the same as the role repository returned error")
+	}
+}
+
+func TestExecuteClampsPageRequest(t *testing.T) {
+	testCase := setUp(t)
+	page := pagination.Page[role.Role]{}
+	testCase.RoleRepo.On("FindPage", mock.Anything, mock.Anything).Return(&page, nil)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &ListRolesRequest{Page: 0, Size: 1000})
+
+	if response.Err != nil {
+		t.Fatal("Expected use case not to return error")
+	}
+	testCase.RoleRepo.AssertCalled(t, "FindPage", ctx, pagination.PageRequest{Page: 1, Size: pagination.MaxPageSize})
+}
+
+func TestExecuteSuccess(t *testing.T) {
+	testCase := setUp(t)
+	page := pagination.Page[role.Role]{
+		Items:       []role.Role{{Name: "Test role"}},
+		Total:       11,
+		PageRequest: pagination.PageRequest{Page: 2, Size: 10},
+	}
+	testCase.RoleRepo.On("FindPage", mock.Anything, mock.Anything).Return(&page, nil)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &ListRolesRequest{Page: 2, Size: 10})
+
+	if response.Err != nil {
+		t.Fatal("Expected use case not to return error")
+	}
+	if response.Content != &page {
+		t.Fatal("Expected use case to return the repository page")
+	}
+	testCase.RoleRepo.AssertCalled(t, "FindPage", ctx, pagination.PageRequest{Page: 2, Size: 10})
+}
@@ -0,0 +1,8 @@
+package listUsers
+
+type ListUsersRequest struct {
+	RoleName       string
+	PermissionName string
+	Page           int
+	Size           int
+}
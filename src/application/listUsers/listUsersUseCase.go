@@ -0,0 +1,49 @@
+package listUsers
+
+import (
+	"context"
+	"fmt"
+	"go-as/src/domain/internals"
+	"go-as/src/domain/pagination"
+	"go-as/src/domain/user"
+)
+
+type ListUsersUseCase struct {
+	userRepository user.UserRepository
+	logger         internals.Logger
+}
+
+func (useCase *ListUsersUseCase) Execute(ctx context.Context, request any) internals.UseCaseResponse {
+	validatedRequest, errResponse := internals.ValidateUseCaseRequest[*ListUsersRequest](request)
+	if errResponse != nil {
+		return *errResponse
+	}
+
+	pageRequest := pagination.NewPageRequest(validatedRequest.Page, validatedRequest.Size)
+	useCase.logger.Info(ctx, fmt.Sprintf("Starting listing users page %d", pageRequest.Page))
+	defer useCase.logger.Info(ctx, fmt.Sprintf("Finished listing users page %d", pageRequest.Page))
+
+	filter := user.UserFilter{
+		RoleName:       validatedRequest.RoleName,
+		PermissionName: validatedRequest.PermissionName,
+	}
+	page, err := useCase.userRepository.FindPage(ctx, filter, pageRequest)
+	if err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+	return internals.UseCaseResponse{
+		Content: page,
+		Err:     nil,
+	}
+}
+
+func (*ListUsersUseCase) RequiredPermissions() []string {
+	return []string{user.ReadUserPermission}
+}
+
+func NewListUsersUseCase(userRepository user.UserRepository, logger internals.Logger) *ListUsersUseCase {
+	return &ListUsersUseCase{
+		userRepository: userRepository,
+		logger:         logger,
+	}
+}
@@ -0,0 +1,85 @@
+package listUsers
+
+import (
+	"context"
+	"errors"
+	"go-as/mocks"
+	"go-as/src/domain/pagination"
+	"go-as/src/domain/user"
+	"go-as/src/infrastructure/logging"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"go.elastic.co/apm/v2"
+)
+
+type testCase struct {
+	UserRepo *mocks.UserRepository
+	UseCase  *ListUsersUseCase
+}
+
+func setUp(t *testing.T) testCase {
+	tracer := apm.DefaultTracer()
+	logger := logging.NewZapTracedLogger(tracer)
+	userRepoMock := mocks.NewUserRepository(t)
+	return testCase{
+		UserRepo: userRepoMock,
+		UseCase:  NewListUsersUseCase(userRepoMock, logger),
+	}
+}
+
+func TestExecuteWrongRequest(t *testing.T) {
+	testCase := setUp(t)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, "wrongRequest")
+
+	if response.Err == nil {
+		t.Fatal("Expected use case to return error")
+	}
+	testCase.UserRepo.AssertNotCalled(t, "FindPage")
+}
+
+func TestExecuteFindPageError(t *testing.T) {
+	testCase := setUp(t)
+	findError := errors.New("Test find error")
+	testCase.UserRepo.On("FindPage", mock.Anything, mock.Anything, mock.Anything).Return(nil, findError)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &ListUsersRequest{})
+
+	if response.Err != findError {
+		t.Fatal("Error expected to be the same as the user repository returned error")
+	}
+}
+
+func TestExecuteSuccess(t *testing.T) {
+	testCase := setUp(t)
+	page := pagination.Page[user.User]{
+		Items:       []user.User{{Email: "testEmail"}},
+		Total:       1,
+		PageRequest: pagination.PageRequest{Page: 3, Size: 5},
+	}
+	testCase.UserRepo.On("FindPage", mock.Anything, mock.Anything, mock.Anything).Return(&page, nil)
+	request := ListUsersRequest{
+		RoleName:       "testRole",
+		PermissionName: "testPermission",
+		Page:           3,
+		Size:           5,
+	}
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != nil {
+		t.Fatal("Expected use case not to return error")
+	}
+	if response.Content != &page {
+		t.Fatal("Expected use case to return the repository page")
+	}
+	expectedFilter := user.UserFilter{
+		RoleName:       "testRole",
+		PermissionName: "testPermission",
+	}
+	testCase.UserRepo.AssertCalled(t, "FindPage", ctx, expectedFilter, pagination.PageRequest{Page: 3, Size: 5})
+}
@@ -0,0 +1,63 @@
+package purgeExpiredGrants
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type ExpiredGrantsSweeper struct {
+	purgeExpiredGrantsUseCase *PurgeExpiredGrantsUseCase
+	interval                  time.Duration
+	ctx                       context.Context
+	cancel                    context.CancelFunc
+	done                      chan struct{}
+	logger                    *zap.Logger
+}
+
+func (sweeper *ExpiredGrantsSweeper) Run() {
+	go sweeper.sweepPeriodically()
+}
+
+func (sweeper *ExpiredGrantsSweeper) Stop(ctx context.Context) error {
+	sweeper.cancel()
+	select {
+	case <-sweeper.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (sweeper *ExpiredGrantsSweeper) sweepPeriodically() {
+	defer close(sweeper.done)
+	ticker := time.NewTicker(sweeper.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-sweeper.ctx.Done():
+			return
+		case instant := <-ticker.C:
+			request := PurgeExpiredGrantsRequest{
+				Instant: instant,
+			}
+			if useCaseResponse := sweeper.purgeExpiredGrantsUseCase.Execute(sweeper.ctx, &request); useCaseResponse.Err != nil {
+				sweeper.logger.Warn(fmt.Sprintf("Error purging expired grants: %s", useCaseResponse.Err.Error()))
+			}
+		}
+	}
+}
+
+func NewExpiredGrantsSweeper(useCase *PurgeExpiredGrantsUseCase, interval time.Duration, logger *zap.Logger) *ExpiredGrantsSweeper {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &ExpiredGrantsSweeper{
+		purgeExpiredGrantsUseCase: useCase,
+		interval:                  interval,
+		ctx:                       ctx,
+		cancel:                    cancel,
+		done:                      make(chan struct{}),
+		logger:                    logger,
+	}
+}
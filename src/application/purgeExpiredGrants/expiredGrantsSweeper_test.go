@@ -0,0 +1,42 @@
+package purgeExpiredGrants
+
+import (
+	"context"
+	"go-as/src/domain/user"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestSweeperStopsAfterRunningSweeps(t *testing.T) {
+	testCase := setUp(t)
+	sweeps := make(chan struct{}, 10)
+	testCase.GrantRepo.On("DeleteExpiredPermissionGrants", mock.Anything, mock.Anything).Return(make([]user.UserPermission, 0), nil).Run(func(mock.Arguments) {
+		sweeps <- struct{}{}
+	})
+	testCase.GrantRepo.On("DeleteExpiredRoleGrants", mock.Anything, mock.Anything).Return(make([]user.UserRole, 0), nil)
+	sweeper := NewExpiredGrantsSweeper(testCase.UseCase, time.Millisecond, zap.NewNop())
+
+	sweeper.Run()
+	select {
+	case <-sweeps:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Expected sweeper to purge expired grants periodically")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	err := sweeper.Stop(ctx)
+
+	if err != nil {
+		t.Fatal("Expected sweeper to stop before the timeout")
+	}
+	for len(sweeps) > 0 {
+		<-sweeps
+	}
+	time.Sleep(10 * time.Millisecond)
+	if len(sweeps) != 0 {
+		t.Fatal("Expected sweeper not to purge after being stopped")
+	}
+}
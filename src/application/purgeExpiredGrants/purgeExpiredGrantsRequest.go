@@ -0,0 +1,7 @@
+package purgeExpiredGrants
+
+import "time"
+
+type PurgeExpiredGrantsRequest struct {
+	Instant time.Time
+}
@@ -0,0 +1,99 @@
+package purgeExpiredGrants
+
+import (
+	"context"
+	"fmt"
+	"go-as/src/domain/audit"
+	"go-as/src/domain/internals"
+	"go-as/src/domain/user"
+	"time"
+)
+
+type PurgeExpiredGrantsUseCase struct {
+	grantRepository    user.GrantRepository
+	changeRecorder     *audit.ChangeRecorder
+	transactionManager internals.TransactionManager
+	logger             internals.Logger
+}
+
+func (useCase *PurgeExpiredGrantsUseCase) Execute(ctx context.Context, request any) internals.UseCaseResponse {
+	validatedRequest, errResponse := internals.ValidateUseCaseRequest[*PurgeExpiredGrantsRequest](request)
+	if errResponse != nil {
+		return *errResponse
+	}
+
+	useCase.logger.Info(ctx, fmt.Sprintf("Starting purge of grants expired at %s", validatedRequest.Instant.Format(time.RFC3339)))
+	defer useCase.logger.Info(ctx, fmt.Sprintf("Finished purge of grants expired at %s", validatedRequest.Instant.Format(time.RFC3339)))
+
+	err := useCase.transactionManager.Transaction(ctx, func(ctx context.Context) error {
+		if err := useCase.purgePermissionGrants(ctx, validatedRequest.Instant); err != nil {
+			return err
+		}
+		return useCase.purgeRoleGrants(ctx, validatedRequest.Instant)
+	})
+	if err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+	return internals.EmptyUseCaseResponse()
+}
+
+func (useCase *PurgeExpiredGrantsUseCase) purgePermissionGrants(ctx context.Context, instant time.Time) error {
+	expiredPermissionGrants, err := useCase.grantRepository.DeleteExpiredPermissionGrants(ctx, instant)
+	if err != nil {
+		return err
+	}
+	for _, grant := range expiredPermissionGrants {
+		err = useCase.changeRecorder.Record(ctx, audit.Change{
+			Action:     audit.ExpireUserPermissionAction,
+			TargetType: audit.UserTargetType,
+			Target:     grant.UserEmail,
+			Before:     grant,
+			Event: user.UserPermissionGrantExpiredEvent{
+				Email:          grant.UserEmail,
+				PermissionName: grant.PermissionName,
+				ExpiredAt:      *grant.ValidUntil,
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (useCase *PurgeExpiredGrantsUseCase) purgeRoleGrants(ctx context.Context, instant time.Time) error {
+	expiredRoleGrants, err := useCase.grantRepository.DeleteExpiredRoleGrants(ctx, instant)
+	if err != nil {
+		return err
+	}
+	for _, grant := range expiredRoleGrants {
+		err = useCase.changeRecorder.Record(ctx, audit.Change{
+			Action:     audit.ExpireUserRoleAction,
+			TargetType: audit.UserTargetType,
+			Target:     grant.UserEmail,
+			Before:     grant,
+			Event: user.UserRoleGrantExpiredEvent{
+				Email:     grant.UserEmail,
+				RoleName:  grant.RoleName,
+				ExpiredAt: *grant.ValidUntil,
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (*PurgeExpiredGrantsUseCase) RequiredPermissions() []string {
+	return []string{}
+}
+
+func NewPurgeExpiredGrantsUseCase(grantRepository user.GrantRepository, changeRecorder *audit.ChangeRecorder, transactionManager internals.TransactionManager, logger internals.Logger) *PurgeExpiredGrantsUseCase {
+	return &PurgeExpiredGrantsUseCase{
+		grantRepository:    grantRepository,
+		changeRecorder:     changeRecorder,
+		transactionManager: transactionManager,
+		logger:             logger,
+	}
+}
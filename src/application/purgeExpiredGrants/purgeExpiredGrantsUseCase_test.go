@@ -0,0 +1,132 @@
+package purgeExpiredGrants
+
+import (
+	"context"
+	"errors"
+	"go-as/mocks"
+	"go-as/src/domain/audit"
+	"go-as/src/domain/internals"
+	"go-as/src/domain/user"
+	"go-as/src/infrastructure/logging"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"go.elastic.co/apm/v2"
+)
+
+type testCase struct {
+	GrantRepo          *mocks.GrantRepository
+	EventPublisher     *mocks.EventPublisher
+	TransactionManager *mocks.TransactionManager
+	AuditRepo          *mocks.AuditRepository
+	UseCase            *PurgeExpiredGrantsUseCase
+}
+
+func setUp(t *testing.T) testCase {
+	tracer := apm.DefaultTracer()
+	logger := logging.NewZapTracedLogger(tracer)
+	grantRepoMock := mocks.NewGrantRepository(t)
+	eventPublisherMock := mocks.NewEventPublisher(t)
+	transactionManagerMock := mocks.NewTransactionManager(t)
+	transactionManagerMock.On("Transaction", mock.Anything, mock.Anything).Return(func(ctx context.Context, operation func(context.Context) error) error {
+		return operation(ctx)
+	}).Maybe()
+	auditRepoMock := mocks.NewAuditRepository(t)
+	auditRepoMock.On("Save", mock.Anything, mock.Anything).Return(nil).Maybe()
+	return testCase{
+		GrantRepo:          grantRepoMock,
+		EventPublisher:     eventPublisherMock,
+		TransactionManager: transactionManagerMock,
+		AuditRepo:          auditRepoMock,
+		UseCase:            NewPurgeExpiredGrantsUseCase(grantRepoMock, audit.NewChangeRecorder(auditRepoMock, eventPublisherMock), transactionManagerMock, logger),
+	}
+}
+
+func TestExecuteWrongRequest(t *testing.T) {
+	testCase := setUp(t)
+	request := "wrongRequest"
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err == nil {
+		t.Fatal("Expected use case to return error")
+	}
+	testCase.GrantRepo.AssertNotCalled(t, "DeleteExpiredPermissionGrants")
+	testCase.GrantRepo.AssertNotCalled(t, "DeleteExpiredRoleGrants")
+	testCase.EventPublisher.AssertNotCalled(t, "Publish")
+}
+
+func TestExecuteDeletePermissionGrantsError(t *testing.T) {
+	testCase := setUp(t)
+	request := PurgeExpiredGrantsRequest{
+		Instant: time.Now(),
+	}
+	testError := errors.New("Test error")
+	testCase.GrantRepo.On("DeleteExpiredPermissionGrants", mock.Anything, mock.Anything).Return(nil, testError)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != testError {
+		t.Fatal("Expected use case to return same error as the delete permission grants error")
+	}
+	testCase.GrantRepo.AssertCalled(t, "DeleteExpiredPermissionGrants", ctx, request.Instant)
+	testCase.GrantRepo.AssertNotCalled(t, "DeleteExpiredRoleGrants")
+	testCase.EventPublisher.AssertNotCalled(t, "Publish")
+}
+
+func TestExecuteSuccess(t *testing.T) {
+	testCase := setUp(t)
+	request := PurgeExpiredGrantsRequest{
+		Instant: time.Now(),
+	}
+	expiredAt := request.Instant.Add(-time.Minute)
+	permissionGrants := []user.UserPermission{{UserEmail: "testEmail", PermissionName: "testPermission", GrantValidity: user.GrantValidity{ValidUntil: &expiredAt}}}
+	roleGrants := []user.UserRole{{UserEmail: "testEmail", RoleName: "testRole", GrantValidity: user.GrantValidity{ValidUntil: &expiredAt}}}
+	testCase.GrantRepo.On("DeleteExpiredPermissionGrants", mock.Anything, mock.Anything).Return(permissionGrants, nil)
+	testCase.GrantRepo.On("DeleteExpiredRoleGrants", mock.Anything, mock.Anything).Return(roleGrants, nil)
+	testCase.EventPublisher.On("Publish", mock.Anything, mock.Anything).Return(nil)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response != internals.EmptyUseCaseResponse() {
+		t.Fatal("Expected use case to return an empty response")
+	}
+	testCase.EventPublisher.AssertCalled(t, "Publish", ctx, user.UserPermissionGrantExpiredEvent{
+		Email:          "testEmail",
+		PermissionName: "testPermission",
+		ExpiredAt:      expiredAt,
+	})
+	testCase.EventPublisher.AssertCalled(t, "Publish", ctx, user.UserRoleGrantExpiredEvent{
+		Email:     "testEmail",
+		RoleName:  "testRole",
+		ExpiredAt: expiredAt,
+	})
+	testCase.AuditRepo.AssertCalled(t, "Save", mock.Anything, mock.MatchedBy(func(entry audit.AuditEntry) bool {
+		return entry.Action == audit.ExpireUserPermissionAction
+	}))
+}
+
+func TestExecutePublishError(t *testing.T) {
+	testCase := setUp(t)
+	request := PurgeExpiredGrantsRequest{
+		Instant: time.Now(),
+	}
+	expiredAt := request.Instant.Add(-time.Minute)
+	roleGrants := []user.UserRole{{UserEmail: "testEmail", RoleName: "testRole", GrantValidity: user.GrantValidity{ValidUntil: &expiredAt}}}
+	testCase.GrantRepo.On("DeleteExpiredPermissionGrants", mock.Anything, mock.Anything).Return([]user.UserPermission{}, nil)
+	testCase.GrantRepo.On("DeleteExpiredRoleGrants", mock.Anything, mock.Anything).Return(roleGrants, nil)
+	publishError := errors.New("Test error")
+	testCase.EventPublisher.On("Publish", mock.Anything, mock.Anything).Return(publishError)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != publishError {
+		t.Fatal("Error expected to be the same as the event publisher returned error")
+	}
+	testCase.EventPublisher.AssertNumberOfCalls(t, "Publish", 1)
+}
@@ -0,0 +1,46 @@
+package registerUser
+
+import (
+	"context"
+	"fmt"
+	"go-as/src/application/createUser"
+	"go-as/src/domain/internals"
+	"go-as/src/domain/user"
+)
+
+type RegisterUserUseCase struct {
+	userRepository    user.UserRepository
+	createUserUseCase *createUser.CreateUserUseCase
+	logger            internals.Logger
+}
+
+func (useCase *RegisterUserUseCase) Execute(ctx context.Context, request any) internals.UseCaseResponse {
+	validatedRequest, errResponse := internals.ValidateUseCaseRequest[*createUser.CreateUserRequest](request)
+	if errResponse != nil {
+		return *errResponse
+	}
+
+	useCase.logger.Info(ctx, fmt.Sprintf("Starting user registration for %s", validatedRequest.Email))
+	defer useCase.logger.Info(ctx, fmt.Sprintf("Finished user registration for %s", validatedRequest.Email))
+
+	existingUser, err := useCase.userRepository.FindByEmail(ctx, validatedRequest.Email)
+	if err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+	if existingUser != nil {
+		return internals.ErrorUseCaseResponse(user.UserAlreadyExistsError{Email: validatedRequest.Email})
+	}
+	return useCase.createUserUseCase.Execute(ctx, validatedRequest)
+}
+
+func (*RegisterUserUseCase) RequiredPermissions() []string {
+	return []string{user.CreateUserPermission}
+}
+
+func NewRegisterUserUseCase(userRepository user.UserRepository, createUserUseCase *createUser.CreateUserUseCase, logger internals.Logger) *RegisterUserUseCase {
+	return &RegisterUserUseCase{
+		userRepository:    userRepository,
+		createUserUseCase: createUserUseCase,
+		logger:            logger,
+	}
+}
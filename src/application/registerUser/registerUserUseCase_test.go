@@ -0,0 +1,98 @@
+package registerUser
+
+import (
+	"context"
+	"errors"
+	"go-as/mocks"
+	"go-as/src/application/createUser"
+	"go-as/src/domain/audit"
+	"go-as/src/domain/user"
+	"go-as/src/infrastructure/logging"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"go.elastic.co/apm/v2"
+)
+
+type testCase struct {
+	UserRepo *mocks.UserRepository
+	UseCase  *RegisterUserUseCase
+}
+
+func setUp(t *testing.T) testCase {
+	tracer := apm.DefaultTracer()
+	logger := logging.NewZapTracedLogger(tracer)
+	userRepoMock := mocks.NewUserRepository(t)
+	auditRepoMock := mocks.NewAuditRepository(t)
+	auditRepoMock.On("Save", mock.Anything, mock.Anything).Return(nil).Maybe()
+	eventPublisherMock := mocks.NewEventPublisher(t)
+	eventPublisherMock.On("Publish", mock.Anything, mock.Anything).Return(nil).Maybe()
+	transactionManagerMock := mocks.NewTransactionManager(t)
+	transactionManagerMock.On("Transaction", mock.Anything, mock.Anything).Return(func(ctx context.Context, operation func(context.Context) error) error {
+		return operation(ctx)
+	}).Maybe()
+	createUseCase := createUser.NewCreateUserUseCase(userRepoMock, audit.NewChangeRecorder(auditRepoMock, eventPublisherMock), transactionManagerMock, logger)
+	return testCase{
+		UserRepo: userRepoMock,
+		UseCase:  NewRegisterUserUseCase(userRepoMock, createUseCase, logger),
+	}
+}
+
+func TestExecuteWrongRequest(t *testing.T) {
+	testCase := setUp(t)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, "wrongRequest")
+
+	if response.Err == nil {
+		t.Fatal("Expected use case to return error")
+	}
+	testCase.UserRepo.AssertNotCalled(t, "Save")
+}
+
+func TestExecuteFindError(t *testing.T) {
+	testCase := setUp(t)
+	findError := errors.New("Test find error")
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(nil, findError)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &createUser.CreateUserRequest{Email: "testEmail"})
+
+	if response.Err != findError {
+		t.Fatal("Error expected to be the same as the user repository returned error")
+	}
+	testCase.UserRepo.AssertNotCalled(t, "Save")
+}
+
+func TestExecuteUserAlreadyExists(t *testing.T) {
+	testCase := setUp(t)
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(&user.User{Email: "testEmail"}, nil)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &createUser.CreateUserRequest{Email: "testEmail"})
+
+	if _, ok := response.Err.(user.UserAlreadyExistsError); !ok {
+		t.Fatal("Expected use case to return user already exists error")
+	}
+	testCase.UserRepo.AssertNotCalled(t, "Save")
+}
+
+func TestExecuteSuccess(t *testing.T) {
+	testCase := setUp(t)
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(nil, nil)
+	testCase.UserRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+	request := createUser.CreateUserRequest{
+		Email:     "testEmail",
+		Superuser: true,
+	}
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != nil {
+		t.Fatal("Expected use case not to return error")
+	}
+	testCase.UserRepo.AssertCalled(t, "Save", ctx, mock.MatchedBy(func(savedUser user.User) bool {
+		return savedUser.Email == request.Email && savedUser.Superuser
+	}))
+}
@@ -0,0 +1,7 @@
+package revokeUserPermission
+
+type RevokeUserPermissionRequest struct {
+	UserEmail       string
+	PermissionName  string
+	ExpectedVersion *int64
+}
@@ -0,0 +1,73 @@
+package revokeUserPermission
+
+import (
+	"context"
+	"fmt"
+	"go-as/src/domain/audit"
+	"go-as/src/domain/internals"
+	"go-as/src/domain/user"
+)
+
+type RevokeUserPermissionUseCase struct {
+	userRepository     user.UserRepository
+	changeRecorder     *audit.ChangeRecorder
+	transactionManager internals.TransactionManager
+	logger             internals.Logger
+}
+
+func (useCase *RevokeUserPermissionUseCase) Execute(ctx context.Context, request any) internals.UseCaseResponse {
+	validatedRequest, errResponse := internals.ValidateUseCaseRequest[*RevokeUserPermissionRequest](request)
+	if errResponse != nil {
+		return *errResponse
+	}
+
+	useCase.logger.Info(ctx, fmt.Sprintf("Starting revoking permission %s from %s", validatedRequest.PermissionName, validatedRequest.UserEmail))
+	defer useCase.logger.Info(ctx, fmt.Sprintf("Finished revoking permission %s from %s", validatedRequest.PermissionName, validatedRequest.UserEmail))
+
+	foundUser, err := useCase.userRepository.FindByEmail(ctx, validatedRequest.UserEmail)
+	if err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+	if foundUser == nil {
+		return internals.ErrorUseCaseResponse(user.UserNotFoundError{Email: validatedRequest.UserEmail})
+	}
+	if err = foundUser.CheckVersion(validatedRequest.ExpectedVersion); err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+	revokedGrant := foundUser.FindPermissionGrant(validatedRequest.PermissionName)
+	if revokedGrant == nil {
+		return internals.EmptyUseCaseResponse()
+	}
+	err = useCase.transactionManager.Transaction(ctx, func(ctx context.Context) error {
+		if err := useCase.userRepository.DeletePermissionGrant(ctx, validatedRequest.UserEmail, validatedRequest.PermissionName, foundUser.Version); err != nil {
+			return err
+		}
+		return useCase.changeRecorder.Record(ctx, audit.Change{
+			Action:     audit.RevokeUserPermissionAction,
+			TargetType: audit.UserTargetType,
+			Target:     foundUser.Email,
+			Before:     revokedGrant,
+			Event: user.UserPermissionRevokedEvent{
+				Email:          foundUser.Email,
+				PermissionName: revokedGrant.PermissionName,
+			},
+		})
+	})
+	if err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+	return internals.EmptyUseCaseResponse()
+}
+
+func (*RevokeUserPermissionUseCase) RequiredPermissions() []string {
+	return []string{user.UpdateUserPermission}
+}
+
+func NewRevokeUserPermissionUseCase(userRepository user.UserRepository, changeRecorder *audit.ChangeRecorder, transactionManager internals.TransactionManager, logger internals.Logger) *RevokeUserPermissionUseCase {
+	return &RevokeUserPermissionUseCase{
+		userRepository:     userRepository,
+		changeRecorder:     changeRecorder,
+		transactionManager: transactionManager,
+		logger:             logger,
+	}
+}
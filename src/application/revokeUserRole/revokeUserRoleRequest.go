@@ -0,0 +1,7 @@
+package revokeUserRole
+
+type RevokeUserRoleRequest struct {
+	UserEmail       string
+	RoleName        string
+	ExpectedVersion *int64
+}
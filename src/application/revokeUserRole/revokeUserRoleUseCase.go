@@ -0,0 +1,73 @@
+package revokeUserRole
+
+import (
+	"context"
+	"fmt"
+	"go-as/src/domain/audit"
+	"go-as/src/domain/internals"
+	"go-as/src/domain/user"
+)
+
+type RevokeUserRoleUseCase struct {
+	userRepository     user.UserRepository
+	changeRecorder     *audit.ChangeRecorder
+	transactionManager internals.TransactionManager
+	logger             internals.Logger
+}
+
+func (useCase *RevokeUserRoleUseCase) Execute(ctx context.Context, request any) internals.UseCaseResponse {
+	validatedRequest, errResponse := internals.ValidateUseCaseRequest[*RevokeUserRoleRequest](request)
+	if errResponse != nil {
+		return *errResponse
+	}
+
+	useCase.logger.Info(ctx, fmt.Sprintf("Starting revoking role %s from %s", validatedRequest.RoleName, validatedRequest.UserEmail))
+	defer useCase.logger.Info(ctx, fmt.Sprintf("Finished revoking role %s from %s", validatedRequest.RoleName, validatedRequest.UserEmail))
+
+	foundUser, err := useCase.userRepository.FindByEmail(ctx, validatedRequest.UserEmail)
+	if err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+	if foundUser == nil {
+		return internals.ErrorUseCaseResponse(user.UserNotFoundError{Email: validatedRequest.UserEmail})
+	}
+	if err = foundUser.CheckVersion(validatedRequest.ExpectedVersion); err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+	revokedGrant := foundUser.FindRoleGrant(validatedRequest.RoleName)
+	if revokedGrant == nil {
+		return internals.EmptyUseCaseResponse()
+	}
+	err = useCase.transactionManager.Transaction(ctx, func(ctx context.Context) error {
+		if err := useCase.userRepository.DeleteRoleGrant(ctx, validatedRequest.UserEmail, validatedRequest.RoleName, foundUser.Version); err != nil {
+			return err
+		}
+		return useCase.changeRecorder.Record(ctx, audit.Change{
+			Action:     audit.RevokeUserRoleAction,
+			TargetType: audit.UserTargetType,
+			Target:     foundUser.Email,
+			Before:     revokedGrant,
+			Event: user.UserRoleRevokedEvent{
+				Email:    foundUser.Email,
+				RoleName: revokedGrant.RoleName,
+			},
+		})
+	})
+	if err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+	return internals.EmptyUseCaseResponse()
+}
+
+func (*RevokeUserRoleUseCase) RequiredPermissions() []string {
+	return []string{user.UpdateUserPermission}
+}
+
+func NewRevokeUserRoleUseCase(userRepository user.UserRepository, changeRecorder *audit.ChangeRecorder, transactionManager internals.TransactionManager, logger internals.Logger) *RevokeUserRoleUseCase {
+	return &RevokeUserRoleUseCase{
+		userRepository:     userRepository,
+		changeRecorder:     changeRecorder,
+		transactionManager: transactionManager,
+		logger:             logger,
+	}
+}
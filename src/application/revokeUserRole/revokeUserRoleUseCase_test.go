@@ -0,0 +1,129 @@
+package revokeUserRole
+
+import (
+	"context"
+	"errors"
+	"go-as/mocks"
+	"go-as/src/domain/audit"
+	"go-as/src/domain/user"
+	"go-as/src/infrastructure/logging"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"go.elastic.co/apm/v2"
+)
+
+type testCase struct {
+	UserRepo           *mocks.UserRepository
+	AuditRepo          *mocks.AuditRepository
+	EventPublisher     *mocks.EventPublisher
+	TransactionManager *mocks.TransactionManager
+	UseCase            *RevokeUserRoleUseCase
+}
+
+func setUp(t *testing.T) testCase {
+	tracer := apm.DefaultTracer()
+	logger := logging.NewZapTracedLogger(tracer)
+	userRepoMock := mocks.NewUserRepository(t)
+	auditRepoMock := mocks.NewAuditRepository(t)
+	auditRepoMock.On("Save", mock.Anything, mock.Anything).Return(nil).Maybe()
+	eventPublisherMock := mocks.NewEventPublisher(t)
+	eventPublisherMock.On("Publish", mock.Anything, mock.Anything).Return(nil).Maybe()
+	transactionManagerMock := mocks.NewTransactionManager(t)
+	transactionManagerMock.On("Transaction", mock.Anything, mock.Anything).Return(func(ctx context.Context, operation func(context.Context) error) error {
+		return operation(ctx)
+	}).Maybe()
+	return testCase{
+		UserRepo:           userRepoMock,
+		AuditRepo:          auditRepoMock,
+		EventPublisher:     eventPublisherMock,
+		TransactionManager: transactionManagerMock,
+		UseCase:            NewRevokeUserRoleUseCase(userRepoMock, audit.NewChangeRecorder(auditRepoMock, eventPublisherMock), transactionManagerMock, logger),
+	}
+}
+
+func TestExecuteWrongRequest(t *testing.T) {
+	testCase := setUp(t)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, "wrongRequest")
+
+	if response.Err == nil {
+		t.Fatal("Expected use case to return error")
+	}
+	testCase.UserRepo.AssertNotCalled(t, "DeleteRoleGrant")
+}
+
+func TestExecuteUserNotFound(t *testing.T) {
+	testCase := setUp(t)
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(nil, nil)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &RevokeUserRoleRequest{UserEmail: "testEmail", RoleName: "testRole"})
+
+	if _, ok := response.Err.(user.UserNotFoundError); !ok {
+		t.Fatal("Expected use case to return user not found error")
+	}
+	testCase.UserRepo.AssertNotCalled(t, "DeleteRoleGrant")
+}
+
+func TestExecuteDeleteGrantError(t *testing.T) {
+	testCase := setUp(t)
+	deleteError := errors.New("Test delete error")
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(&user.User{Email: "testEmail", Version: 3, RoleGrants: []user.UserRole{{UserEmail: "testEmail", RoleName: "testRole"}}}, nil)
+	testCase.UserRepo.On("DeleteRoleGrant", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(deleteError)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &RevokeUserRoleRequest{UserEmail: "testEmail", RoleName: "testRole"})
+
+	if response.Err != deleteError {
+		t.Fatal("Error expected to be the same as the user repository returned error")
+	}
+}
+
+func TestExecuteSuccess(t *testing.T) {
+	testCase := setUp(t)
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(&user.User{Email: "testEmail", Version: 3, RoleGrants: []user.UserRole{{UserEmail: "testEmail", RoleName: "testRole"}}}, nil)
+	testCase.UserRepo.On("DeleteRoleGrant", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &RevokeUserRoleRequest{UserEmail: "testEmail", RoleName: "testRole"})
+
+	if response.Err != nil {
+		t.Fatal("Expected use case not to return error")
+	}
+	testCase.UserRepo.AssertCalled(t, "DeleteRoleGrant", ctx, "testEmail", "testRole", int64(3))
+	testCase.UserRepo.AssertNotCalled(t, "Save")
+	testCase.AuditRepo.AssertCalled(t, "Save", ctx, mock.MatchedBy(func(entry audit.AuditEntry) bool {
+		return entry.Action == audit.RevokeUserRoleAction && entry.Target == "testEmail"
+	}))
+	testCase.EventPublisher.AssertCalled(t, "Publish", mock.Anything, mock.AnythingOfType("user.UserRoleRevokedEvent"))
+}
+
+func TestExecuteVersionMismatch(t *testing.T) {
+	testCase := setUp(t)
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(&user.User{Email: "testEmail", Version: 3}, nil)
+	expectedVersion := int64(2)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &RevokeUserRoleRequest{UserEmail: "testEmail", RoleName: "testRole", ExpectedVersion: &expectedVersion})
+
+	if _, isMismatch := response.Err.(user.UserVersionMismatchError); !isMismatch {
+		t.Fatal("Expected use case to return a version mismatch error")
+	}
+	testCase.UserRepo.AssertNotCalled(t, "DeleteRoleGrant")
+}
+
+func TestExecuteGrantNotFound(t *testing.T) {
+	testCase := setUp(t)
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(&user.User{Email: "testEmail", Version: 3}, nil)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &RevokeUserRoleRequest{UserEmail: "testEmail", RoleName: "testRole"})
+
+	if response.Err != nil {
+		t.Fatal("Expected use case not to return error")
+	}
+	testCase.UserRepo.AssertNotCalled(t, "DeleteRoleGrant")
+	testCase.AuditRepo.AssertNotCalled(t, "Save")
+}
@@ -0,0 +1,7 @@
+package syncUser
+
+type SyncUserRequest struct {
+	PreviousEmail string
+	Email         string
+	Superuser     bool
+}
@@ -0,0 +1,116 @@
+package syncUser
+
+import (
+	"context"
+	"fmt"
+	"go-as/src/domain/audit"
+	"go-as/src/domain/internals"
+	"go-as/src/domain/user"
+)
+
+type SyncUserUseCase struct {
+	userRepository     user.UserRepository
+	changeRecorder     *audit.ChangeRecorder
+	transactionManager internals.TransactionManager
+	logger             internals.Logger
+}
+
+func (useCase *SyncUserUseCase) Execute(ctx context.Context, request any) internals.UseCaseResponse {
+	validatedRequest, errResponse := internals.ValidateUseCaseRequest[*SyncUserRequest](request)
+	if errResponse != nil {
+		return *errResponse
+	}
+
+	useCase.logger.Info(ctx, fmt.Sprintf("Starting synchronization of user %s", validatedRequest.PreviousEmail))
+	defer useCase.logger.Info(ctx, fmt.Sprintf("Finished synchronization of user %s", validatedRequest.PreviousEmail))
+
+	err := useCase.transactionManager.Transaction(ctx, func(ctx context.Context) error {
+		targetUser, previousEmail, err := useCase.findSyncedUser(ctx, validatedRequest)
+		if err != nil {
+			return err
+		}
+		if targetUser == nil {
+			return user.UserNotFoundError{Email: validatedRequest.PreviousEmail}
+		}
+		before := map[string]any{"email": targetUser.Email, "superuser": targetUser.Superuser}
+		if validatedRequest.Email == previousEmail && targetUser.Superuser == validatedRequest.Superuser {
+			return nil
+		}
+		if validatedRequest.Email != previousEmail {
+			if targetUser, err = useCase.changeEmail(ctx, previousEmail, validatedRequest.Email); err != nil {
+				return err
+			}
+		}
+		if targetUser.Superuser != validatedRequest.Superuser {
+			targetUser.Superuser = validatedRequest.Superuser
+			if err = useCase.userRepository.Save(ctx, *targetUser); err != nil {
+				return err
+			}
+		}
+		return useCase.changeRecorder.Record(ctx, audit.Change{
+			Action:     audit.SyncUserAction,
+			TargetType: audit.UserTargetType,
+			Target:     targetUser.Email,
+			Before:     before,
+			After:      map[string]any{"email": targetUser.Email, "superuser": targetUser.Superuser},
+			Event: user.UserSyncedEvent{
+				PreviousEmail: validatedRequest.PreviousEmail,
+				Email:         targetUser.Email,
+				Superuser:     targetUser.Superuser,
+			},
+		})
+	})
+	if err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+	return internals.EmptyUseCaseResponse()
+}
+
+// findSyncedUser looks the user up by its previous email, falling back to the new one
+// when the email change was already applied, and returns the email the user is stored with
+func (useCase *SyncUserUseCase) findSyncedUser(ctx context.Context, request *SyncUserRequest) (*user.User, string, error) {
+	foundUser, err := useCase.userRepository.FindByEmail(ctx, request.PreviousEmail)
+	if err != nil || foundUser != nil || request.Email == request.PreviousEmail {
+		return foundUser, request.PreviousEmail, err
+	}
+	foundUser, err = useCase.userRepository.FindByEmail(ctx, request.Email)
+	return foundUser, request.Email, err
+}
+
+func (useCase *SyncUserUseCase) changeEmail(ctx context.Context, email string, newEmail string) (*user.User, error) {
+	existingUser, err := useCase.userRepository.FindByEmail(ctx, newEmail)
+	if err != nil {
+		return nil, err
+	}
+	if existingUser != nil {
+		return nil, user.UserAlreadyExistsError{Email: newEmail}
+	}
+	if err = useCase.userRepository.ChangeEmail(ctx, email, newEmail); err != nil {
+		return nil, err
+	}
+	return useCase.findUser(ctx, newEmail)
+}
+
+func (useCase *SyncUserUseCase) findUser(ctx context.Context, email string) (*user.User, error) {
+	foundUser, err := useCase.userRepository.FindByEmail(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+	if foundUser == nil {
+		return nil, user.UserNotFoundError{Email: email}
+	}
+	return foundUser, nil
+}
+
+func (*SyncUserUseCase) RequiredPermissions() []string {
+	return []string{}
+}
+
+func NewSyncUserUseCase(userRepository user.UserRepository, changeRecorder *audit.ChangeRecorder, transactionManager internals.TransactionManager, logger internals.Logger) *SyncUserUseCase {
+	return &SyncUserUseCase{
+		userRepository:     userRepository,
+		changeRecorder:     changeRecorder,
+		transactionManager: transactionManager,
+		logger:             logger,
+	}
+}
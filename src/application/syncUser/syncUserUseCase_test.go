@@ -0,0 +1,209 @@
+package syncUser
+
+import (
+	"context"
+	"errors"
+	"go-as/mocks"
+	"go-as/src/domain/audit"
+	"go-as/src/domain/user"
+	"go-as/src/infrastructure/logging"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"go.elastic.co/apm/v2"
+)
+
+type testCase struct {
+	UserRepo           *mocks.UserRepository
+	TransactionManager *mocks.TransactionManager
+	AuditRepo          *mocks.AuditRepository
+	EventPublisher     *mocks.EventPublisher
+	UseCase            *SyncUserUseCase
+}
+
+func setUp(t *testing.T) testCase {
+	tracer := apm.DefaultTracer()
+	logger := logging.NewZapTracedLogger(tracer)
+	userRepoMock := mocks.NewUserRepository(t)
+	transactionManagerMock := mocks.NewTransactionManager(t)
+	transactionManagerMock.On("Transaction", mock.Anything, mock.Anything).Return(func(ctx context.Context, operation func(context.Context) error) error {
+		return operation(ctx)
+	}).Maybe()
+	auditRepoMock := mocks.NewAuditRepository(t)
+	auditRepoMock.On("Save", mock.Anything, mock.Anything).Return(nil).Maybe()
+	eventPublisherMock := mocks.NewEventPublisher(t)
+	eventPublisherMock.On("Publish", mock.Anything, mock.Anything).Return(nil).Maybe()
+	return testCase{
+		UserRepo:           userRepoMock,
+		TransactionManager: transactionManagerMock,
+		AuditRepo:          auditRepoMock,
+		EventPublisher:     eventPublisherMock,
+		UseCase:            NewSyncUserUseCase(userRepoMock, audit.NewChangeRecorder(auditRepoMock, eventPublisherMock), transactionManagerMock, logger),
+	}
+}
+
+func TestExecuteWrongRequest(t *testing.T) {
+	testCase := setUp(t)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, "wrongRequest")
+
+	if response.Err == nil {
+		t.Fatal("Expected use case to return error")
+	}
+	testCase.UserRepo.AssertNotCalled(t, "FindByEmail")
+}
+
+func TestExecuteFindError(t *testing.T) {
+	testCase := setUp(t)
+	findError := errors.New("Test find error")
+	testCase.UserRepo.On("FindByEmail", mock.Anything, "testEmail").Return(nil, findError)
+	request := SyncUserRequest{
+		PreviousEmail: "testEmail",
+		Email:         "testEmail",
+		Superuser:     true,
+	}
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != findError {
+		t.Fatal("Error expected to be the same as the repository returned error")
+	}
+	testCase.UserRepo.AssertNotCalled(t, "Save")
+}
+
+func TestExecuteUserNotFound(t *testing.T) {
+	testCase := setUp(t)
+	testCase.UserRepo.On("FindByEmail", mock.Anything, "previousEmail").Return(nil, nil)
+	testCase.UserRepo.On("FindByEmail", mock.Anything, "newEmail").Return(nil, nil)
+	request := SyncUserRequest{
+		PreviousEmail: "previousEmail",
+		Email:         "newEmail",
+		Superuser:     true,
+	}
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if _, ok := response.Err.(user.UserNotFoundError); !ok {
+		t.Fatal("Expected use case to return user not found error")
+	}
+	testCase.UserRepo.AssertNotCalled(t, "Save")
+	testCase.AuditRepo.AssertNotCalled(t, "Save")
+}
+
+func TestExecuteEmailAlreadyChanged(t *testing.T) {
+	testCase := setUp(t)
+	renamedUser := user.User{Email: "newEmail", Superuser: true}
+	testCase.UserRepo.On("FindByEmail", mock.Anything, "previousEmail").Return(nil, nil)
+	testCase.UserRepo.On("FindByEmail", mock.Anything, "newEmail").Return(&renamedUser, nil)
+	request := SyncUserRequest{
+		PreviousEmail: "previousEmail",
+		Email:         "newEmail",
+		Superuser:     true,
+	}
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != nil {
+		t.Fatal("Expected use case not to return error")
+	}
+	testCase.UserRepo.AssertNotCalled(t, "Save")
+	testCase.UserRepo.AssertNotCalled(t, "ChangeEmail")
+}
+
+func TestExecuteSyncsSuperuser(t *testing.T) {
+	testCase := setUp(t)
+	foundUser := user.User{Email: "testEmail", Version: 3}
+	testCase.UserRepo.On("FindByEmail", mock.Anything, "testEmail").Return(&foundUser, nil)
+	testCase.UserRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+	request := SyncUserRequest{
+		PreviousEmail: "testEmail",
+		Email:         "testEmail",
+		Superuser:     true,
+	}
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != nil {
+		t.Fatal("Expected use case not to return error")
+	}
+	testCase.UserRepo.AssertCalled(t, "Save", ctx, user.User{Email: "testEmail", Superuser: true, Version: 3})
+	testCase.UserRepo.AssertNotCalled(t, "ChangeEmail")
+	testCase.AuditRepo.AssertCalled(t, "Save", mock.Anything, mock.MatchedBy(func(entry audit.AuditEntry) bool {
+		return entry.Action == audit.SyncUserAction
+	}))
+	testCase.EventPublisher.AssertCalled(t, "Publish", mock.Anything, mock.AnythingOfType("user.UserSyncedEvent"))
+}
+
+func TestExecuteUnchangedUserNotSaved(t *testing.T) {
+	testCase := setUp(t)
+	foundUser := user.User{Email: "testEmail", Superuser: true}
+	testCase.UserRepo.On("FindByEmail", mock.Anything, "testEmail").Return(&foundUser, nil)
+	request := SyncUserRequest{
+		PreviousEmail: "testEmail",
+		Email:         "testEmail",
+		Superuser:     true,
+	}
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != nil {
+		t.Fatal("Expected use case not to return error")
+	}
+	testCase.UserRepo.AssertNotCalled(t, "Save")
+	testCase.AuditRepo.AssertNotCalled(t, "Save")
+}
+
+func TestExecuteChangesEmail(t *testing.T) {
+	testCase := setUp(t)
+	previousUser := user.User{Email: "previousEmail"}
+	renamedUser := user.User{Email: "newEmail"}
+	testCase.UserRepo.On("FindByEmail", mock.Anything, "previousEmail").Return(&previousUser, nil)
+	testCase.UserRepo.On("FindByEmail", mock.Anything, "newEmail").Return(nil, nil).Once()
+	testCase.UserRepo.On("FindByEmail", mock.Anything, "newEmail").Return(&renamedUser, nil).Once()
+	testCase.UserRepo.On("ChangeEmail", mock.Anything, "previousEmail", "newEmail").Return(nil)
+	testCase.UserRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+	request := SyncUserRequest{
+		PreviousEmail: "previousEmail",
+		Email:         "newEmail",
+		Superuser:     true,
+	}
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != nil {
+		t.Fatal("Expected use case not to return error")
+	}
+	testCase.UserRepo.AssertCalled(t, "ChangeEmail", ctx, "previousEmail", "newEmail")
+	testCase.UserRepo.AssertCalled(t, "Save", ctx, user.User{Email: "newEmail", Superuser: true})
+	testCase.AuditRepo.AssertCalled(t, "Save", mock.Anything, mock.MatchedBy(func(entry audit.AuditEntry) bool {
+		return entry.Action == audit.SyncUserAction
+	}))
+}
+
+func TestExecuteNewEmailAlreadyExists(t *testing.T) {
+	testCase := setUp(t)
+	previousUser := user.User{Email: "previousEmail"}
+	existingUser := user.User{Email: "newEmail"}
+	testCase.UserRepo.On("FindByEmail", mock.Anything, "previousEmail").Return(&previousUser, nil)
+	testCase.UserRepo.On("FindByEmail", mock.Anything, "newEmail").Return(&existingUser, nil)
+	request := SyncUserRequest{
+		PreviousEmail: "previousEmail",
+		Email:         "newEmail",
+	}
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if _, ok := response.Err.(user.UserAlreadyExistsError); !ok {
+		t.Fatal("Expected use case to return user already exists error")
+	}
+	testCase.UserRepo.AssertNotCalled(t, "ChangeEmail")
+	testCase.UserRepo.AssertNotCalled(t, "Save")
+}
@@ -0,0 +1,72 @@
+package syncUser
+
+import (
+	"context"
+	"fmt"
+	"go-as/src/domain/events"
+	"go-as/src/domain/user"
+	"reflect"
+
+	"go.uber.org/zap"
+)
+
+type UserUpdatedEventConsumer struct {
+	eventName         string
+	eventListener     events.EventListener
+	eventDeduplicator *events.EventDeduplicator
+	eventOrdering     *events.EventOrdering
+	syncUserUseCase   *SyncUserUseCase
+	logger            *zap.Logger
+}
+
+func (consumer *UserUpdatedEventConsumer) Consume() error {
+	return consumer.eventListener.Listen(consumer.eventDeduplicator.Deduplicate(consumer.eventName, events.HandleTypedEnvelope(consumer.handleEvent)))
+}
+
+func (consumer *UserUpdatedEventConsumer) handleEvent(ctx context.Context, envelope events.EventEnvelope, event *user.UserUpdatedEvent) error {
+	previousKey, key := user.EventOrderingKey(event.PreviousEmail), user.EventOrderingKey(event.Email)
+	applied, err := consumer.eventOrdering.Apply(ctx, envelope.OccurredAt, []string{previousKey, key}, func(ctx context.Context, positions map[string]*events.EventPosition) error {
+		return consumer.syncUser(ctx, event, positions[previousKey], positions[key])
+	})
+	if err == nil && !applied {
+		consumer.logger.Info(fmt.Sprintf("Skipped stale %s %s, a newer event was already applied to user %s", consumer.eventName, envelope.ID, event.PreviousEmail))
+	}
+	return err
+}
+
+func (consumer *UserUpdatedEventConsumer) syncUser(ctx context.Context, event *user.UserUpdatedEvent, previousPosition *events.EventPosition, position *events.EventPosition) error {
+	syncUserRequest := SyncUserRequest{
+		PreviousEmail: event.PreviousEmail,
+		Email:         event.Email,
+		Superuser:     event.Superuser,
+	}
+	if useCaseResponse := consumer.syncUserUseCase.Execute(ctx, &syncUserRequest); useCaseResponse.Err != nil {
+		if _, notFound := useCaseResponse.Err.(user.UserNotFoundError); notFound && previousPosition.Deleted {
+			consumer.logger.Info(fmt.Sprintf("User %s was deleted before its update was handled", event.PreviousEmail))
+			return nil
+		}
+		// A user that was never deleted is not created yet, so its update is retried until it is
+		return useCaseResponse.Err
+	}
+	if event.PreviousEmail != event.Email {
+		previousPosition.Deleted = true
+	}
+	position.Deleted = false
+	return nil
+}
+
+func NewUserUpdatedEventConsumer(eventListenerFactory events.EventListenerFactory, eventDeduplicator *events.EventDeduplicator, eventOrdering *events.EventOrdering, useCase *SyncUserUseCase, logger *zap.Logger) *UserUpdatedEventConsumer {
+	eventName := reflect.TypeOf(user.UserUpdatedEvent{}).Name()
+	eventListener, err := eventListenerFactory.CreateListener(eventName)
+	if err != nil {
+		logger.Fatal(fmt.Sprintf("Error creating listener %s: %s", eventName, err.Error()))
+	}
+	return &UserUpdatedEventConsumer{
+		eventName:         eventName,
+		eventListener:     eventListener,
+		eventDeduplicator: eventDeduplicator,
+		eventOrdering:     eventOrdering,
+		syncUserUseCase:   useCase,
+		logger:            logger,
+	}
+}
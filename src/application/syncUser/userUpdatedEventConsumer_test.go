@@ -0,0 +1,102 @@
+package syncUser
+
+import (
+	"context"
+	"errors"
+	"go-as/mocks"
+	"go-as/src/domain/events"
+	"go-as/src/domain/user"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+var testEnvelope = events.EventEnvelope{ID: "testID", OccurredAt: time.Unix(200, 0)}
+
+func newTestConsumer(t *testing.T, testCase testCase, deleted bool) (*UserUpdatedEventConsumer, *mocks.EventPositionRepository) {
+	positionRepositoryMock := mocks.NewEventPositionRepository(t)
+	positionRepositoryMock.On("Lock", mock.Anything, mock.Anything).Return(func(_ context.Context, key string) *events.EventPosition {
+		return &events.EventPosition{Key: key, OccurredAt: time.Unix(100, 0), Deleted: deleted}
+	}, nil).Maybe()
+	positionRepositoryMock.On("Save", mock.Anything, mock.Anything).Return(nil).Maybe()
+	return &UserUpdatedEventConsumer{
+		eventName:       "UserUpdatedEvent",
+		eventOrdering:   events.NewEventOrdering(positionRepositoryMock, testCase.TransactionManager),
+		syncUserUseCase: testCase.UseCase,
+		logger:          zap.NewNop(),
+	}, positionRepositoryMock
+}
+
+func TestHandleEventDeletedUserIsSuccess(t *testing.T) {
+	testCase := setUp(t)
+	consumer, positionRepository := newTestConsumer(t, testCase, true)
+	testCase.UserRepo.On("FindByEmail", mock.Anything, "testEmail").Return(nil, nil)
+
+	err := consumer.handleEvent(context.Background(), testEnvelope, &user.UserUpdatedEvent{PreviousEmail: "testEmail", Email: "testEmail", Superuser: true})
+
+	if err != nil {
+		t.Fatal("Expected an update of a deleted user to be acknowledged")
+	}
+	testCase.UserRepo.AssertNotCalled(t, "Save")
+	positionRepository.AssertCalled(t, "Save", mock.Anything, events.EventPosition{Key: "user:testEmail", OccurredAt: time.Unix(200, 0), Deleted: true})
+}
+
+func TestHandleEventNotCreatedUserIsRetried(t *testing.T) {
+	testCase := setUp(t)
+	consumer, positionRepository := newTestConsumer(t, testCase, false)
+	testCase.UserRepo.On("FindByEmail", mock.Anything, "testEmail").Return(nil, nil)
+
+	err := consumer.handleEvent(context.Background(), testEnvelope, &user.UserUpdatedEvent{PreviousEmail: "testEmail", Email: "testEmail", Superuser: true})
+
+	if _, ok := err.(user.UserNotFoundError); !ok {
+		t.Fatal("Expected an update of a user not created yet to be returned so the event is retried")
+	}
+	testCase.UserRepo.AssertNotCalled(t, "Save")
+	positionRepository.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+}
+
+func TestHandleEventRenameTombstonesPreviousEmail(t *testing.T) {
+	testCase := setUp(t)
+	consumer, positionRepository := newTestConsumer(t, testCase, false)
+	testCase.UserRepo.On("FindByEmail", mock.Anything, "testEmail").Return(&user.User{Email: "testEmail", Superuser: true}, nil)
+	testCase.UserRepo.On("FindByEmail", mock.Anything, "newTestEmail").Return(nil, nil).Once()
+	testCase.UserRepo.On("FindByEmail", mock.Anything, "newTestEmail").Return(&user.User{Email: "newTestEmail", Superuser: true}, nil).Once()
+	testCase.UserRepo.On("ChangeEmail", mock.Anything, "testEmail", "newTestEmail").Return(nil)
+
+	err := consumer.handleEvent(context.Background(), testEnvelope, &user.UserUpdatedEvent{PreviousEmail: "testEmail", Email: "newTestEmail", Superuser: true})
+
+	if err != nil {
+		t.Fatal("Expected event to be handled")
+	}
+	positionRepository.AssertCalled(t, "Save", mock.Anything, events.EventPosition{Key: "user:testEmail", OccurredAt: time.Unix(200, 0), Deleted: true})
+	positionRepository.AssertCalled(t, "Save", mock.Anything, events.EventPosition{Key: "user:newTestEmail", OccurredAt: time.Unix(200, 0), Deleted: false})
+}
+
+func TestHandleEventSaveError(t *testing.T) {
+	testCase := setUp(t)
+	consumer, _ := newTestConsumer(t, testCase, false)
+	saveError := errors.New("Test save error")
+	testCase.UserRepo.On("FindByEmail", mock.Anything, "testEmail").Return(&user.User{Email: "testEmail"}, nil)
+	testCase.UserRepo.On("Save", mock.Anything, mock.Anything).Return(saveError)
+
+	err := consumer.handleEvent(context.Background(), testEnvelope, &user.UserUpdatedEvent{PreviousEmail: "testEmail", Email: "testEmail", Superuser: true})
+
+	if err != saveError {
+		t.Fatal("Expected save errors to be returned so the event is retried")
+	}
+}
+
+func TestHandleEventSkipsStaleUpdate(t *testing.T) {
+	testCase := setUp(t)
+	consumer, _ := newTestConsumer(t, testCase, false)
+
+	err := consumer.handleEvent(context.Background(), events.EventEnvelope{ID: "testID", OccurredAt: time.Unix(50, 0)}, &user.UserUpdatedEvent{PreviousEmail: "testEmail", Email: "testEmail", Superuser: true})
+
+	if err != nil {
+		t.Fatal("Expected a stale update to be acknowledged")
+	}
+	testCase.UserRepo.AssertNotCalled(t, "FindByEmail")
+	testCase.UserRepo.AssertNotCalled(t, "Save")
+}
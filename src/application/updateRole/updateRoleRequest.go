@@ -0,0 +1,7 @@
+package updateRole
+
+type UpdateRoleRequest struct {
+	Name            string
+	Permissions     []string
+	ExpectedVersion *int64
+}
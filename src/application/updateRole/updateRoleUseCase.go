@@ -0,0 +1,94 @@
+package updateRole
+
+import (
+	"context"
+	"fmt"
+	"go-as/src/domain/audit"
+	"go-as/src/domain/internals"
+	"go-as/src/domain/permission"
+	"go-as/src/domain/role"
+)
+
+type UpdateRoleUseCase struct {
+	roleRepository       role.RoleRepository
+	permissionRepository permission.PermissionRepository
+	changeRecorder       *audit.ChangeRecorder
+	transactionManager   internals.TransactionManager
+	logger               internals.Logger
+}
+
+func (useCase *UpdateRoleUseCase) Execute(ctx context.Context, request any) internals.UseCaseResponse {
+	validatedRequest, errResponse := internals.ValidateUseCaseRequest[*UpdateRoleRequest](request)
+	if errResponse != nil {
+		return *errResponse
+	}
+
+	useCase.logger.Info(ctx, fmt.Sprintf("Starting update of role %s", validatedRequest.Name))
+	defer useCase.logger.Info(ctx, fmt.Sprintf("Finished update of role %s", validatedRequest.Name))
+
+	roles, err := useCase.roleRepository.FindByNames(ctx, []string{validatedRequest.Name})
+	if err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+	if len(roles) == 0 {
+		return internals.ErrorUseCaseResponse(role.RoleNotFoundError{RoleName: validatedRequest.Name})
+	}
+	if err = roles[0].CheckVersion(validatedRequest.ExpectedVersion); err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+	permissions, err := useCase.findPermissions(ctx, validatedRequest.Permissions)
+	if err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+	updatedRole := roles[0]
+	before := permission.Names(updatedRole.Permissions)
+	updatedRole.Permissions = permissions
+	err = useCase.transactionManager.Transaction(ctx, func(ctx context.Context) error {
+		if err := useCase.roleRepository.UpdatePermissions(ctx, updatedRole); err != nil {
+			return err
+		}
+		return useCase.changeRecorder.Record(ctx, audit.Change{
+			Action:     audit.UpdateRoleAction,
+			TargetType: audit.RoleTargetType,
+			Target:     updatedRole.Name,
+			Before:     map[string][]string{"permissions": before},
+			After:      map[string][]string{"permissions": permission.Names(permissions)},
+			Event: role.RoleUpdatedEvent{
+				Name:        updatedRole.Name,
+				Permissions: permission.Names(permissions),
+			},
+		})
+	})
+	if err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+	return internals.EmptyUseCaseResponse()
+}
+
+func (useCase *UpdateRoleUseCase) findPermissions(ctx context.Context, permissionNames []string) ([]permission.Permission, error) {
+	if len(permissionNames) == 0 {
+		return []permission.Permission{}, nil
+	}
+	permissions, err := useCase.permissionRepository.FindByNames(ctx, permissionNames)
+	if err != nil {
+		return nil, err
+	}
+	if len(permissions) != len(permissionNames) {
+		return nil, fmt.Errorf("permissions %s not found", permissionNames)
+	}
+	return permissions, nil
+}
+
+func (*UpdateRoleUseCase) RequiredPermissions() []string {
+	return []string{role.UpdateRolePermission}
+}
+
+func NewUpdateRoleUseCase(roleRepository role.RoleRepository, permissionRepository permission.PermissionRepository, changeRecorder *audit.ChangeRecorder, transactionManager internals.TransactionManager, logger internals.Logger) *UpdateRoleUseCase {
+	return &UpdateRoleUseCase{
+		roleRepository:       roleRepository,
+		permissionRepository: permissionRepository,
+		changeRecorder:       changeRecorder,
+		transactionManager:   transactionManager,
+		logger:               logger,
+	}
+}
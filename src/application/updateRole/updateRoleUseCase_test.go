@@ -0,0 +1,186 @@
+package updateRole
+
+import (
+	"context"
+	"errors"
+	"go-as/mocks"
+	"go-as/src/domain/audit"
+	"go-as/src/domain/permission"
+	"go-as/src/domain/role"
+	"go-as/src/infrastructure/logging"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"go.elastic.co/apm/v2"
+)
+
+type testCase struct {
+	PermissionRepo     *mocks.PermissionRepository
+	RoleRepo           *mocks.RoleRepository
+	AuditRepo          *mocks.AuditRepository
+	EventPublisher     *mocks.EventPublisher
+	TransactionManager *mocks.TransactionManager
+	UseCase            *UpdateRoleUseCase
+}
+
+func setUp(t *testing.T) testCase {
+	tracer := apm.DefaultTracer()
+	logger := logging.NewZapTracedLogger(tracer)
+	permissionRepoMock := mocks.NewPermissionRepository(t)
+	roleRepoMock := mocks.NewRoleRepository(t)
+	auditRepoMock := mocks.NewAuditRepository(t)
+	auditRepoMock.On("Save", mock.Anything, mock.Anything).Return(nil).Maybe()
+	eventPublisherMock := mocks.NewEventPublisher(t)
+	eventPublisherMock.On("Publish", mock.Anything, mock.Anything).Return(nil).Maybe()
+	transactionManagerMock := mocks.NewTransactionManager(t)
+	transactionManagerMock.On("Transaction", mock.Anything, mock.Anything).Return(func(ctx context.Context, operation func(context.Context) error) error {
+		return operation(ctx)
+	}).Maybe()
+	return testCase{
+		PermissionRepo:     permissionRepoMock,
+		RoleRepo:           roleRepoMock,
+		AuditRepo:          auditRepoMock,
+		EventPublisher:     eventPublisherMock,
+		TransactionManager: transactionManagerMock,
+		UseCase:            NewUpdateRoleUseCase(roleRepoMock, permissionRepoMock, audit.NewChangeRecorder(auditRepoMock, eventPublisherMock), transactionManagerMock, logger),
+	}
+}
+
+func TestExecuteWrongRequest(t *testing.T) {
+	testCase := setUp(t)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, "wrongRequest")
+
+	if response.Err == nil {
+		t.Fatal("Expected use case to return error")
+	}
+	testCase.RoleRepo.AssertNotCalled(t, "UpdatePermissions")
+}
+
+func TestExecuteRoleNotFound(t *testing.T) {
+	testCase := setUp(t)
+	testCase.RoleRepo.On("FindByNames", mock.Anything, mock.Anything).Return([]role.Role{}, nil)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &UpdateRoleRequest{Name: "Test role"})
+
+	if _, ok := response.Err.(role.RoleNotFoundError); !ok {
+		t.Fatal("Expected use case to return role not found error")
+	}
+	testCase.PermissionRepo.AssertNotCalled(t, "FindByNames")
+	testCase.RoleRepo.AssertNotCalled(t, "UpdatePermissions")
+}
+
+func TestExecutePermissionsNotFound(t *testing.T) {
+	testCase := setUp(t)
+	testCase.RoleRepo.On("FindByNames", mock.Anything, mock.Anything).Return([]role.Role{{Name: "Test role"}}, nil)
+	testCase.PermissionRepo.On("FindByNames", mock.Anything, mock.Anything).Return([]permission.Permission{{Name: "Test permission 1"}}, nil)
+	request := UpdateRoleRequest{
+		Name:        "Test role",
+		Permissions: []string{"Test permission 1", "Test permission 2"},
+	}
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err == nil {
+		t.Fatal("Expected use case to return error")
+	}
+	testCase.RoleRepo.AssertNotCalled(t, "UpdatePermissions")
+}
+
+func TestExecuteUpdateError(t *testing.T) {
+	testCase := setUp(t)
+	updateError := errors.New("Test update error")
+	testCase.RoleRepo.On("FindByNames", mock.Anything, mock.Anything).Return([]role.Role{{Name: "Test role"}}, nil)
+	testCase.PermissionRepo.On("FindByNames", mock.Anything, mock.Anything).Return([]permission.Permission{{Name: "Test permission"}}, nil)
+	testCase.RoleRepo.On("UpdatePermissions", mock.Anything, mock.Anything).Return(updateError)
+	request := UpdateRoleRequest{
+		Name:        "Test role",
+		Permissions: []string{"Test permission"},
+	}
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != updateError {
+		t.Fatal("Error expected to be the same as the role repository returned error")
+	}
+}
+
+func TestExecuteSuccess(t *testing.T) {
+	testCase := setUp(t)
+	roleName := "Test role"
+	permissions := []permission.Permission{{Name: "Test permission"}}
+	existingRole := role.Role{
+		Name:        roleName,
+		Permissions: []permission.Permission{{Name: "Old permission"}},
+		Parents:     []role.Role{{Name: "Test parent"}},
+	}
+	testCase.RoleRepo.On("FindByNames", mock.Anything, mock.Anything).Return([]role.Role{existingRole}, nil)
+	testCase.PermissionRepo.On("FindByNames", mock.Anything, mock.Anything).Return(permissions, nil)
+	testCase.RoleRepo.On("UpdatePermissions", mock.Anything, mock.Anything).Return(nil)
+	request := UpdateRoleRequest{
+		Name:        roleName,
+		Permissions: []string{"Test permission"},
+	}
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != nil {
+		t.Fatal("Expected use case not to return error")
+	}
+	testCase.RoleRepo.AssertCalled(t, "UpdatePermissions", ctx, mock.MatchedBy(func(updatedRole role.Role) bool {
+		return updatedRole.Name == roleName &&
+			reflect.DeepEqual(updatedRole.Permissions, permissions) &&
+			reflect.DeepEqual(updatedRole.Parents, existingRole.Parents)
+	}))
+	testCase.AuditRepo.AssertCalled(t, "Save", mock.Anything, mock.MatchedBy(func(entry audit.AuditEntry) bool {
+		return entry.Action == audit.UpdateRoleAction
+	}))
+	testCase.EventPublisher.AssertCalled(t, "Publish", mock.Anything, mock.AnythingOfType("role.RoleUpdatedEvent"))
+}
+
+func TestExecuteClearPermissions(t *testing.T) {
+	testCase := setUp(t)
+	existingRole := role.Role{
+		Name:        "Test role",
+		Permissions: []permission.Permission{{Name: "Old permission"}},
+	}
+	testCase.RoleRepo.On("FindByNames", mock.Anything, mock.Anything).Return([]role.Role{existingRole}, nil)
+	testCase.RoleRepo.On("UpdatePermissions", mock.Anything, mock.Anything).Return(nil)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &UpdateRoleRequest{Name: existingRole.Name})
+
+	if response.Err != nil {
+		t.Fatal("Expected use case not to return error")
+	}
+	testCase.PermissionRepo.AssertNotCalled(t, "FindByNames")
+	testCase.RoleRepo.AssertCalled(t, "UpdatePermissions", ctx, mock.MatchedBy(func(updatedRole role.Role) bool {
+		return len(updatedRole.Permissions) == 0
+	}))
+}
+
+func TestExecuteRoleVersionMismatch(t *testing.T) {
+	testCase := setUp(t)
+	testCase.RoleRepo.On("FindByNames", mock.Anything, mock.Anything).Return([]role.Role{{Name: "Test role", Version: 3}}, nil)
+	staleVersion := int64(2)
+	request := UpdateRoleRequest{
+		Name:            "Test role",
+		Permissions:     []string{"Test permission"},
+		ExpectedVersion: &staleVersion,
+	}
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if _, ok := response.Err.(role.RoleVersionMismatchError); !ok {
+		t.Fatal("Expected use case to return role version mismatch error")
+	}
+	testCase.PermissionRepo.AssertNotCalled(t, "FindByNames")
+	testCase.RoleRepo.AssertNotCalled(t, "UpdatePermissions")
+}
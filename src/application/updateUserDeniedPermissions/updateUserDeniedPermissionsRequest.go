@@ -0,0 +1,7 @@
+package updateUserDeniedPermissions
+
+type UpdateUserDeniedPermissionsRequest struct {
+	UserEmail       string
+	PermissionNames []string
+	ExpectedVersion *int64
+}
@@ -0,0 +1,84 @@
+package updateUserDeniedPermissions
+
+import (
+	"context"
+	"fmt"
+	"go-as/src/domain/audit"
+	"go-as/src/domain/internals"
+	"go-as/src/domain/permission"
+	"go-as/src/domain/user"
+)
+
+type UpdateUserDeniedPermissionsUseCase struct {
+	userRepository       user.UserRepository
+	permissionRepository permission.PermissionRepository
+	changeRecorder       *audit.ChangeRecorder
+	transactionManager   internals.TransactionManager
+	logger               internals.Logger
+}
+
+func (useCase *UpdateUserDeniedPermissionsUseCase) Execute(ctx context.Context, request any) internals.UseCaseResponse {
+	validatedRequest, errResponse := internals.ValidateUseCaseRequest[*UpdateUserDeniedPermissionsRequest](request)
+	if errResponse != nil {
+		return *errResponse
+	}
+
+	useCase.logger.Info(ctx, fmt.Sprintf("Starting updating denied permissions of %s", validatedRequest.UserEmail))
+	defer useCase.logger.Info(ctx, fmt.Sprintf("Finished updating denied permissions of %s", validatedRequest.UserEmail))
+
+	targetUser, err := useCase.userRepository.FindByEmail(ctx, validatedRequest.UserEmail)
+	if err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+	if targetUser == nil {
+		return internals.ErrorUseCaseResponse(fmt.Errorf("user %s not found", validatedRequest.UserEmail))
+	}
+	if err = targetUser.CheckVersion(validatedRequest.ExpectedVersion); err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+
+	permissions, err := useCase.permissionRepository.FindByNames(ctx, validatedRequest.PermissionNames)
+	if err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+	if len(permissions) != len(validatedRequest.PermissionNames) {
+		return internals.ErrorUseCaseResponse(fmt.Errorf("permissions %s not found", validatedRequest.PermissionNames))
+	}
+
+	before := permission.Names(targetUser.DeniedPermissions)
+	targetUser.DeniedPermissions = permissions
+	err = useCase.transactionManager.Transaction(ctx, func(ctx context.Context) error {
+		if err := useCase.userRepository.Save(ctx, *targetUser); err != nil {
+			return err
+		}
+		return useCase.changeRecorder.Record(ctx, audit.Change{
+			Action:     audit.UpdateUserDeniedPermissionsAction,
+			TargetType: audit.UserTargetType,
+			Target:     targetUser.Email,
+			Before:     map[string][]string{"deniedPermissions": before},
+			After:      map[string][]string{"deniedPermissions": permission.Names(permissions)},
+			Event: user.UserDeniedPermissionsUpdatedEvent{
+				Email:             targetUser.Email,
+				DeniedPermissions: permission.Names(permissions),
+			},
+		})
+	})
+	if err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+	return internals.EmptyUseCaseResponse()
+}
+
+func (*UpdateUserDeniedPermissionsUseCase) RequiredPermissions() []string {
+	return []string{user.UpdateUserPermission}
+}
+
+func NewUpdateUserDeniedPermissionsUseCase(userRepository user.UserRepository, permissionRepository permission.PermissionRepository, changeRecorder *audit.ChangeRecorder, transactionManager internals.TransactionManager, logger internals.Logger) *UpdateUserDeniedPermissionsUseCase {
+	return &UpdateUserDeniedPermissionsUseCase{
+		userRepository:       userRepository,
+		permissionRepository: permissionRepository,
+		changeRecorder:       changeRecorder,
+		transactionManager:   transactionManager,
+		logger:               logger,
+	}
+}
@@ -0,0 +1,7 @@
+package updateUserDisabled
+
+type UpdateUserDisabledRequest struct {
+	UserEmail       string
+	Disabled        bool
+	ExpectedVersion *int64
+}
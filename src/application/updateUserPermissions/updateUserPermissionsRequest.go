@@ -1,6 +1,10 @@
 package updateUserPermissions
 
+import "go-as/src/domain/user"
+
 type UpdateUserPermissionsRequest struct {
 	UserEmail       string
 	PermissionNames []string
+	Validities      map[string]user.GrantValidity
+	ExpectedVersion *int64
 }
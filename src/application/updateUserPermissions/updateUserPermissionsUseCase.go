@@ -3,6 +3,7 @@ package updateUserPermissions
 import (
 	"context"
 	"fmt"
+	"go-as/src/domain/audit"
 	"go-as/src/domain/internals"
 	"go-as/src/domain/permission"
 	"go-as/src/domain/user"
@@ -11,6 +12,8 @@ import (
 type UpdateUserPermissionsUseCase struct {
 	userRepository       user.UserRepository
 	permissionRepository permission.PermissionRepository
+	changeRecorder       *audit.ChangeRecorder
+	transactionManager   internals.TransactionManager
 	logger               internals.Logger
 }
 
@@ -23,13 +26,21 @@ func (useCase *UpdateUserPermissionsUseCase) Execute(ctx context.Context, reques
 	useCase.logger.Info(ctx, fmt.Sprintf("Starting updating permissions to %s", validatedRequest.UserEmail))
 	defer useCase.logger.Info(ctx, fmt.Sprintf("Finished updating permissions to %s", validatedRequest.UserEmail))
 
-	user, err := useCase.userRepository.FindByEmail(ctx, validatedRequest.UserEmail)
+	grants, err := useCase.buildPermissionGrants(validatedRequest)
 	if err != nil {
 		return internals.ErrorUseCaseResponse(err)
 	}
-	if user == nil {
+
+	targetUser, err := useCase.userRepository.FindByEmail(ctx, validatedRequest.UserEmail)
+	if err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+	if targetUser == nil {
 		return internals.ErrorUseCaseResponse(fmt.Errorf("user %s not found", validatedRequest.UserEmail))
 	}
+	if err = targetUser.CheckVersion(validatedRequest.ExpectedVersion); err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
 
 	permissions, err := useCase.permissionRepository.FindByNames(ctx, validatedRequest.PermissionNames)
 	if err != nil {
@@ -39,22 +50,64 @@ func (useCase *UpdateUserPermissionsUseCase) Execute(ctx context.Context, reques
 		return internals.ErrorUseCaseResponse(fmt.Errorf("permissions %s not found", validatedRequest.PermissionNames))
 	}
 
-	user.Permissions = permissions
-	err = useCase.userRepository.Save(ctx, *user)
+	before := permission.Names(targetUser.Permissions)
+	targetUser.Permissions = permissions
+	targetUser.PermissionGrants = grants
+	err = useCase.transactionManager.Transaction(ctx, func(ctx context.Context) error {
+		if err := useCase.userRepository.Save(ctx, *targetUser); err != nil {
+			return err
+		}
+		return useCase.changeRecorder.Record(ctx, audit.Change{
+			Action:     audit.UpdateUserPermissionsAction,
+			TargetType: audit.UserTargetType,
+			Target:     targetUser.Email,
+			Before:     map[string][]string{"permissions": before},
+			After:      map[string][]string{"permissions": permission.Names(permissions)},
+			Event: user.UserPermissionsUpdatedEvent{
+				Email:       targetUser.Email,
+				Permissions: permission.Names(permissions),
+			},
+		})
+	})
 	if err != nil {
 		return internals.ErrorUseCaseResponse(err)
 	}
 	return internals.EmptyUseCaseResponse()
 }
 
+func (*UpdateUserPermissionsUseCase) buildPermissionGrants(request *UpdateUserPermissionsRequest) ([]user.UserPermission, error) {
+	grants := make([]user.UserPermission, 0, len(request.PermissionNames))
+	grantedNames := make(map[string]bool, len(request.PermissionNames))
+	for _, permissionName := range request.PermissionNames {
+		grantedNames[permissionName] = true
+		validity := request.Validities[permissionName]
+		if err := validity.Validate(); err != nil {
+			return nil, err
+		}
+		grants = append(grants, user.UserPermission{
+			UserEmail:      request.UserEmail,
+			PermissionName: permissionName,
+			GrantValidity:  validity,
+		})
+	}
+	for permissionName := range request.Validities {
+		if !grantedNames[permissionName] {
+			return nil, fmt.Errorf("validity provided for permission %s which is not being granted", permissionName)
+		}
+	}
+	return grants, nil
+}
+
 func (*UpdateUserPermissionsUseCase) RequiredPermissions() []string {
 	return []string{user.UpdateUserPermission}
 }
 
-func NewUpdateUserPermissionsUseCase(userRepository user.UserRepository, permissionRepository permission.PermissionRepository, logger internals.Logger) *UpdateUserPermissionsUseCase {
+func NewUpdateUserPermissionsUseCase(userRepository user.UserRepository, permissionRepository permission.PermissionRepository, changeRecorder *audit.ChangeRecorder, transactionManager internals.TransactionManager, logger internals.Logger) *UpdateUserPermissionsUseCase {
 	return &UpdateUserPermissionsUseCase{
 		userRepository:       userRepository,
 		permissionRepository: permissionRepository,
+		changeRecorder:       changeRecorder,
+		transactionManager:   transactionManager,
 		logger:               logger,
 	}
 }
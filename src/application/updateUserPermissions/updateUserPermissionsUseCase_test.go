@@ -4,21 +4,27 @@ import (
 	"context"
 	"errors"
 	"go-as/mocks"
+	"go-as/src/domain/audit"
+	"go-as/src/domain/auth"
 	"go-as/src/domain/internals"
 	"go-as/src/domain/permission"
 	"go-as/src/domain/user"
 	"go-as/src/infrastructure/logging"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 	"go.elastic.co/apm/v2"
 )
 
 type testCase struct {
-	UserRepo       *mocks.UserRepository
-	PermissionRepo *mocks.PermissionRepository
-	UseCase        *UpdateUserPermissionsUseCase
+	UserRepo           *mocks.UserRepository
+	PermissionRepo     *mocks.PermissionRepository
+	AuditRepo          *mocks.AuditRepository
+	EventPublisher     *mocks.EventPublisher
+	TransactionManager *mocks.TransactionManager
+	UseCase            *UpdateUserPermissionsUseCase
 }
 
 func setUp(t *testing.T) testCase {
@@ -26,10 +32,19 @@ func setUp(t *testing.T) testCase {
 	logger := logging.NewZapTracedLogger(tracer)
 	permissionRepoMock := mocks.NewPermissionRepository(t)
 	userRepoMock := mocks.NewUserRepository(t)
+	auditRepoMock := mocks.NewAuditRepository(t)
+	eventPublisherMock := mocks.NewEventPublisher(t)
+	transactionManagerMock := mocks.NewTransactionManager(t)
+	transactionManagerMock.On("Transaction", mock.Anything, mock.Anything).Return(func(ctx context.Context, operation func(context.Context) error) error {
+		return operation(ctx)
+	}).Maybe()
 	return testCase{
-		UserRepo:       userRepoMock,
-		PermissionRepo: permissionRepoMock,
-		UseCase:        NewUpdateUserPermissionsUseCase(userRepoMock, permissionRepoMock, logger),
+		UserRepo:           userRepoMock,
+		PermissionRepo:     permissionRepoMock,
+		AuditRepo:          auditRepoMock,
+		EventPublisher:     eventPublisherMock,
+		TransactionManager: transactionManagerMock,
+		UseCase:            NewUpdateUserPermissionsUseCase(userRepoMock, permissionRepoMock, audit.NewChangeRecorder(auditRepoMock, eventPublisherMock), transactionManagerMock, logger),
 	}
 }
 
@@ -188,6 +203,8 @@ func TestExecuteSuccess(t *testing.T) {
 	testPermissions := []permission.Permission{{Name: "testPermission1"}, {Name: "testPermission2"}}
 	testCase.PermissionRepo.On("FindByNames", mock.Anything, mock.Anything).Return(testPermissions, nil)
 	testCase.UserRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+	testCase.AuditRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+	testCase.EventPublisher.On("Publish", mock.Anything, mock.Anything).Return(nil)
 	ctx := context.Background()
 
 	response := testCase.UseCase.Execute(ctx, &request)
@@ -204,3 +221,152 @@ func TestExecuteSuccess(t *testing.T) {
 		return user.Email == request.UserEmail && reflect.DeepEqual(user.Permissions, testPermissions)
 	}))
 }
+
+func TestExecuteInvalidValidity(t *testing.T) {
+	testCase := setUp(t)
+	validFrom := time.Now()
+	request := UpdateUserPermissionsRequest{
+		UserEmail:       "testEmail",
+		PermissionNames: []string{"testPermission1"},
+		Validities:      map[string]user.GrantValidity{"testPermission1": {ValidFrom: &validFrom, ValidUntil: &validFrom}},
+	}
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if _, isValidityError := response.Err.(user.InvalidGrantValidityError); !isValidityError {
+		t.Fatal("Expected use case to return an invalid grant validity error")
+	}
+	testCase.UserRepo.AssertNotCalled(t, "FindByEmail")
+	testCase.UserRepo.AssertNotCalled(t, "Save")
+}
+
+func TestExecuteWithValiditySuccess(t *testing.T) {
+	testCase := setUp(t)
+	validFrom := time.Now()
+	validUntil := validFrom.Add(time.Hour)
+	request := UpdateUserPermissionsRequest{
+		UserEmail:       "testEmail",
+		PermissionNames: []string{"testPermission1"},
+		Validities:      map[string]user.GrantValidity{"testPermission1": {ValidFrom: &validFrom, ValidUntil: &validUntil}},
+	}
+	testUser := &user.User{
+		Email: "testEmail",
+	}
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(testUser, nil)
+	testPermissions := []permission.Permission{{Name: "testPermission1"}}
+	testCase.PermissionRepo.On("FindByNames", mock.Anything, mock.Anything).Return(testPermissions, nil)
+	testCase.UserRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+	testCase.AuditRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+	testCase.EventPublisher.On("Publish", mock.Anything, mock.Anything).Return(nil)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != nil {
+		t.Fatal("Expected use case not to return error")
+	}
+	expectedGrants := []user.UserPermission{
+		{UserEmail: "testEmail", PermissionName: "testPermission1", GrantValidity: request.Validities["testPermission1"]},
+	}
+	testCase.UserRepo.AssertCalled(t, "Save", ctx, mock.MatchedBy(func(user user.User) bool {
+		return reflect.DeepEqual(user.Permissions, testPermissions) && reflect.DeepEqual(user.PermissionGrants, expectedGrants)
+	}))
+}
+
+func TestExecuteUserVersionMismatch(t *testing.T) {
+	testCase := setUp(t)
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(&user.User{Email: "testEmail", Version: 3}, nil)
+	staleVersion := int64(2)
+	request := UpdateUserPermissionsRequest{
+		UserEmail:       "testEmail",
+		PermissionNames: []string{"testPermission"},
+		ExpectedVersion: &staleVersion,
+	}
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if _, ok := response.Err.(user.UserVersionMismatchError); !ok {
+		t.Fatal("Expected use case to return user version mismatch error")
+	}
+	testCase.PermissionRepo.AssertNotCalled(t, "FindByNames")
+	testCase.UserRepo.AssertNotCalled(t, "Save")
+}
+
+func TestExecuteAuditSaveError(t *testing.T) {
+	testCase := setUp(t)
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(&user.User{Email: "testEmail"}, nil)
+	testCase.PermissionRepo.On("FindByNames", mock.Anything, mock.Anything).Return([]permission.Permission{{Name: "testPermission"}}, nil)
+	testCase.UserRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+	auditError := errors.New("Test audit error")
+	testCase.AuditRepo.On("Save", mock.Anything, mock.Anything).Return(auditError)
+	request := UpdateUserPermissionsRequest{
+		UserEmail:       "testEmail",
+		PermissionNames: []string{"testPermission"},
+	}
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != auditError {
+		t.Fatal("Error expected to be the same as the audit repository returned error")
+	}
+}
+
+func TestExecuteRecordsAuditEntry(t *testing.T) {
+	testCase := setUp(t)
+	testUser := &user.User{
+		Email:       "testEmail",
+		Permissions: []permission.Permission{{Name: "oldPermission"}},
+	}
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(testUser, nil)
+	testCase.PermissionRepo.On("FindByNames", mock.Anything, mock.Anything).Return([]permission.Permission{{Name: "newPermission"}}, nil)
+	testCase.UserRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+	testCase.AuditRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+	testCase.EventPublisher.On("Publish", mock.Anything, mock.Anything).Return(nil)
+	request := UpdateUserPermissionsRequest{
+		UserEmail:       "testEmail",
+		PermissionNames: []string{"newPermission"},
+	}
+	ctx := auth.WithActor(context.Background(), "actorEmail")
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != nil {
+		t.Fatal("Expected use case not to return error")
+	}
+	testCase.AuditRepo.AssertCalled(t, "Save", ctx, mock.MatchedBy(func(entry audit.AuditEntry) bool {
+		return entry.Actor == "actorEmail" &&
+			entry.Action == audit.UpdateUserPermissionsAction &&
+			entry.TargetType == audit.UserTargetType &&
+			entry.Target == "testEmail" &&
+			entry.Before == `{"permissions":["oldPermission"]}` &&
+			entry.After == `{"permissions":["newPermission"]}`
+	}))
+}
+
+func TestExecutePublishesUserPermissionsUpdatedEvent(t *testing.T) {
+	testCase := setUp(t)
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(&user.User{Email: "testEmail"}, nil)
+	testCase.PermissionRepo.On("FindByNames", mock.Anything, mock.Anything).Return([]permission.Permission{{Name: "testPermission"}}, nil)
+	testCase.UserRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+	testCase.AuditRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+	publishError := errors.New("Test publish error")
+	testCase.EventPublisher.On("Publish", mock.Anything, mock.Anything).Return(publishError)
+	request := UpdateUserPermissionsRequest{
+		UserEmail:       "testEmail",
+		PermissionNames: []string{"testPermission"},
+	}
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != publishError {
+		t.Fatal("Error expected to be the same as the event publisher returned error")
+	}
+	testCase.EventPublisher.AssertCalled(t, "Publish", ctx, user.UserPermissionsUpdatedEvent{
+		Email:       "testEmail",
+		Permissions: []string{"testPermission"},
+	})
+}
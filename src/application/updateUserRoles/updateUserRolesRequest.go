@@ -1,6 +1,10 @@
 package updateUserRoles
 
+import "go-as/src/domain/user"
+
 type UpdateUserRolesRequest struct {
-	UserEmail string
-	RoleNames []string
+	UserEmail       string
+	RoleNames       []string
+	Validities      map[string]user.GrantValidity
+	ExpectedVersion *int64
 }
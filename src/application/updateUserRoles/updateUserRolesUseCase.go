@@ -3,15 +3,18 @@ package updateUserRoles
 import (
 	"context"
 	"fmt"
+	"go-as/src/domain/audit"
 	"go-as/src/domain/internals"
 	"go-as/src/domain/role"
 	"go-as/src/domain/user"
 )
 
 type UpdateUserRolesUseCase struct {
-	userRepository user.UserRepository
-	roleRepository role.RoleRepository
-	logger         internals.Logger
+	userRepository     user.UserRepository
+	roleRepository     role.RoleRepository
+	changeRecorder     *audit.ChangeRecorder
+	transactionManager internals.TransactionManager
+	logger             internals.Logger
 }
 
 func (useCase *UpdateUserRolesUseCase) Execute(ctx context.Context, request any) internals.UseCaseResponse {
@@ -23,13 +26,21 @@ func (useCase *UpdateUserRolesUseCase) Execute(ctx context.Context, request any)
 	useCase.logger.Info(ctx, fmt.Sprintf("Starting adding roles to %s", validatedRequest.UserEmail))
 	defer useCase.logger.Info(ctx, fmt.Sprintf("Finished adding roles to %s", validatedRequest.UserEmail))
 
-	user, err := useCase.userRepository.FindByEmail(ctx, validatedRequest.UserEmail)
+	grants, err := useCase.buildRoleGrants(validatedRequest)
 	if err != nil {
 		return internals.ErrorUseCaseResponse(err)
 	}
-	if user == nil {
+
+	targetUser, err := useCase.userRepository.FindByEmail(ctx, validatedRequest.UserEmail)
+	if err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
+	if targetUser == nil {
 		return internals.ErrorUseCaseResponse(fmt.Errorf("user %s not found", validatedRequest.UserEmail))
 	}
+	if err = targetUser.CheckVersion(validatedRequest.ExpectedVersion); err != nil {
+		return internals.ErrorUseCaseResponse(err)
+	}
 
 	roles, err := useCase.roleRepository.FindByNames(ctx, validatedRequest.RoleNames)
 	if err != nil {
@@ -39,22 +50,64 @@ func (useCase *UpdateUserRolesUseCase) Execute(ctx context.Context, request any)
 		return internals.ErrorUseCaseResponse(fmt.Errorf("roles %s not found", validatedRequest.RoleNames))
 	}
 
-	user.Roles = roles
-	err = useCase.userRepository.Save(ctx, *user)
+	before := role.Names(targetUser.Roles)
+	targetUser.Roles = roles
+	targetUser.RoleGrants = grants
+	err = useCase.transactionManager.Transaction(ctx, func(ctx context.Context) error {
+		if err := useCase.userRepository.Save(ctx, *targetUser); err != nil {
+			return err
+		}
+		return useCase.changeRecorder.Record(ctx, audit.Change{
+			Action:     audit.UpdateUserRolesAction,
+			TargetType: audit.UserTargetType,
+			Target:     targetUser.Email,
+			Before:     map[string][]string{"roles": before},
+			After:      map[string][]string{"roles": role.Names(roles)},
+			Event: user.UserRolesUpdatedEvent{
+				Email: targetUser.Email,
+				Roles: role.Names(roles),
+			},
+		})
+	})
 	if err != nil {
 		return internals.ErrorUseCaseResponse(err)
 	}
 	return internals.EmptyUseCaseResponse()
 }
 
+func (*UpdateUserRolesUseCase) buildRoleGrants(request *UpdateUserRolesRequest) ([]user.UserRole, error) {
+	grants := make([]user.UserRole, 0, len(request.RoleNames))
+	grantedNames := make(map[string]bool, len(request.RoleNames))
+	for _, roleName := range request.RoleNames {
+		grantedNames[roleName] = true
+		validity := request.Validities[roleName]
+		if err := validity.Validate(); err != nil {
+			return nil, err
+		}
+		grants = append(grants, user.UserRole{
+			UserEmail:     request.UserEmail,
+			RoleName:      roleName,
+			GrantValidity: validity,
+		})
+	}
+	for roleName := range request.Validities {
+		if !grantedNames[roleName] {
+			return nil, fmt.Errorf("validity provided for role %s which is not being granted", roleName)
+		}
+	}
+	return grants, nil
+}
+
 func (*UpdateUserRolesUseCase) RequiredPermissions() []string {
 	return []string{user.UpdateUserPermission}
 }
 
-func NewUpdateUserRolesUseCase(userRepository user.UserRepository, roleRepository role.RoleRepository, logger internals.Logger) *UpdateUserRolesUseCase {
+func NewUpdateUserRolesUseCase(userRepository user.UserRepository, roleRepository role.RoleRepository, changeRecorder *audit.ChangeRecorder, transactionManager internals.TransactionManager, logger internals.Logger) *UpdateUserRolesUseCase {
 	return &UpdateUserRolesUseCase{
-		userRepository: userRepository,
-		roleRepository: roleRepository,
-		logger:         logger,
+		userRepository:     userRepository,
+		roleRepository:     roleRepository,
+		changeRecorder:     changeRecorder,
+		transactionManager: transactionManager,
+		logger:             logger,
 	}
 }
@@ -4,21 +4,27 @@ import (
 	"context"
 	"errors"
 	"go-as/mocks"
+	"go-as/src/domain/audit"
+	"go-as/src/domain/auth"
 	"go-as/src/domain/internals"
 	"go-as/src/domain/role"
 	"go-as/src/domain/user"
 	"go-as/src/infrastructure/logging"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 	"go.elastic.co/apm/v2"
 )
 
 type testCase struct {
-	UserRepo *mocks.UserRepository
-	RoleRepo *mocks.RoleRepository
-	UseCase  *UpdateUserRolesUseCase
+	UserRepo           *mocks.UserRepository
+	RoleRepo           *mocks.RoleRepository
+	AuditRepo          *mocks.AuditRepository
+	EventPublisher     *mocks.EventPublisher
+	TransactionManager *mocks.TransactionManager
+	UseCase            *UpdateUserRolesUseCase
 }
 
 func setUp(t *testing.T) testCase {
@@ -26,10 +32,19 @@ func setUp(t *testing.T) testCase {
 	logger := logging.NewZapTracedLogger(tracer)
 	roleRepoMock := mocks.NewRoleRepository(t)
 	userRepoMock := mocks.NewUserRepository(t)
+	auditRepoMock := mocks.NewAuditRepository(t)
+	eventPublisherMock := mocks.NewEventPublisher(t)
+	transactionManagerMock := mocks.NewTransactionManager(t)
+	transactionManagerMock.On("Transaction", mock.Anything, mock.Anything).Return(func(ctx context.Context, operation func(context.Context) error) error {
+		return operation(ctx)
+	}).Maybe()
 	return testCase{
-		UserRepo: userRepoMock,
-		RoleRepo: roleRepoMock,
-		UseCase:  NewUpdateUserRolesUseCase(userRepoMock, roleRepoMock, logger),
+		UserRepo:           userRepoMock,
+		RoleRepo:           roleRepoMock,
+		AuditRepo:          auditRepoMock,
+		EventPublisher:     eventPublisherMock,
+		TransactionManager: transactionManagerMock,
+		UseCase:            NewUpdateUserRolesUseCase(userRepoMock, roleRepoMock, audit.NewChangeRecorder(auditRepoMock, eventPublisherMock), transactionManagerMock, logger),
 	}
 }
 
@@ -188,6 +203,8 @@ func TestExecuteSuccess(t *testing.T) {
 	testRoles := []role.Role{{Name: "testRole1"}, {Name: "testRole2"}}
 	testCase.RoleRepo.On("FindByNames", mock.Anything, mock.Anything).Return(testRoles, nil)
 	testCase.UserRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+	testCase.AuditRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+	testCase.EventPublisher.On("Publish", mock.Anything, mock.Anything).Return(nil)
 	ctx := context.Background()
 
 	response := testCase.UseCase.Execute(ctx, &request)
@@ -204,3 +221,171 @@ func TestExecuteSuccess(t *testing.T) {
 		return user.Email == request.UserEmail && reflect.DeepEqual(user.Roles, testRoles)
 	}))
 }
+
+func TestExecuteInvalidValidity(t *testing.T) {
+	testCase := setUp(t)
+	validFrom := time.Now()
+	validUntil := validFrom.Add(-time.Hour)
+	request := UpdateUserRolesRequest{
+		UserEmail:  "testEmail",
+		RoleNames:  []string{"testRole1"},
+		Validities: map[string]user.GrantValidity{"testRole1": {ValidFrom: &validFrom, ValidUntil: &validUntil}},
+	}
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if _, isValidityError := response.Err.(user.InvalidGrantValidityError); !isValidityError {
+		t.Fatal("Expected use case to return an invalid grant validity error")
+	}
+	testCase.UserRepo.AssertNotCalled(t, "FindByEmail")
+	testCase.UserRepo.AssertNotCalled(t, "Save")
+}
+
+func TestExecuteValidityForRoleNotGranted(t *testing.T) {
+	testCase := setUp(t)
+	validUntil := time.Now().Add(time.Hour)
+	request := UpdateUserRolesRequest{
+		UserEmail:  "testEmail",
+		RoleNames:  []string{"testRole1"},
+		Validities: map[string]user.GrantValidity{"testRole2": {ValidUntil: &validUntil}},
+	}
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err == nil {
+		t.Fatal("Expected use case to return error")
+	}
+	testCase.UserRepo.AssertNotCalled(t, "FindByEmail")
+	testCase.UserRepo.AssertNotCalled(t, "Save")
+}
+
+func TestExecuteWithValiditySuccess(t *testing.T) {
+	testCase := setUp(t)
+	validUntil := time.Now().Add(time.Hour)
+	request := UpdateUserRolesRequest{
+		UserEmail:  "testEmail",
+		RoleNames:  []string{"testRole1", "testRole2"},
+		Validities: map[string]user.GrantValidity{"testRole1": {ValidUntil: &validUntil}},
+	}
+	testUser := &user.User{
+		Email: "testEmail",
+	}
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(testUser, nil)
+	testRoles := []role.Role{{Name: "testRole1"}, {Name: "testRole2"}}
+	testCase.RoleRepo.On("FindByNames", mock.Anything, mock.Anything).Return(testRoles, nil)
+	testCase.UserRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+	testCase.AuditRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+	testCase.EventPublisher.On("Publish", mock.Anything, mock.Anything).Return(nil)
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != nil {
+		t.Fatal("Expected use case not to return error")
+	}
+	expectedGrants := []user.UserRole{
+		{UserEmail: "testEmail", RoleName: "testRole1", GrantValidity: user.GrantValidity{ValidUntil: &validUntil}},
+		{UserEmail: "testEmail", RoleName: "testRole2"},
+	}
+	testCase.UserRepo.AssertCalled(t, "Save", ctx, mock.MatchedBy(func(user user.User) bool {
+		return reflect.DeepEqual(user.Roles, testRoles) && reflect.DeepEqual(user.RoleGrants, expectedGrants)
+	}))
+}
+
+func TestExecuteUserVersionMismatch(t *testing.T) {
+	testCase := setUp(t)
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(&user.User{Email: "testEmail", Version: 3}, nil)
+	staleVersion := int64(2)
+	request := UpdateUserRolesRequest{
+		UserEmail:       "testEmail",
+		RoleNames:       []string{"testRole"},
+		ExpectedVersion: &staleVersion,
+	}
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if _, ok := response.Err.(user.UserVersionMismatchError); !ok {
+		t.Fatal("Expected use case to return user version mismatch error")
+	}
+	testCase.RoleRepo.AssertNotCalled(t, "FindByNames")
+	testCase.UserRepo.AssertNotCalled(t, "Save")
+}
+
+func TestExecuteAuditSaveError(t *testing.T) {
+	testCase := setUp(t)
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(&user.User{Email: "testEmail"}, nil)
+	testCase.RoleRepo.On("FindByNames", mock.Anything, mock.Anything).Return([]role.Role{{Name: "testRole"}}, nil)
+	testCase.UserRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+	auditError := errors.New("Test audit error")
+	testCase.AuditRepo.On("Save", mock.Anything, mock.Anything).Return(auditError)
+	request := UpdateUserRolesRequest{
+		UserEmail: "testEmail",
+		RoleNames: []string{"testRole"},
+	}
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != auditError {
+		t.Fatal("Error expected to be the same as the audit repository returned error")
+	}
+}
+
+func TestExecuteRecordsAuditEntry(t *testing.T) {
+	testCase := setUp(t)
+	testUser := &user.User{
+		Email: "testEmail",
+		Roles: []role.Role{{Name: "oldRole"}},
+	}
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(testUser, nil)
+	testCase.RoleRepo.On("FindByNames", mock.Anything, mock.Anything).Return([]role.Role{{Name: "newRole"}}, nil)
+	testCase.UserRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+	testCase.AuditRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+	testCase.EventPublisher.On("Publish", mock.Anything, mock.Anything).Return(nil)
+	request := UpdateUserRolesRequest{
+		UserEmail: "testEmail",
+		RoleNames: []string{"newRole"},
+	}
+	ctx := auth.WithActor(context.Background(), "actorEmail")
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != nil {
+		t.Fatal("Expected use case not to return error")
+	}
+	testCase.AuditRepo.AssertCalled(t, "Save", ctx, mock.MatchedBy(func(entry audit.AuditEntry) bool {
+		return entry.Actor == "actorEmail" &&
+			entry.Action == audit.UpdateUserRolesAction &&
+			entry.Target == "testEmail" &&
+			entry.Before == `{"roles":["oldRole"]}` &&
+			entry.After == `{"roles":["newRole"]}`
+	}))
+}
+
+func TestExecutePublishesUserRolesUpdatedEvent(t *testing.T) {
+	testCase := setUp(t)
+	testCase.UserRepo.On("FindByEmail", mock.Anything, mock.Anything).Return(&user.User{Email: "testEmail"}, nil)
+	testCase.RoleRepo.On("FindByNames", mock.Anything, mock.Anything).Return([]role.Role{{Name: "testRole"}}, nil)
+	testCase.UserRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+	testCase.AuditRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+	publishError := errors.New("Test publish error")
+	testCase.EventPublisher.On("Publish", mock.Anything, mock.Anything).Return(publishError)
+	request := UpdateUserRolesRequest{
+		UserEmail: "testEmail",
+		RoleNames: []string{"testRole"},
+	}
+	ctx := context.Background()
+
+	response := testCase.UseCase.Execute(ctx, &request)
+
+	if response.Err != publishError {
+		t.Fatal("Error expected to be the same as the event publisher returned error")
+	}
+	testCase.EventPublisher.AssertCalled(t, "Publish", ctx, user.UserRolesUpdatedEvent{
+		Email: "testEmail",
+		Roles: []string{"testRole"},
+	})
+}
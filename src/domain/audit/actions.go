@@ -0,0 +1,26 @@
+package audit
+
+const CreateRoleAction = "CreateRole"
+const UpdateRoleAction = "UpdateRole"
+const DeleteRoleAction = "DeleteRole"
+const GrantRoleResourcePermissionAction = "GrantRoleResourcePermission"
+const CreatePermissionAction = "CreatePermission"
+const DeletePermissionAction = "DeletePermission"
+const CreateUserAction = "CreateUser"
+const SyncUserAction = "SyncUser"
+const DeleteUserAction = "DeleteUser"
+const UpdateUserPermissionsAction = "UpdateUserPermissions"
+const UpdateUserRolesAction = "UpdateUserRoles"
+const UpdateUserDeniedPermissionsAction = "UpdateUserDeniedPermissions"
+const UpdateUserDisabledAction = "UpdateUserDisabled"
+const GrantUserPermissionAction = "GrantUserPermission"
+const RevokeUserPermissionAction = "RevokeUserPermission"
+const GrantUserRoleAction = "GrantUserRole"
+const RevokeUserRoleAction = "RevokeUserRole"
+const GrantUserResourcePermissionAction = "GrantUserResourcePermission"
+const ExpireUserPermissionAction = "ExpireUserPermission"
+const ExpireUserRoleAction = "ExpireUserRole"
+
+const RoleTargetType = "role"
+const PermissionTargetType = "permission"
+const UserTargetType = "user"
@@ -0,0 +1,44 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"go-as/src/domain/auth"
+	"time"
+)
+
+type AuditEntry struct {
+	ID         int64     `gorm:"column:id;primaryKey"`
+	Actor      string    `gorm:"column:actor"`
+	Action     string    `gorm:"column:action"`
+	TargetType string    `gorm:"column:target_type"`
+	Target     string    `gorm:"column:target"`
+	Before     string    `gorm:"column:before"`
+	After      string    `gorm:"column:after"`
+	TraceID    string    `gorm:"column:trace_id"`
+	CreatedAt  time.Time `gorm:"column:created_at"`
+}
+
+func (AuditEntry) TableName() string {
+	return "audit_log"
+}
+
+func NewAuditEntry(ctx context.Context, action string, targetType string, target string, before any, after any) (AuditEntry, error) {
+	serializedBefore, err := json.Marshal(before)
+	if err != nil {
+		return AuditEntry{}, err
+	}
+	serializedAfter, err := json.Marshal(after)
+	if err != nil {
+		return AuditEntry{}, err
+	}
+	return AuditEntry{
+		Actor:      auth.ActorFromContext(ctx),
+		Action:     action,
+		TargetType: targetType,
+		Target:     target,
+		Before:     string(serializedBefore),
+		After:      string(serializedAfter),
+		CreatedAt:  time.Now().UTC(),
+	}, nil
+}
@@ -0,0 +1,12 @@
+package audit
+
+import "time"
+
+type AuditFilter struct {
+	Actor      string
+	Action     string
+	TargetType string
+	Target     string
+	From       *time.Time
+	To         *time.Time
+}
@@ -0,0 +1,11 @@
+package audit
+
+import (
+	"context"
+	"go-as/src/domain/pagination"
+)
+
+type AuditRepository interface {
+	Save(ctx context.Context, entry AuditEntry) error
+	FindPage(ctx context.Context, filter AuditFilter, pageRequest pagination.PageRequest) (*pagination.Page[AuditEntry], error)
+}
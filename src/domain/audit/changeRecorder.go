@@ -0,0 +1,43 @@
+package audit
+
+import (
+	"context"
+	"go-as/src/domain/events"
+)
+
+type Change struct {
+	Action     string
+	TargetType string
+	Target     string
+	Before     any
+	After      any
+	Event      any
+}
+
+// ChangeRecorder is the single path every authorization mutation goes through,
+// it audits the change and publishes its event within the transaction of the given context
+type ChangeRecorder struct {
+	auditRepository AuditRepository
+	eventPublisher  events.EventPublisher
+}
+
+func (recorder *ChangeRecorder) Record(ctx context.Context, change Change) error {
+	entry, err := NewAuditEntry(ctx, change.Action, change.TargetType, change.Target, change.Before, change.After)
+	if err != nil {
+		return err
+	}
+	if err = recorder.auditRepository.Save(ctx, entry); err != nil {
+		return err
+	}
+	if change.Event == nil {
+		return nil
+	}
+	return recorder.eventPublisher.Publish(ctx, change.Event)
+}
+
+func NewChangeRecorder(auditRepository AuditRepository, eventPublisher events.EventPublisher) *ChangeRecorder {
+	return &ChangeRecorder{
+		auditRepository: auditRepository,
+		eventPublisher:  eventPublisher,
+	}
+}
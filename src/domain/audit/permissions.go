@@ -0,0 +1,3 @@
+package audit
+
+const ReadAuditPermission = "ReadAuditPermission"
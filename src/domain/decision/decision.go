@@ -0,0 +1,18 @@
+package decision
+
+import "time"
+
+type Decision struct {
+	ID          int64     `gorm:"column:id;primaryKey"`
+	Subject     string    `gorm:"column:subject"`
+	Permissions []string  `gorm:"column:permissions;serializer:json"`
+	Allowed     bool      `gorm:"column:allowed"`
+	Reason      string    `gorm:"column:reason"`
+	Source      string    `gorm:"column:source"`
+	TraceID     string    `gorm:"column:trace_id"`
+	DecidedAt   time.Time `gorm:"column:decided_at"`
+}
+
+func (Decision) TableName() string {
+	return "decision_log"
+}
@@ -0,0 +1,7 @@
+package decision
+
+import "context"
+
+type DecisionLog interface {
+	Record(ctx context.Context, decision Decision)
+}
@@ -0,0 +1,7 @@
+package decision
+
+import "context"
+
+type DecisionSink interface {
+	Write(ctx context.Context, decisions []Decision) error
+}
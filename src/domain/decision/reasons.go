@@ -0,0 +1,12 @@
+package decision
+
+// Reasons are fixed codes, so they never carry the subject or permission names the decision log redacts
+
+const GrantedReason = "granted"
+const SuperuserReason = "superuser"
+const UserNotFoundReason = "user_not_found"
+const UserDisabledReason = "user_disabled"
+const NotGrantedReason = "not_granted"
+const PermissionDeniedReason = "permission_denied"
+const AuthenticationRequiredReason = "authentication_required"
+const ErrorReason = "error"
@@ -0,0 +1,96 @@
+package events
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"go-as/src/domain/internals"
+	"time"
+)
+
+type IdempotencyKey func(envelope EventEnvelope, event any) string
+
+func EnvelopeIDIdempotencyKey(envelope EventEnvelope, _ any) string {
+	return envelope.ID
+}
+
+// FieldIdempotencyKey reads the key from the decoded event rather than the raw
+// payload, so older versions are keyed by their upcasted fields.
+func FieldIdempotencyKey(field string) IdempotencyKey {
+	return func(_ EventEnvelope, event any) string {
+		encoded, err := json.Marshal(event)
+		if err != nil {
+			return ""
+		}
+		var fields map[string]interface{}
+		if err := json.Unmarshal(encoded, &fields); err != nil {
+			return ""
+		}
+		value, found := fields[field]
+		if !found || value == nil {
+			return ""
+		}
+		return fmt.Sprint(value)
+	}
+}
+
+// contentIdempotencyKey identifies messages without a key by their content,
+// so redeliveries of them are still skipped.
+func contentIdempotencyKey(envelope EventEnvelope) string {
+	digest := sha256.New()
+	fmt.Fprintf(digest, "%d:%s:", envelope.Version, envelope.OccurredAt.UTC().Format(time.RFC3339Nano))
+	digest.Write(envelope.Payload)
+	return fmt.Sprintf("sha256-%s", hex.EncodeToString(digest.Sum(nil)))
+}
+
+type EventDeduplicator struct {
+	processedEventRepository ProcessedEventRepository
+	transactionManager       internals.TransactionManager
+	idempotencyKeys          map[string]IdempotencyKey
+	ttl                      time.Duration
+}
+
+// Deduplicate keys the event by its envelope id unless an idempotency key is
+// configured for its name.
+func (deduplicator *EventDeduplicator) Deduplicate(eventName string, handler EventHandler) EventHandler {
+	idempotencyKey, found := deduplicator.idempotencyKeys[eventName]
+	if !found {
+		idempotencyKey = EnvelopeIDIdempotencyKey
+	}
+	return func(ctx context.Context, envelope EventEnvelope, event any) error {
+		key := idempotencyKey(envelope, event)
+		if key == "" {
+			key = contentIdempotencyKey(envelope)
+		}
+		return deduplicator.transactionManager.Transaction(ctx, func(ctx context.Context) error {
+			now := time.Now().UTC()
+			firstDelivery, err := deduplicator.processedEventRepository.SaveIfAbsent(ctx, ProcessedEvent{
+				Key:         fmt.Sprintf("%s:%s", eventName, key),
+				ProcessedAt: now,
+				ExpiresAt:   now.Add(deduplicator.ttl),
+			})
+			if err != nil {
+				return err
+			}
+			if !firstDelivery {
+				return nil
+			}
+			return handler(ctx, envelope, event)
+		})
+	}
+}
+
+func (deduplicator *EventDeduplicator) PurgeExpired(ctx context.Context, instant time.Time) error {
+	return deduplicator.processedEventRepository.DeleteExpired(ctx, instant)
+}
+
+func NewEventDeduplicator(processedEventRepository ProcessedEventRepository, transactionManager internals.TransactionManager, idempotencyKeys map[string]IdempotencyKey, ttl time.Duration) *EventDeduplicator {
+	return &EventDeduplicator{
+		processedEventRepository: processedEventRepository,
+		transactionManager:       transactionManager,
+		idempotencyKeys:          idempotencyKeys,
+		ttl:                      ttl,
+	}
+}
@@ -0,0 +1,174 @@
+package events_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"go-as/mocks"
+	"go-as/src/domain/events"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type testCase struct {
+	ProcessedEventRepo *mocks.ProcessedEventRepository
+	TransactionManager *mocks.TransactionManager
+	Deduplicator       *events.EventDeduplicator
+}
+
+func setUp(t *testing.T, idempotencyKeys map[string]events.IdempotencyKey) testCase {
+	processedEventRepoMock := mocks.NewProcessedEventRepository(t)
+	transactionManagerMock := mocks.NewTransactionManager(t)
+	transactionManagerMock.On("Transaction", mock.Anything, mock.Anything).Return(func(ctx context.Context, operation func(context.Context) error) error {
+		return operation(ctx)
+	}).Maybe()
+	return testCase{
+		ProcessedEventRepo: processedEventRepoMock,
+		TransactionManager: transactionManagerMock,
+		Deduplicator:       events.NewEventDeduplicator(processedEventRepoMock, transactionManagerMock, idempotencyKeys, time.Hour),
+	}
+}
+
+func TestDeduplicateFirstDelivery(t *testing.T) {
+	testCase := setUp(t, nil)
+	testCase.ProcessedEventRepo.On("SaveIfAbsent", mock.Anything, mock.Anything).Return(true, nil)
+	handled := 0
+	handler := testCase.Deduplicator.Deduplicate("TestEvent", func(context.Context, events.EventEnvelope, any) error {
+		handled++
+		return nil
+	})
+	ctx := context.Background()
+
+	err := handler(ctx, events.EventEnvelope{ID: "testEnvelopeID"}, nil)
+
+	if err != nil {
+		t.Fatal("Expected handler not to return error")
+	}
+	if handled != 1 {
+		t.Fatal("Expected first delivery to be handled")
+	}
+	testCase.ProcessedEventRepo.AssertCalled(t, "SaveIfAbsent", ctx, mock.MatchedBy(func(event events.ProcessedEvent) bool {
+		return event.Key == "TestEvent:testEnvelopeID" && event.ExpiresAt.Sub(event.ProcessedAt) == time.Hour
+	}))
+}
+
+func TestDeduplicateSkipsDuplicate(t *testing.T) {
+	testCase := setUp(t, nil)
+	testCase.ProcessedEventRepo.On("SaveIfAbsent", mock.Anything, mock.Anything).Return(false, nil)
+	handled := 0
+	handler := testCase.Deduplicator.Deduplicate("TestEvent", func(context.Context, events.EventEnvelope, any) error {
+		handled++
+		return nil
+	})
+	ctx := context.Background()
+
+	err := handler(ctx, events.EventEnvelope{ID: "testEnvelopeID"}, nil)
+
+	if err != nil {
+		t.Fatal("Expected handler not to return error")
+	}
+	if handled != 0 {
+		t.Fatal("Expected duplicate delivery not to be handled")
+	}
+}
+
+func TestDeduplicateWithoutKeyUsesContent(t *testing.T) {
+	testCase := setUp(t, nil)
+	testCase.ProcessedEventRepo.On("SaveIfAbsent", mock.Anything, mock.Anything).Return(true, nil).Once()
+	testCase.ProcessedEventRepo.On("SaveIfAbsent", mock.Anything, mock.Anything).Return(false, nil).Once()
+	handled := 0
+	handler := testCase.Deduplicator.Deduplicate("TestEvent", func(context.Context, events.EventEnvelope, any) error {
+		handled++
+		return nil
+	})
+	ctx := context.Background()
+	envelope := events.EventEnvelope{Version: 1, Payload: json.RawMessage(`{"ID":"testEventID"}`)}
+
+	firstErr := handler(ctx, envelope, nil)
+	secondErr := handler(ctx, envelope, nil)
+
+	if firstErr != nil || secondErr != nil {
+		t.Fatal("Expected handler not to return error")
+	}
+	if handled != 1 {
+		t.Fatal("Expected redelivered event without idempotency key to be handled once")
+	}
+	testCase.ProcessedEventRepo.AssertCalled(t, "SaveIfAbsent", ctx, mock.MatchedBy(func(event events.ProcessedEvent) bool {
+		return strings.HasPrefix(event.Key, "TestEvent:sha256-")
+	}))
+}
+
+func TestDeduplicateByField(t *testing.T) {
+	testCase := setUp(t, map[string]events.IdempotencyKey{"TestEvent": events.FieldIdempotencyKey("ID")})
+	testCase.ProcessedEventRepo.On("SaveIfAbsent", mock.Anything, mock.Anything).Return(true, nil)
+	handler := testCase.Deduplicator.Deduplicate("TestEvent", func(context.Context, events.EventEnvelope, any) error {
+		return nil
+	})
+	ctx := context.Background()
+
+	err := handler(ctx, events.EventEnvelope{ID: "testEnvelopeID", Payload: json.RawMessage(`{"ID":"testEventID"}`)}, &TestEvent{ID: "testEventID"})
+
+	if err != nil {
+		t.Fatal("Expected handler not to return error")
+	}
+	testCase.ProcessedEventRepo.AssertCalled(t, "SaveIfAbsent", ctx, mock.MatchedBy(func(event events.ProcessedEvent) bool {
+		return event.Key == "TestEvent:testEventID"
+	}))
+}
+
+func TestDeduplicateByFieldOnlyForConfiguredEvent(t *testing.T) {
+	testCase := setUp(t, map[string]events.IdempotencyKey{"OtherEvent": events.FieldIdempotencyKey("ID")})
+	testCase.ProcessedEventRepo.On("SaveIfAbsent", mock.Anything, mock.Anything).Return(true, nil)
+	handler := testCase.Deduplicator.Deduplicate("TestEvent", func(context.Context, events.EventEnvelope, any) error {
+		return nil
+	})
+	ctx := context.Background()
+
+	err := handler(ctx, events.EventEnvelope{ID: "testEnvelopeID", Payload: json.RawMessage(`{"ID":"testEventID"}`)}, &TestEvent{ID: "testEventID"})
+
+	if err != nil {
+		t.Fatal("Expected handler not to return error")
+	}
+	testCase.ProcessedEventRepo.AssertCalled(t, "SaveIfAbsent", ctx, mock.MatchedBy(func(event events.ProcessedEvent) bool {
+		return event.Key == "TestEvent:testEnvelopeID"
+	}))
+}
+
+func TestDeduplicateByUpcastedField(t *testing.T) {
+	testCase := setUp(t, map[string]events.IdempotencyKey{"TestEvent": events.FieldIdempotencyKey("PreviousID")})
+	testCase.ProcessedEventRepo.On("SaveIfAbsent", mock.Anything, mock.Anything).Return(true, nil)
+	handler := testCase.Deduplicator.Deduplicate("TestEvent", func(context.Context, events.EventEnvelope, any) error {
+		return nil
+	})
+	ctx := context.Background()
+	envelope := events.EventEnvelope{Name: "TestEvent", Version: 1, Payload: json.RawMessage(`{"ID":"testEventID"}`)}
+	event, _ := setUpRegistry().Decode(envelope)
+
+	err := handler(ctx, envelope, event)
+
+	if err != nil {
+		t.Fatal("Expected handler not to return error")
+	}
+	testCase.ProcessedEventRepo.AssertCalled(t, "SaveIfAbsent", ctx, mock.MatchedBy(func(event events.ProcessedEvent) bool {
+		return event.Key == "TestEvent:testEventID"
+	}))
+}
+
+func TestDeduplicateHandlerErrorIsReturned(t *testing.T) {
+	testCase := setUp(t, nil)
+	testCase.ProcessedEventRepo.On("SaveIfAbsent", mock.Anything, mock.Anything).Return(true, nil)
+	handlerError := errors.New("Test handler error")
+	handler := testCase.Deduplicator.Deduplicate("TestEvent", func(context.Context, events.EventEnvelope, any) error {
+		return handlerError
+	})
+	ctx := context.Background()
+
+	err := handler(ctx, events.EventEnvelope{ID: "testEnvelopeID"}, nil)
+
+	if err != handlerError {
+		t.Fatal("Error expected to be the same as the handler returned error so the processed key is rolled back")
+	}
+}
@@ -0,0 +1,14 @@
+package events
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type EventEnvelope struct {
+	ID         string          `json:"id"`
+	Name       string          `json:"name"`
+	Version    int             `json:"version"`
+	OccurredAt time.Time       `json:"occurredAt"`
+	Payload    json.RawMessage `json:"payload"`
+}
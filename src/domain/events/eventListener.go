@@ -1,5 +1,35 @@
 package events
 
+import (
+	"context"
+	"fmt"
+)
+
+type EventHandler func(ctx context.Context, envelope EventEnvelope, event any) error
+
+// PartitionedEvent events with the same key are handled one at a time in delivery order, but only
+// among events of the same queue, and a retried event can be overtaken by later ones. Consumers that
+// need the order in which events occurred across queues and retries apply them through EventOrdering.
+type PartitionedEvent interface {
+	PartitionKey() string
+}
+
 type EventListener interface {
-	Listen(eventChannel chan map[string]interface{}) error
+	Listen(handler EventHandler) error
+}
+
+func HandleTyped[T any](handler func(ctx context.Context, event T) error) EventHandler {
+	return HandleTypedEnvelope(func(ctx context.Context, _ EventEnvelope, event T) error {
+		return handler(ctx, event)
+	})
+}
+
+func HandleTypedEnvelope[T any](handler func(ctx context.Context, envelope EventEnvelope, event T) error) EventHandler {
+	return func(ctx context.Context, envelope EventEnvelope, event any) error {
+		typedEvent, ok := event.(T)
+		if !ok {
+			return fmt.Errorf("unexpected event %s of type %T", envelope.Name, event)
+		}
+		return handler(ctx, envelope, typedEvent)
+	}
 }
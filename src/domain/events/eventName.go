@@ -0,0 +1,15 @@
+package events
+
+import "reflect"
+
+func EventName(event any) string {
+	eventType := reflect.TypeOf(event)
+	if eventType.Kind() == reflect.Ptr {
+		eventType = eventType.Elem()
+	}
+	eventTypeName := eventType.Name()
+	if eventTypeName == "string" {
+		return event.(string)
+	}
+	return eventTypeName
+}
@@ -0,0 +1,69 @@
+package events
+
+import (
+	"context"
+	"go-as/src/domain/internals"
+	"sort"
+	"time"
+)
+
+// EventOrdering applies events in the order they occurred, whatever queue or retry they arrive
+// through, by skipping events that are not newer than the latest one applied for the same key.
+type EventOrdering struct {
+	positionRepository EventPositionRepository
+	transactionManager internals.TransactionManager
+}
+
+// Apply runs apply while the positions of keys are locked, unless the event is stale for any of them,
+// and advances them to occurredAt once apply succeeds. It reports whether the event was applied.
+func (ordering *EventOrdering) Apply(ctx context.Context, occurredAt time.Time, keys []string, apply func(ctx context.Context, positions map[string]*EventPosition) error) (bool, error) {
+	applied := false
+	err := ordering.transactionManager.Transaction(ctx, func(ctx context.Context) error {
+		positions, err := ordering.lockPositions(ctx, keys)
+		if err != nil {
+			return err
+		}
+		for _, position := range positions {
+			if position.IsStale(occurredAt) {
+				return nil
+			}
+		}
+		if err = apply(ctx, positions); err != nil {
+			return err
+		}
+		for _, position := range positions {
+			position.Advance(occurredAt)
+			if err = ordering.positionRepository.Save(ctx, *position); err != nil {
+				return err
+			}
+		}
+		applied = true
+		return nil
+	})
+	return applied, err
+}
+
+// lockPositions locks in key order, so events locking the same keys cannot deadlock
+func (ordering *EventOrdering) lockPositions(ctx context.Context, keys []string) (map[string]*EventPosition, error) {
+	sortedKeys := append([]string{}, keys...)
+	sort.Strings(sortedKeys)
+	positions := make(map[string]*EventPosition, len(sortedKeys))
+	for _, key := range sortedKeys {
+		if _, locked := positions[key]; locked {
+			continue
+		}
+		position, err := ordering.positionRepository.Lock(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		positions[key] = position
+	}
+	return positions, nil
+}
+
+func NewEventOrdering(positionRepository EventPositionRepository, transactionManager internals.TransactionManager) *EventOrdering {
+	return &EventOrdering{
+		positionRepository: positionRepository,
+		transactionManager: transactionManager,
+	}
+}
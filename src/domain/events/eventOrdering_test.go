@@ -0,0 +1,83 @@
+package events_test
+
+import (
+	"context"
+	"errors"
+	"go-as/mocks"
+	"go-as/src/domain/events"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+func setUpOrdering(t *testing.T, positions map[string]time.Time) (*mocks.EventPositionRepository, *events.EventOrdering) {
+	positionRepositoryMock := mocks.NewEventPositionRepository(t)
+	positionRepositoryMock.On("Lock", mock.Anything, mock.Anything).Return(func(_ context.Context, key string) *events.EventPosition {
+		return &events.EventPosition{Key: key, OccurredAt: positions[key]}
+	}, nil).Maybe()
+	positionRepositoryMock.On("Save", mock.Anything, mock.Anything).Return(nil).Maybe()
+	transactionManagerMock := mocks.NewTransactionManager(t)
+	transactionManagerMock.On("Transaction", mock.Anything, mock.Anything).Return(func(ctx context.Context, operation func(context.Context) error) error {
+		return operation(ctx)
+	}).Maybe()
+	return positionRepositoryMock, events.NewEventOrdering(positionRepositoryMock, transactionManagerMock)
+}
+
+func TestApplyNewerEventAdvancesPositions(t *testing.T) {
+	positionRepository, ordering := setUpOrdering(t, map[string]time.Time{"a": time.Unix(100, 0)})
+	ctx := context.Background()
+
+	applied, err := ordering.Apply(ctx, time.Unix(200, 0), []string{"a", "b"}, func(context.Context, map[string]*events.EventPosition) error {
+		return nil
+	})
+
+	if err != nil || !applied {
+		t.Fatal("Expected newer event to be applied")
+	}
+	positionRepository.AssertCalled(t, "Save", ctx, events.EventPosition{Key: "a", OccurredAt: time.Unix(200, 0)})
+	positionRepository.AssertCalled(t, "Save", ctx, events.EventPosition{Key: "b", OccurredAt: time.Unix(200, 0)})
+}
+
+func TestApplySkipsEventStaleForAnyKey(t *testing.T) {
+	positionRepository, ordering := setUpOrdering(t, map[string]time.Time{"b": time.Unix(200, 0)})
+	handled := false
+
+	applied, err := ordering.Apply(context.Background(), time.Unix(200, 0), []string{"a", "b"}, func(context.Context, map[string]*events.EventPosition) error {
+		handled = true
+		return nil
+	})
+
+	if err != nil || applied || handled {
+		t.Fatal("Expected event not newer than the applied ones to be skipped")
+	}
+	positionRepository.AssertNotCalled(t, "Save")
+}
+
+func TestApplyEventWithoutOccurrenceTime(t *testing.T) {
+	positionRepository, ordering := setUpOrdering(t, map[string]time.Time{"a": time.Unix(100, 0)})
+	ctx := context.Background()
+
+	applied, err := ordering.Apply(ctx, time.Time{}, []string{"a"}, func(context.Context, map[string]*events.EventPosition) error {
+		return nil
+	})
+
+	if err != nil || !applied {
+		t.Fatal("Expected event without occurrence time to be applied")
+	}
+	positionRepository.AssertCalled(t, "Save", ctx, events.EventPosition{Key: "a", OccurredAt: time.Unix(100, 0)})
+}
+
+func TestApplyErrorKeepsPositions(t *testing.T) {
+	positionRepository, ordering := setUpOrdering(t, map[string]time.Time{})
+	applyError := errors.New("Test apply error")
+
+	applied, err := ordering.Apply(context.Background(), time.Unix(200, 0), []string{"a"}, func(context.Context, map[string]*events.EventPosition) error {
+		return applyError
+	})
+
+	if err != applyError || applied {
+		t.Fatal("Error expected to be the same as the apply returned error")
+	}
+	positionRepository.AssertNotCalled(t, "Save")
+}
@@ -0,0 +1,27 @@
+package events
+
+import "time"
+
+// EventPosition is the occurrence time of the latest event applied for a partition key.
+// Deleted is a tombstone telling keys whose entity was deleted apart from ones not created yet.
+type EventPosition struct {
+	Key        string    `gorm:"column:key;primaryKey"`
+	OccurredAt time.Time `gorm:"column:occurred_at"`
+	Deleted    bool      `gorm:"column:deleted"`
+}
+
+func (EventPosition) TableName() string {
+	return "event_position"
+}
+
+// IsStale reports whether an event that occurred at occurredAt is not newer than the applied ones.
+// Events without an occurrence time cannot be ordered, so they are never stale.
+func (position *EventPosition) IsStale(occurredAt time.Time) bool {
+	return !occurredAt.IsZero() && !occurredAt.After(position.OccurredAt)
+}
+
+func (position *EventPosition) Advance(occurredAt time.Time) {
+	if occurredAt.After(position.OccurredAt) {
+		position.OccurredAt = occurredAt
+	}
+}
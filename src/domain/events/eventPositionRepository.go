@@ -0,0 +1,9 @@
+package events
+
+import "context"
+
+type EventPositionRepository interface {
+	// Lock returns the position of the key, starting at the zero time, and holds it until the transaction ends
+	Lock(ctx context.Context, key string) (*EventPosition, error)
+	Save(ctx context.Context, position EventPosition) error
+}
@@ -0,0 +1,7 @@
+package events
+
+import "context"
+
+type EventPublisher interface {
+	Publish(ctx context.Context, event any) error
+}
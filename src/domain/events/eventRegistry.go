@@ -0,0 +1,84 @@
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+type EventUpcaster func(payload json.RawMessage) (json.RawMessage, error)
+
+type EventValidator interface {
+	Validate(event interface{}) error
+}
+
+type registeredEvent struct {
+	eventType reflect.Type
+	version   int
+	upcasters map[int]EventUpcaster
+}
+
+type EventRegistry struct {
+	events    map[string]*registeredEvent
+	validator EventValidator
+}
+
+func (registry *EventRegistry) Register(event any, version int) {
+	eventType := reflect.TypeOf(event)
+	if eventType.Kind() == reflect.Ptr {
+		eventType = eventType.Elem()
+	}
+	registry.events[EventName(event)] = &registeredEvent{
+		eventType: eventType,
+		version:   version,
+		upcasters: make(map[int]EventUpcaster),
+	}
+}
+
+func (registry *EventRegistry) RegisterUpcaster(event any, fromVersion int, upcaster EventUpcaster) error {
+	name := EventName(event)
+	registered, found := registry.events[name]
+	if !found {
+		return fmt.Errorf("event %s must be registered before its upcasters", name)
+	}
+	registered.upcasters[fromVersion] = upcaster
+	return nil
+}
+
+func (registry *EventRegistry) Decode(envelope EventEnvelope) (any, error) {
+	registered, found := registry.events[envelope.Name]
+	if !found {
+		return nil, UnknownEventError{Name: envelope.Name}
+	}
+	if envelope.Version > registered.version {
+		return nil, UnsupportedEventVersionError{Name: envelope.Name, Version: envelope.Version}
+	}
+
+	payload := envelope.Payload
+	for version := envelope.Version; version < registered.version; version++ {
+		upcaster, found := registered.upcasters[version]
+		if !found {
+			return nil, UnsupportedEventVersionError{Name: envelope.Name, Version: envelope.Version}
+		}
+		var err error
+		if payload, err = upcaster(payload); err != nil {
+			return nil, err
+		}
+	}
+
+	event := reflect.New(registered.eventType).Interface()
+	if err := json.Unmarshal(payload, event); err != nil {
+		return nil, fmt.Errorf("malformed payload for %s: %w", envelope.Name, err)
+	}
+	if err := registry.validator.Validate(event); err != nil {
+		return nil, fmt.Errorf("invalid payload for %s: %w", envelope.Name, err)
+	}
+	return event, nil
+}
+
+func NewEventRegistry(validator EventValidator) *EventRegistry {
+	return &EventRegistry{
+		events:    make(map[string]*registeredEvent),
+		validator: validator,
+	}
+}
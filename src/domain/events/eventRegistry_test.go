@@ -0,0 +1,141 @@
+package events_test
+
+import (
+	"encoding/json"
+	"errors"
+	"go-as/src/domain/events"
+	"testing"
+)
+
+type TestEvent struct {
+	PreviousID string
+	ID         string
+}
+
+type testEventValidator struct{}
+
+func (testEventValidator) Validate(event interface{}) error {
+	if event.(*TestEvent).ID == "" {
+		return errors.New("Test validation error")
+	}
+	return nil
+}
+
+func setUpRegistry() *events.EventRegistry {
+	eventRegistry := events.NewEventRegistry(testEventValidator{})
+	eventRegistry.Register(TestEvent{}, 2)
+	if err := eventRegistry.RegisterUpcaster(TestEvent{}, 1, func(payload json.RawMessage) (json.RawMessage, error) {
+		var event TestEvent
+		if err := json.Unmarshal(payload, &event); err != nil {
+			return nil, err
+		}
+		event.PreviousID = event.ID
+		return json.Marshal(event)
+	}); err != nil {
+		panic(err)
+	}
+	return eventRegistry
+}
+
+func TestDecode(t *testing.T) {
+	eventRegistry := setUpRegistry()
+
+	event, err := eventRegistry.Decode(events.EventEnvelope{
+		Name:    "TestEvent",
+		Version: 2,
+		Payload: json.RawMessage(`{"PreviousID":"testPreviousID","ID":"testID"}`),
+	})
+
+	if err != nil {
+		t.Fatal("Expected decode not to return error")
+	}
+	testEvent, ok := event.(*TestEvent)
+	if !ok || testEvent.PreviousID != "testPreviousID" || testEvent.ID != "testID" {
+		t.Fatal("Expected payload to be decoded into the registered event")
+	}
+}
+
+func TestDecodeUpcastsOldVersion(t *testing.T) {
+	eventRegistry := setUpRegistry()
+
+	event, err := eventRegistry.Decode(events.EventEnvelope{
+		Name:    "TestEvent",
+		Version: 1,
+		Payload: json.RawMessage(`{"ID":"testID"}`),
+	})
+
+	if err != nil {
+		t.Fatal("Expected decode not to return error")
+	}
+	if event.(*TestEvent).PreviousID != "testID" {
+		t.Fatal("Expected old version to be upcasted to the registered version")
+	}
+}
+
+func TestDecodeUnknownEvent(t *testing.T) {
+	eventRegistry := setUpRegistry()
+
+	_, err := eventRegistry.Decode(events.EventEnvelope{
+		Name:    "OtherEvent",
+		Version: 1,
+		Payload: json.RawMessage(`{"ID":"testID"}`),
+	})
+
+	if _, ok := err.(events.UnknownEventError); !ok {
+		t.Fatal("Expected unknown event error")
+	}
+}
+
+func TestDecodeNewerVersion(t *testing.T) {
+	eventRegistry := setUpRegistry()
+
+	_, err := eventRegistry.Decode(events.EventEnvelope{
+		Name:    "TestEvent",
+		Version: 3,
+		Payload: json.RawMessage(`{"ID":"testID"}`),
+	})
+
+	if _, ok := err.(events.UnsupportedEventVersionError); !ok {
+		t.Fatal("Expected unsupported event version error")
+	}
+}
+
+func TestDecodeMalformedPayload(t *testing.T) {
+	eventRegistry := setUpRegistry()
+
+	_, err := eventRegistry.Decode(events.EventEnvelope{
+		Name:    "TestEvent",
+		Version: 2,
+		Payload: json.RawMessage(`{"ID":1}`),
+	})
+
+	if err == nil {
+		t.Fatal("Expected malformed payload to return error")
+	}
+}
+
+func TestDecodeInvalidPayload(t *testing.T) {
+	eventRegistry := setUpRegistry()
+
+	_, err := eventRegistry.Decode(events.EventEnvelope{
+		Name:    "TestEvent",
+		Version: 2,
+		Payload: json.RawMessage(`{"PreviousID":"testPreviousID"}`),
+	})
+
+	if err == nil {
+		t.Fatal("Expected invalid payload to return error")
+	}
+}
+
+func TestRegisterUpcasterBeforeEvent(t *testing.T) {
+	eventRegistry := events.NewEventRegistry(testEventValidator{})
+
+	err := eventRegistry.RegisterUpcaster(TestEvent{}, 1, func(payload json.RawMessage) (json.RawMessage, error) {
+		return payload, nil
+	})
+
+	if err == nil {
+		t.Fatal("Expected upcaster of an unregistered event to return error")
+	}
+}
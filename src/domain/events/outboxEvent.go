@@ -0,0 +1,38 @@
+package events
+
+import "time"
+
+type OutboxEvent struct {
+	ID            int64      `gorm:"column:id;primaryKey"`
+	EventName     string     `gorm:"column:event_name"`
+	Payload       string     `gorm:"column:payload"`
+	CreatedAt     time.Time  `gorm:"column:created_at"`
+	Attempts      int        `gorm:"column:attempts"`
+	NextAttemptAt time.Time  `gorm:"column:next_attempt_at"`
+	LastError     string     `gorm:"column:last_error"`
+	SentAt        *time.Time `gorm:"column:sent_at"`
+	FailedAt      *time.Time `gorm:"column:failed_at"`
+}
+
+func (OutboxEvent) TableName() string {
+	return "outbox"
+}
+
+// RecordFailure schedules the next attempt with an exponential backoff,
+// or marks the event as failed for good once maxAttempts is reached
+func (event *OutboxEvent) RecordFailure(instant time.Time, err error, baseDelay time.Duration, maxDelay time.Duration, maxAttempts int) {
+	event.Attempts++
+	event.LastError = err.Error()
+	if event.Attempts >= maxAttempts {
+		event.FailedAt = &instant
+		return
+	}
+	delay := baseDelay
+	for attempt := 1; attempt < event.Attempts && delay < maxDelay; attempt++ {
+		delay *= 2
+	}
+	if delay > maxDelay {
+		delay = maxDelay
+	}
+	event.NextAttemptAt = instant.Add(delay)
+}
@@ -0,0 +1,31 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+type OutboxEventPublisher struct {
+	outboxRepository OutboxRepository
+}
+
+func (publisher *OutboxEventPublisher) Publish(ctx context.Context, event any) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	return publisher.outboxRepository.Save(ctx, OutboxEvent{
+		EventName:     EventName(event),
+		Payload:       string(payload),
+		CreatedAt:     now,
+		NextAttemptAt: now,
+	})
+}
+
+func NewOutboxEventPublisher(outboxRepository OutboxRepository) *OutboxEventPublisher {
+	return &OutboxEventPublisher{
+		outboxRepository: outboxRepository,
+	}
+}
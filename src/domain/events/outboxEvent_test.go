@@ -0,0 +1,47 @@
+package events_test
+
+import (
+	"errors"
+	"go-as/src/domain/events"
+	"testing"
+	"time"
+)
+
+func TestRecordFailureSchedulesRetry(t *testing.T) {
+	instant := time.Now().UTC()
+	event := events.OutboxEvent{Attempts: 1}
+
+	event.RecordFailure(instant, errors.New("testError"), time.Second, time.Minute, 5)
+
+	if event.Attempts != 2 || event.LastError != "testError" {
+		t.Fatal("Expected failure to be recorded")
+	}
+	if !event.NextAttemptAt.Equal(instant.Add(2 * time.Second)) {
+		t.Fatal("Expected next attempt to be delayed exponentially")
+	}
+	if event.FailedAt != nil {
+		t.Fatal("Expected event not to be failed before reaching max attempts")
+	}
+}
+
+func TestRecordFailureCapsRetryDelay(t *testing.T) {
+	instant := time.Now().UTC()
+	event := events.OutboxEvent{Attempts: 10}
+
+	event.RecordFailure(instant, errors.New("testError"), time.Second, time.Minute, 20)
+
+	if !event.NextAttemptAt.Equal(instant.Add(time.Minute)) {
+		t.Fatal("Expected next attempt delay to be capped")
+	}
+}
+
+func TestRecordFailureFailsAfterMaxAttempts(t *testing.T) {
+	instant := time.Now().UTC()
+	event := events.OutboxEvent{Attempts: 4}
+
+	event.RecordFailure(instant, errors.New("testError"), time.Second, time.Minute, 5)
+
+	if event.FailedAt == nil || !event.FailedAt.Equal(instant) {
+		t.Fatal("Expected event to be failed after max attempts")
+	}
+}
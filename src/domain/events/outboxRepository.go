@@ -0,0 +1,14 @@
+package events
+
+import (
+	"context"
+	"time"
+)
+
+type OutboxRepository interface {
+	Save(ctx context.Context, event OutboxEvent) error
+	ClaimPending(ctx context.Context, instant time.Time, leaseUntil time.Time, limit int) ([]OutboxEvent, error)
+	MarkSent(ctx context.Context, id int64, sentAt time.Time) error
+	MarkFailed(ctx context.Context, event OutboxEvent) error
+	DeleteSentBefore(ctx context.Context, instant time.Time) (int64, error)
+}
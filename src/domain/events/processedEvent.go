@@ -0,0 +1,13 @@
+package events
+
+import "time"
+
+type ProcessedEvent struct {
+	Key         string    `gorm:"column:key;primaryKey"`
+	ProcessedAt time.Time `gorm:"column:processed_at"`
+	ExpiresAt   time.Time `gorm:"column:expires_at"`
+}
+
+func (ProcessedEvent) TableName() string {
+	return "processed_event"
+}
@@ -0,0 +1,11 @@
+package events
+
+import (
+	"context"
+	"time"
+)
+
+type ProcessedEventRepository interface {
+	SaveIfAbsent(ctx context.Context, event ProcessedEvent) (bool, error)
+	DeleteExpired(ctx context.Context, instant time.Time) error
+}
@@ -0,0 +1,59 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"go-as/src/domain/internals"
+	"time"
+)
+
+type ProcessedEventSweeper struct {
+	eventDeduplicator *EventDeduplicator
+	interval          time.Duration
+	ctx               context.Context
+	cancel            context.CancelFunc
+	done              chan struct{}
+	logger            internals.Logger
+}
+
+func (sweeper *ProcessedEventSweeper) Run() {
+	go sweeper.sweepPeriodically()
+}
+
+func (sweeper *ProcessedEventSweeper) Stop(ctx context.Context) error {
+	sweeper.cancel()
+	select {
+	case <-sweeper.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (sweeper *ProcessedEventSweeper) sweepPeriodically() {
+	defer close(sweeper.done)
+	ticker := time.NewTicker(sweeper.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-sweeper.ctx.Done():
+			return
+		case instant := <-ticker.C:
+			if err := sweeper.eventDeduplicator.PurgeExpired(sweeper.ctx, instant.UTC()); err != nil {
+				sweeper.logger.Warn(sweeper.ctx, fmt.Sprintf("Error purging expired processed events: %s", err.Error()))
+			}
+		}
+	}
+}
+
+func NewProcessedEventSweeper(eventDeduplicator *EventDeduplicator, interval time.Duration, logger internals.Logger) *ProcessedEventSweeper {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &ProcessedEventSweeper{
+		eventDeduplicator: eventDeduplicator,
+		interval:          interval,
+		ctx:               ctx,
+		cancel:            cancel,
+		done:              make(chan struct{}),
+		logger:            logger,
+	}
+}
@@ -0,0 +1,42 @@
+package events_test
+
+import (
+	"context"
+	"go-as/src/domain/events"
+	"go-as/src/infrastructure/logging"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"go.elastic.co/apm/v2"
+)
+
+func TestProcessedEventSweeperStopsAfterRunningSweeps(t *testing.T) {
+	testCase := setUp(t, nil)
+	sweeps := make(chan struct{}, 10)
+	testCase.ProcessedEventRepo.On("DeleteExpired", mock.Anything, mock.Anything).Return(nil).Run(func(mock.Arguments) {
+		sweeps <- struct{}{}
+	})
+	sweeper := events.NewProcessedEventSweeper(testCase.Deduplicator, time.Millisecond, logging.NewZapTracedLogger(apm.DefaultTracer()))
+
+	sweeper.Run()
+	select {
+	case <-sweeps:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Expected sweeper to purge expired processed events periodically")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	err := sweeper.Stop(ctx)
+
+	if err != nil {
+		t.Fatal("Expected sweeper to stop before the timeout")
+	}
+	for len(sweeps) > 0 {
+		<-sweeps
+	}
+	time.Sleep(10 * time.Millisecond)
+	if len(sweeps) != 0 {
+		t.Fatal("Expected sweeper not to purge after being stopped")
+	}
+}
@@ -0,0 +1,11 @@
+package events
+
+import "fmt"
+
+type UnknownEventError struct {
+	Name string
+}
+
+func (err UnknownEventError) Error() string {
+	return fmt.Sprintf("Event %s is not registered", err.Name)
+}
@@ -0,0 +1,12 @@
+package events
+
+import "fmt"
+
+type UnsupportedEventVersionError struct {
+	Name    string
+	Version int
+}
+
+func (err UnsupportedEventVersionError) Error() string {
+	return fmt.Sprintf("Version %d of event %s is not supported", err.Version, err.Name)
+}
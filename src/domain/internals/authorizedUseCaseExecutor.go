@@ -4,17 +4,24 @@ import (
 	"context"
 	"errors"
 	"go-as/src/domain/auth"
+	"go-as/src/domain/decision"
 	"go-as/src/domain/user"
+	"reflect"
 )
 
+var errAuthenticationRequired = errors.New("authentication required")
+
 type AuthorizedUseCaseExecutor struct {
 	userRepository user.UserRepository
+	decisionLog    decision.DecisionLog
 }
 
 func (executor *AuthorizedUseCaseExecutor) Execute(ctx context.Context, useCase UseCase, useCaseRequest any, accessToken *auth.AccessToken) *UseCaseResponse {
 	requiredPermissions := useCase.RequiredPermissions()
 	if len(requiredPermissions) > 0 {
-		if err := executor.checkPermissions(ctx, useCase, accessToken, requiredPermissions); err != nil {
+		reason, err := executor.checkPermissions(ctx, useCase, accessToken, requiredPermissions)
+		executor.recordDecision(ctx, useCase, accessToken, requiredPermissions, reason, err)
+		if err != nil {
 			useCaseResponse := UseCaseResponse{
 				Err: err,
 			}
@@ -22,49 +29,103 @@ func (executor *AuthorizedUseCaseExecutor) Execute(ctx context.Context, useCase
 		}
 	}
 
+	if accessToken != nil {
+		ctx = auth.WithActor(ctx, accessToken.Sub)
+	}
 	useCaseResponse := useCase.Execute(ctx, useCaseRequest)
 	return &useCaseResponse
 }
 
-func (executor *AuthorizedUseCaseExecutor) checkPermissions(ctx context.Context, useCase UseCase, token *auth.AccessToken, permissions []string) error {
+func (executor *AuthorizedUseCaseExecutor) checkPermissions(ctx context.Context, useCase UseCase, token *auth.AccessToken, permissions []string) (string, error) {
 	if token == nil {
-		return errors.New("authentication required")
+		return "", errAuthenticationRequired
 	}
 
-	var user *user.User
+	var authenticatedUser *user.User
 	var err error
 	if token != nil {
-		user, err = executor.getUserFromAccessToken(ctx, token)
+		authenticatedUser, err = executor.getUserFromAccessToken(ctx, token)
 	}
 	if err != nil {
-		return err
+		return "", err
 	}
-	if user == nil {
-		return errors.New("authentication required")
+	if authenticatedUser == nil {
+		return "", errAuthenticationRequired
 	}
 
-	if user.Superuser {
-		return nil
+	if authenticatedUser.Disabled {
+		return "", user.UserDisabledError{Email: authenticatedUser.Email}
+	}
+	if authenticatedUser.Superuser {
+		return decision.SuperuserReason, nil
 	}
 
 	for _, permissionName := range permissions {
-		if !user.HasPermission(permissionName) {
-			return UseCaseAuthorizationError{
-				Email:      user.Email,
+		if denial := authenticatedUser.FindPermissionDenial(permissionName); denial != nil {
+			return "", UseCasePermissionDeniedError{
+				Email:      authenticatedUser.Email,
+				Permission: permissionName,
+				RoleName:   denial.RoleName,
+			}
+		}
+		if !authenticatedUser.HasPermission(permissionName) {
+			return "", UseCaseAuthorizationError{
+				Email:      authenticatedUser.Email,
 				Permission: permissionName,
 			}
 		}
 	}
 
-	return nil
+	return decision.GrantedReason, nil
+}
+
+func (executor *AuthorizedUseCaseExecutor) recordDecision(ctx context.Context, useCase UseCase, token *auth.AccessToken, permissions []string, reason string, err error) {
+	recordedDecision := decision.Decision{
+		Permissions: permissions,
+		Allowed:     err == nil,
+		Reason:      reason,
+		Source:      executor.getUseCaseName(useCase),
+	}
+	if token != nil {
+		recordedDecision.Subject = token.Sub
+	}
+	if err != nil {
+		recordedDecision.Reason = executor.getDenialReason(err)
+	}
+	executor.decisionLog.Record(ctx, recordedDecision)
+}
+
+// getDenialReason maps the error to a fixed reason, since error messages embed the email and permission names
+func (*AuthorizedUseCaseExecutor) getDenialReason(err error) string {
+	switch err.(type) {
+	case UseCasePermissionDeniedError:
+		return decision.PermissionDeniedReason
+	case UseCaseAuthorizationError:
+		return decision.NotGrantedReason
+	case user.UserDisabledError:
+		return decision.UserDisabledReason
+	}
+	if err == errAuthenticationRequired {
+		return decision.AuthenticationRequiredReason
+	}
+	return decision.ErrorReason
+}
+
+func (*AuthorizedUseCaseExecutor) getUseCaseName(useCase UseCase) string {
+	useCaseType := reflect.TypeOf(useCase)
+	if useCaseType.Kind() == reflect.Ptr {
+		useCaseType = useCaseType.Elem()
+	}
+	return useCaseType.Name()
 }
 
 func (executor *AuthorizedUseCaseExecutor) getUserFromAccessToken(ctx context.Context, token *auth.AccessToken) (*user.User, error) {
 	return executor.userRepository.FindByEmail(ctx, token.Sub)
 }
 
-func NewAuthorizedUseCaseExecutor(userRepository user.UserRepository) *AuthorizedUseCaseExecutor {
+func NewAuthorizedUseCaseExecutor(userRepository user.UserRepository, decisionLog decision.DecisionLog) *AuthorizedUseCaseExecutor {
 	return &AuthorizedUseCaseExecutor{
 		userRepository: userRepository,
+		decisionLog:    decisionLog,
 	}
 }
@@ -0,0 +1,66 @@
+package internals_test
+
+import (
+	"context"
+	"go-as/mocks"
+	"go-as/src/domain/auth"
+	"go-as/src/domain/decision"
+	"go-as/src/domain/internals"
+	"go-as/src/domain/permission"
+	"go-as/src/domain/user"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type testUseCase struct{}
+
+func (testUseCase) Execute(context.Context, any) internals.UseCaseResponse {
+	return internals.EmptyUseCaseResponse()
+}
+
+func (testUseCase) RequiredPermissions() []string {
+	return []string{"testPermission"}
+}
+
+func TestExecuteRecordsDenialReasonWithoutErrorDetails(t *testing.T) {
+	testCases := map[string]struct {
+		User   *user.User
+		Reason string
+	}{
+		"not granted": {
+			User:   &user.User{Email: "user@test.com"},
+			Reason: decision.NotGrantedReason,
+		},
+		"denied": {
+			User:   &user.User{Email: "user@test.com", Permissions: []permission.Permission{{Name: "testPermission"}}, DeniedPermissions: []permission.Permission{{Name: "testPermission"}}},
+			Reason: decision.PermissionDeniedReason,
+		},
+		"disabled": {
+			User:   &user.User{Email: "user@test.com", Disabled: true},
+			Reason: decision.UserDisabledReason,
+		},
+		"unknown": {
+			Reason: decision.AuthenticationRequiredReason,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			userRepositoryMock := mocks.NewUserRepository(t)
+			userRepositoryMock.On("FindByEmail", mock.Anything, "user@test.com").Return(testCase.User, nil)
+			decisionLogMock := mocks.NewDecisionLog(t)
+			decisionLogMock.On("Record", mock.Anything, mock.Anything).Return()
+			executor := internals.NewAuthorizedUseCaseExecutor(userRepositoryMock, decisionLogMock)
+
+			response := executor.Execute(context.Background(), testUseCase{}, nil, &auth.AccessToken{Sub: "user@test.com"})
+
+			if response.Err == nil {
+				t.Fatal("Expected use case to be refused")
+			}
+			decisionLogMock.AssertCalled(t, "Record", mock.Anything, mock.MatchedBy(func(recordedDecision decision.Decision) bool {
+				return recordedDecision.Reason == testCase.Reason && !strings.Contains(recordedDecision.Reason, "user@test.com")
+			}))
+		})
+	}
+}
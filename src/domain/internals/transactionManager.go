@@ -0,0 +1,7 @@
+package internals
+
+import "context"
+
+type TransactionManager interface {
+	Transaction(ctx context.Context, operation func(ctx context.Context) error) error
+}
@@ -0,0 +1,16 @@
+package internals
+
+import "fmt"
+
+type UseCasePermissionDeniedError struct {
+	Email      string
+	Permission string
+	RoleName   string
+}
+
+func (err UseCasePermissionDeniedError) Error() string {
+	if err.RoleName == "" {
+		return fmt.Sprintf("User %s has been explicitly denied %s", err.Email, err.Permission)
+	}
+	return fmt.Sprintf("User %s has been explicitly denied %s by role %s", err.Email, err.Permission, err.RoleName)
+}
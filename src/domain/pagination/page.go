@@ -0,0 +1,35 @@
+package pagination
+
+const DefaultPageSize = 20
+const MaxPageSize = 100
+
+type PageRequest struct {
+	Page int
+	Size int
+}
+
+func NewPageRequest(page int, size int) PageRequest {
+	if page < 1 {
+		page = 1
+	}
+	if size < 1 {
+		size = DefaultPageSize
+	}
+	if size > MaxPageSize {
+		size = MaxPageSize
+	}
+	return PageRequest{
+		Page: page,
+		Size: size,
+	}
+}
+
+func (pageRequest *PageRequest) Offset() int {
+	return (pageRequest.Page - 1) * pageRequest.Size
+}
+
+type Page[T any] struct {
+	Items []T
+	Total int64
+	PageRequest
+}
@@ -0,0 +1,76 @@
+package permission
+
+import "sort"
+
+func FindClosestPermissionNames(permissionName string, candidateNames []string, limit int) []string {
+	type scoredName struct {
+		name     string
+		distance int
+	}
+	var scoredNames []scoredName
+	for _, candidateName := range candidateNames {
+		if candidateName == permissionName {
+			continue
+		}
+		distance := levenshteinDistance(permissionName, candidateName)
+		if distance > maxMatchDistance(permissionName, candidateName) {
+			continue
+		}
+		scoredNames = append(scoredNames, scoredName{name: candidateName, distance: distance})
+	}
+	sort.SliceStable(scoredNames, func(i, j int) bool {
+		if scoredNames[i].distance == scoredNames[j].distance {
+			return scoredNames[i].name < scoredNames[j].name
+		}
+		return scoredNames[i].distance < scoredNames[j].distance
+	})
+
+	var closestNames []string
+	for _, scoredName := range scoredNames {
+		if len(closestNames) == limit {
+			break
+		}
+		closestNames = append(closestNames, scoredName.name)
+	}
+	return closestNames
+}
+
+func maxMatchDistance(firstName string, secondName string) int {
+	longestLength := len([]rune(firstName))
+	if secondLength := len([]rune(secondName)); secondLength > longestLength {
+		longestLength = secondLength
+	}
+	return longestLength / 3
+}
+
+func levenshteinDistance(firstName string, secondName string) int {
+	first := []rune(firstName)
+	second := []rune(secondName)
+	previousRow := make([]int, len(second)+1)
+	for j := range previousRow {
+		previousRow[j] = j
+	}
+	for i := 1; i <= len(first); i++ {
+		currentRow := make([]int, len(second)+1)
+		currentRow[0] = i
+		for j := 1; j <= len(second); j++ {
+			substitutionCost := 1
+			if first[i-1] == second[j-1] {
+				substitutionCost = 0
+			}
+			currentRow[j] = minInt(previousRow[j]+1, currentRow[j-1]+1, previousRow[j-1]+substitutionCost)
+		}
+		previousRow = currentRow
+	}
+	return previousRow[len(second)]
+}
+
+func minInt(first int, others ...int) int {
+	minimum := first
+	for _, other := range others {
+		if other < minimum {
+			minimum = other
+		}
+	}
+	return minimum
+}
@@ -1,5 +1,15 @@
 package permission
 
 type Permission struct {
-	Name string `gorm:"column:name;primaryKey"`
+	Name        string `gorm:"column:name;primaryKey"`
+	Description string `gorm:"column:description"`
+	Service     string `gorm:"column:service"`
+}
+
+func Names(permissions []Permission) []string {
+	names := make([]string, 0, len(permissions))
+	for _, permission := range permissions {
+		names = append(names, permission.Name)
+	}
+	return names
 }
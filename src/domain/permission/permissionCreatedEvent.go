@@ -0,0 +1,7 @@
+package permission
+
+type PermissionCreatedEvent struct {
+	Name        string
+	Description string
+	Service     string
+}
@@ -0,0 +1,5 @@
+package permission
+
+type PermissionDeletedEvent struct {
+	Name string
+}
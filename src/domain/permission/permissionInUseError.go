@@ -0,0 +1,12 @@
+package permission
+
+import "fmt"
+
+type PermissionInUseError struct {
+	PermissionName string
+	References     int64
+}
+
+func (err PermissionInUseError) Error() string {
+	return fmt.Sprintf("Permission %s is still referenced %d times by roles or users", err.PermissionName, err.References)
+}
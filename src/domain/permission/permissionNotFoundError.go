@@ -0,0 +1,11 @@
+package permission
+
+import "fmt"
+
+type PermissionNotFoundError struct {
+	PermissionName string
+}
+
+func (err PermissionNotFoundError) Error() string {
+	return fmt.Sprintf("Permission %s not found", err.PermissionName)
+}
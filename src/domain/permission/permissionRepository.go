@@ -1,8 +1,14 @@
 package permission
 
-import "context"
+import (
+	"context"
+	"go-as/src/domain/pagination"
+)
 
 type PermissionRepository interface {
 	Save(ctx context.Context, permission Permission) error
 	FindByNames(ctx context.Context, permissionNames []string) ([]Permission, error)
+	FindPageByPrefix(ctx context.Context, prefix string, pageRequest pagination.PageRequest) (*pagination.Page[Permission], error)
+	CountReferences(ctx context.Context, permissionName string) (int64, error)
+	Delete(ctx context.Context, permissionName string) error
 }
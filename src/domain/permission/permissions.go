@@ -1,3 +1,5 @@
 package permission
 
 const CreatePermissionPermission = "CreatePermissionPermission"
+const ReadPermissionPermission = "ReadPermissionPermission"
+const DeletePermissionPermission = "DeletePermissionPermission"
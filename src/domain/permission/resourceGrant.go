@@ -0,0 +1,32 @@
+package permission
+
+const AnyResourceID = "*"
+
+type Resource struct {
+	Type string
+	ID   string
+}
+
+type ResourceGrant struct {
+	PermissionName string `gorm:"column:permission_name;primaryKey"`
+	ResourceType   string `gorm:"column:resource_type;primaryKey"`
+	ResourceID     string `gorm:"column:resource_id;primaryKey"`
+}
+
+func (grant *ResourceGrant) Matches(permissionName string, resource Resource) bool {
+	if grant.PermissionName != permissionName || grant.ResourceType != resource.Type {
+		return false
+	}
+	return grant.ResourceID == AnyResourceID || grant.ResourceID == resource.ID
+}
+
+func NewResourceGrant(permissionName string, resourceType string, resourceID string) ResourceGrant {
+	if resourceID == "" {
+		resourceID = AnyResourceID
+	}
+	return ResourceGrant{
+		PermissionName: permissionName,
+		ResourceType:   resourceType,
+		ResourceID:     resourceID,
+	}
+}
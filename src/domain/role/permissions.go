@@ -3,3 +3,4 @@ package role
 const CreateRolePermission = "CreateRolePermission"
 const UpdateRolePermission = "UpdateRolePermission"
 const DeleteRolePermission = "DeleteRolePermission"
+const ReadRolePermission = "ReadRolePermission"
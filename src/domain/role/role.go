@@ -5,16 +5,69 @@ import (
 )
 
 type Role struct {
-	Name        string                  `gorm:"column:name;primaryKey"`
-	Permissions []permission.Permission `gorm:"many2many:role_permission"`
+	Name                string                   `gorm:"column:name;primaryKey"`
+	Permissions         []permission.Permission  `gorm:"many2many:role_permission"`
+	DeniedPermissions   []permission.Permission  `gorm:"many2many:role_denied_permission"`
+	Parents             []Role                   `gorm:"many2many:role_parent;joinForeignKey:RoleName;joinReferences:ParentName"`
+	ResourcePermissions []RoleResourcePermission `gorm:"foreignKey:RoleName"`
+	Version             int64                    `gorm:"column:version"`
+}
+
+func (role *Role) CheckVersion(expectedVersion *int64) error {
+	if expectedVersion != nil && *expectedVersion != role.Version {
+		return RoleVersionMismatchError{
+			RoleName: role.Name,
+			Version:  *expectedVersion,
+		}
+	}
+	return nil
 }
 
 func (role *Role) HasPermission(permission string) bool {
-	if role.Permissions == nil {
+	if _, denied := role.FindPermissionDenial(permission); denied {
 		return false
 	}
+	return role.hasPermissionInHierarchy(permission, make(map[string]bool))
+}
 
-	return role.hasPermissionInPermissions(permission)
+func (role *Role) FindPermissionDenial(permission string) (string, bool) {
+	return role.findPermissionDenialInHierarchy(permission, make(map[string]bool))
+}
+
+func (role *Role) findPermissionDenialInHierarchy(permission string, visitedRoles map[string]bool) (string, bool) {
+	if visitedRoles[role.Name] {
+		return "", false
+	}
+	visitedRoles[role.Name] = true
+
+	for _, deniedPermission := range role.DeniedPermissions {
+		if deniedPermission.Name == permission {
+			return role.Name, true
+		}
+	}
+	for _, parent := range role.Parents {
+		if denyingRoleName, denied := parent.findPermissionDenialInHierarchy(permission, visitedRoles); denied {
+			return denyingRoleName, true
+		}
+	}
+	return "", false
+}
+
+func (role *Role) hasPermissionInHierarchy(permission string, visitedRoles map[string]bool) bool {
+	if visitedRoles[role.Name] {
+		return false
+	}
+	visitedRoles[role.Name] = true
+
+	if role.hasPermissionInPermissions(permission) {
+		return true
+	}
+	for _, parent := range role.Parents {
+		if parent.hasPermissionInHierarchy(permission, visitedRoles) {
+			return true
+		}
+	}
+	return false
 }
 
 func (role *Role) hasPermissionInPermissions(permission string) bool {
@@ -25,3 +78,127 @@ func (role *Role) hasPermissionInPermissions(permission string) bool {
 	}
 	return false
 }
+
+func (role *Role) FindPermissionPath(permission string) []string {
+	return role.findPermissionPathInHierarchy(permission, make(map[string]bool))
+}
+
+func (role *Role) findPermissionPathInHierarchy(permission string, visitedRoles map[string]bool) []string {
+	if visitedRoles[role.Name] {
+		return nil
+	}
+	visitedRoles[role.Name] = true
+
+	if role.hasPermissionInPermissions(permission) {
+		return []string{role.Name}
+	}
+	for _, parent := range role.Parents {
+		if parentPath := parent.findPermissionPathInHierarchy(permission, visitedRoles); parentPath != nil {
+			return append([]string{role.Name}, parentPath...)
+		}
+	}
+	return nil
+}
+
+func (role *Role) PermissionNames() []string {
+	var permissionNames []string
+	role.WalkPermissions(func(permissionName string, _ []string) {
+		permissionNames = append(permissionNames, permissionName)
+	})
+	return permissionNames
+}
+
+func (role *Role) WalkPermissions(visit func(permissionName string, rolePath []string)) {
+	role.walkPermissionsInHierarchy(visit, nil, make(map[string]bool))
+}
+
+func (role *Role) walkPermissionsInHierarchy(visit func(permissionName string, rolePath []string), parentPath []string, visitedRoles map[string]bool) {
+	if visitedRoles[role.Name] {
+		return
+	}
+	visitedRoles[role.Name] = true
+
+	rolePath := append(append([]string{}, parentPath...), role.Name)
+	for _, rolePermission := range role.Permissions {
+		visit(rolePermission.Name, rolePath)
+	}
+	for _, parent := range role.Parents {
+		parent.walkPermissionsInHierarchy(visit, rolePath, visitedRoles)
+	}
+}
+
+func (role *Role) HasPermissionOnResource(permission string, resource permission.Resource) bool {
+	if _, denied := role.FindPermissionDenial(permission); denied {
+		return false
+	}
+	if role.hasPermissionOnResourceInHierarchy(permission, resource, make(map[string]bool)) {
+		return true
+	}
+	return role.HasPermission(permission)
+}
+
+func (role *Role) hasPermissionOnResourceInHierarchy(permission string, resource permission.Resource, visitedRoles map[string]bool) bool {
+	if visitedRoles[role.Name] {
+		return false
+	}
+	visitedRoles[role.Name] = true
+
+	for _, resourcePermission := range role.ResourcePermissions {
+		if resourcePermission.Matches(permission, resource) {
+			return true
+		}
+	}
+	for _, parent := range role.Parents {
+		if parent.hasPermissionOnResourceInHierarchy(permission, resource, visitedRoles) {
+			return true
+		}
+	}
+	return false
+}
+
+func (role *Role) HasResourceGrant(grant permission.ResourceGrant) bool {
+	for _, resourcePermission := range role.ResourcePermissions {
+		if resourcePermission.ResourceGrant == grant {
+			return true
+		}
+	}
+	return false
+}
+
+func (role *Role) HasAncestor(roleName string) bool {
+	return role.hasAncestorInHierarchy(roleName, make(map[string]bool))
+}
+
+func (role *Role) hasAncestorInHierarchy(roleName string, visitedRoles map[string]bool) bool {
+	if visitedRoles[role.Name] {
+		return false
+	}
+	visitedRoles[role.Name] = true
+
+	for _, parent := range role.Parents {
+		if parent.Name == roleName || parent.hasAncestorInHierarchy(roleName, visitedRoles) {
+			return true
+		}
+	}
+	return false
+}
+
+func (role *Role) CheckHierarchyCycles() error {
+	for _, parent := range role.Parents {
+		if parent.Name == role.Name || parent.HasAncestor(role.Name) {
+			return RoleHierarchyCycleError{
+				RoleName:   role.Name,
+				ParentName: parent.Name,
+			}
+		}
+	}
+	return nil
+}
+
+func Names(roles []Role) []string {
+	names := make([]string, 0, len(roles))
+	for _, role := range roles {
+		names = append(names, role.Name)
+	}
+	return names
+}
@@ -0,0 +1,11 @@
+package role
+
+import "fmt"
+
+type RoleAlreadyExistsError struct {
+	RoleName string
+}
+
+func (err RoleAlreadyExistsError) Error() string {
+	return fmt.Sprintf("Role %s already exists", err.RoleName)
+}
@@ -0,0 +1,8 @@
+package role
+
+type RoleCreatedEvent struct {
+	Name              string
+	Permissions       []string
+	DeniedPermissions []string
+	Parents           []string
+}
@@ -0,0 +1,5 @@
+package role
+
+type RoleDeletedEvent struct {
+	Name string
+}
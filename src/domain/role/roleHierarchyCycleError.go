@@ -0,0 +1,12 @@
+package role
+
+import "fmt"
+
+type RoleHierarchyCycleError struct {
+	RoleName   string
+	ParentName string
+}
+
+func (err RoleHierarchyCycleError) Error() string {
+	return fmt.Sprintf("Role %s can not inherit from %s because it would create a cycle", err.RoleName, err.ParentName)
+}
@@ -0,0 +1,11 @@
+package role
+
+import "fmt"
+
+type RoleNotFoundError struct {
+	RoleName string
+}
+
+func (err RoleNotFoundError) Error() string {
+	return fmt.Sprintf("Role %s not found", err.RoleName)
+}
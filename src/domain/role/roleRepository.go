@@ -2,9 +2,13 @@ package role
 
 import (
 	"context"
+	"go-as/src/domain/pagination"
 )
 
 type RoleRepository interface {
 	Save(ctx context.Context, role Role) error
 	FindByNames(ctx context.Context, roleNames []string) ([]Role, error)
+	FindPage(ctx context.Context, pageRequest pagination.PageRequest) (*pagination.Page[Role], error)
+	UpdatePermissions(ctx context.Context, role Role) error
+	Delete(ctx context.Context, roleName string) error
 }
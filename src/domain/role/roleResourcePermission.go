@@ -0,0 +1,12 @@
+package role
+
+import "go-as/src/domain/permission"
+
+type RoleResourcePermission struct {
+	RoleName string `gorm:"column:role_name;primaryKey"`
+	permission.ResourceGrant
+}
+
+func (RoleResourcePermission) TableName() string {
+	return "role_resource_permission"
+}
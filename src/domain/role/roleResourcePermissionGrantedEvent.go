@@ -0,0 +1,8 @@
+package role
+
+type RoleResourcePermissionGrantedEvent struct {
+	RoleName       string
+	PermissionName string
+	ResourceType   string
+	ResourceID     string
+}
@@ -0,0 +1,6 @@
+package role
+
+type RoleUpdatedEvent struct {
+	Name        string
+	Permissions []string
+}
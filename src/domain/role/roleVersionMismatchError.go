@@ -0,0 +1,12 @@
+package role
+
+import "fmt"
+
+type RoleVersionMismatchError struct {
+	RoleName string
+	Version  int64
+}
+
+func (err RoleVersionMismatchError) Error() string {
+	return fmt.Sprintf("Role %s has been modified since version %d", err.RoleName, err.Version)
+}
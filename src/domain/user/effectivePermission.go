@@ -0,0 +1,11 @@
+package user
+
+type PermissionSource struct {
+	Source   string
+	RolePath []string
+}
+
+type EffectivePermission struct {
+	PermissionName string
+	Sources        []PermissionSource
+}
@@ -0,0 +1,11 @@
+package user
+
+import (
+	"context"
+	"time"
+)
+
+type GrantRepository interface {
+	DeleteExpiredPermissionGrants(ctx context.Context, instant time.Time) ([]UserPermission, error)
+	DeleteExpiredRoleGrants(ctx context.Context, instant time.Time) ([]UserRole, error)
+}
@@ -0,0 +1,29 @@
+package user
+
+import "time"
+
+type GrantValidity struct {
+	ValidFrom  *time.Time `gorm:"column:valid_from"`
+	ValidUntil *time.Time `gorm:"column:valid_until"`
+}
+
+func (validity *GrantValidity) IsActiveAt(instant time.Time) bool {
+	if validity.ValidFrom != nil && instant.Before(*validity.ValidFrom) {
+		return false
+	}
+	return !validity.IsExpiredAt(instant)
+}
+
+func (validity *GrantValidity) IsExpiredAt(instant time.Time) bool {
+	return validity.ValidUntil != nil && !instant.Before(*validity.ValidUntil)
+}
+
+func (validity *GrantValidity) Validate() error {
+	if validity.ValidFrom != nil && validity.ValidUntil != nil && !validity.ValidFrom.Before(*validity.ValidUntil) {
+		return InvalidGrantValidityError{
+			ValidFrom:  *validity.ValidFrom,
+			ValidUntil: *validity.ValidUntil,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,15 @@
+package user
+
+import (
+	"fmt"
+	"time"
+)
+
+type InvalidGrantValidityError struct {
+	ValidFrom  time.Time
+	ValidUntil time.Time
+}
+
+func (err InvalidGrantValidityError) Error() string {
+	return fmt.Sprintf("Grant validity start %s must be before its end %s", err.ValidFrom.Format(time.RFC3339), err.ValidUntil.Format(time.RFC3339))
+}
@@ -0,0 +1,10 @@
+package user
+
+type PermissionDenial struct {
+	PermissionName string
+	RoleName       string
+}
+
+func (denial *PermissionDenial) IsUserLevel() bool {
+	return denial.RoleName == ""
+}
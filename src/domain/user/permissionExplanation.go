@@ -0,0 +1,18 @@
+package user
+
+const (
+	SuperuserPermissionSource = "superuser"
+	DirectPermissionSource    = "direct"
+	RolePermissionSource      = "role"
+)
+
+const maxClosestMatches = 3
+
+type PermissionExplanation struct {
+	PermissionName string
+	Granted        bool
+	Source         string
+	RolePath       []string
+	Denial         *PermissionDenial
+	ClosestMatches []string
+}
@@ -1,3 +1,7 @@
 package user
 
 const UpdateUserPermission = "UpdateUserPermission"
+const CheckOtherUserPermissionsPermission = "CheckOtherUserPermissionsPermission"
+const ReadUserPermission = "ReadUserPermission"
+const CreateUserPermission = "CreateUserPermission"
+const DeleteUserPermission = "DeleteUserPermission"
@@ -3,20 +3,30 @@ package user
 import (
 	"go-as/src/domain/permission"
 	"go-as/src/domain/role"
+	"time"
 )
 
 type User struct {
-	Email       string                  `gorm:"column:email;primaryKey"`
-	Roles       []role.Role             `gorm:"many2many:user_role"`
-	Superuser   bool                    `gorm:"column:superuser"`
-	Permissions []permission.Permission `gorm:"many2many:user_permission"`
+	Email               string                   `gorm:"column:email;primaryKey"`
+	Roles               []role.Role              `gorm:"many2many:user_role"`
+	Superuser           bool                     `gorm:"column:superuser"`
+	Disabled            bool                     `gorm:"column:disabled"`
+	Version             int64                    `gorm:"column:version"`
+	Permissions         []permission.Permission  `gorm:"many2many:user_permission"`
+	DeniedPermissions   []permission.Permission  `gorm:"many2many:user_denied_permission"`
+	ResourcePermissions []UserResourcePermission `gorm:"foreignKey:UserEmail"`
+	PermissionGrants    []UserPermission         `gorm:"foreignKey:UserEmail"`
+	RoleGrants          []UserRole               `gorm:"foreignKey:UserEmail"`
 }
 
 func (user *User) HasPermission(permission string) bool {
+	if user.Disabled {
+		return false
+	}
 	if user.Superuser {
 		return true
 	}
-	if user.Permissions == nil {
+	if user.FindPermissionDenial(permission) != nil {
 		return false
 	}
 
@@ -27,8 +37,108 @@ func (user *User) HasPermission(permission string) bool {
 	return hasPermission
 }
 
+func (user *User) CheckVersion(expectedVersion *int64) error {
+	if expectedVersion != nil && *expectedVersion != user.Version {
+		return UserVersionMismatchError{
+			Email:   user.Email,
+			Version: *expectedVersion,
+		}
+	}
+	return nil
+}
+
+func (user *User) FindPermissionDenial(permission string) *PermissionDenial {
+	for _, deniedPermission := range user.DeniedPermissions {
+		if deniedPermission.Name == permission {
+			return &PermissionDenial{
+				PermissionName: permission,
+			}
+		}
+	}
+	for _, role := range user.ActiveRoles() {
+		if denyingRoleName, denied := role.FindPermissionDenial(permission); denied {
+			return &PermissionDenial{
+				PermissionName: permission,
+				RoleName:       denyingRoleName,
+			}
+		}
+	}
+	return nil
+}
+
+func (user *User) ExplainPermission(permissionName string) PermissionExplanation {
+	explanation := PermissionExplanation{
+		PermissionName: permissionName,
+	}
+	if user.Disabled {
+		return explanation
+	}
+	if user.Superuser {
+		explanation.Granted = true
+		explanation.Source = SuperuserPermissionSource
+		return explanation
+	}
+	if denial := user.FindPermissionDenial(permissionName); denial != nil {
+		explanation.Denial = denial
+		return explanation
+	}
+	if user.hasPermissionInPermissions(permissionName) {
+		explanation.Granted = true
+		explanation.Source = DirectPermissionSource
+		return explanation
+	}
+	for _, role := range user.ActiveRoles() {
+		if rolePath := role.FindPermissionPath(permissionName); rolePath != nil {
+			explanation.Granted = true
+			explanation.Source = RolePermissionSource
+			explanation.RolePath = rolePath
+			return explanation
+		}
+	}
+	explanation.ClosestMatches = permission.FindClosestPermissionNames(permissionName, user.PermissionNames(), maxClosestMatches)
+	return explanation
+}
+
+func (user *User) PermissionNames() []string {
+	effectivePermissions := user.EffectivePermissions()
+	permissionNames := make([]string, 0, len(effectivePermissions))
+	for _, effectivePermission := range effectivePermissions {
+		permissionNames = append(permissionNames, effectivePermission.PermissionName)
+	}
+	return permissionNames
+}
+
+func (user *User) EffectivePermissions() []EffectivePermission {
+	if user.Disabled {
+		return nil
+	}
+	var effectivePermissions []EffectivePermission
+	permissionIndexes := make(map[string]int)
+	addPermissionSource := func(permissionName string, source PermissionSource) {
+		if user.FindPermissionDenial(permissionName) != nil {
+			return
+		}
+		index, found := permissionIndexes[permissionName]
+		if !found {
+			index = len(effectivePermissions)
+			permissionIndexes[permissionName] = index
+			effectivePermissions = append(effectivePermissions, EffectivePermission{PermissionName: permissionName})
+		}
+		effectivePermissions[index].Sources = append(effectivePermissions[index].Sources, source)
+	}
+	for _, userPermission := range user.ActivePermissions() {
+		addPermissionSource(userPermission.Name, PermissionSource{Source: DirectPermissionSource})
+	}
+	for _, role := range user.ActiveRoles() {
+		role.WalkPermissions(func(permissionName string, rolePath []string) {
+			addPermissionSource(permissionName, PermissionSource{Source: RolePermissionSource, RolePath: rolePath})
+		})
+	}
+	return effectivePermissions
+}
+
 func (user *User) hasPermissionInPermissions(permission string) bool {
-	for _, userPermission := range user.Permissions {
+	for _, userPermission := range user.ActivePermissions() {
 		if userPermission.Name == permission {
 			return true
 		}
@@ -37,10 +147,96 @@ func (user *User) hasPermissionInPermissions(permission string) bool {
 }
 
 func (user *User) hasPermissionInRoles(permission string) bool {
-	for _, role := range user.Roles {
+	for _, role := range user.ActiveRoles() {
 		if role.HasPermission(permission) {
 			return true
 		}
 	}
 	return false
 }
+
+func (user *User) HasPermissionOnResource(permission string, resource permission.Resource) bool {
+	if user.Disabled {
+		return false
+	}
+	if user.Superuser {
+		return true
+	}
+	if user.FindPermissionDenial(permission) != nil {
+		return false
+	}
+	for _, resourcePermission := range user.ResourcePermissions {
+		if resourcePermission.Matches(permission, resource) {
+			return true
+		}
+	}
+	for _, role := range user.ActiveRoles() {
+		if role.HasPermissionOnResource(permission, resource) {
+			return true
+		}
+	}
+	return user.HasPermission(permission)
+}
+
+func (user *User) HasResourceGrant(grant permission.ResourceGrant) bool {
+	for _, resourcePermission := range user.ResourcePermissions {
+		if resourcePermission.ResourceGrant == grant {
+			return true
+		}
+	}
+	return false
+}
+
+func (user *User) ActivePermissions() []permission.Permission {
+	now := time.Now()
+	var activePermissions []permission.Permission
+	for _, userPermission := range user.Permissions {
+		if validity := user.findPermissionGrantValidity(userPermission.Name); validity == nil || validity.IsActiveAt(now) {
+			activePermissions = append(activePermissions, userPermission)
+		}
+	}
+	return activePermissions
+}
+
+func (user *User) ActiveRoles() []role.Role {
+	now := time.Now()
+	var activeRoles []role.Role
+	for _, userRole := range user.Roles {
+		if validity := user.findRoleGrantValidity(userRole.Name); validity == nil || validity.IsActiveAt(now) {
+			activeRoles = append(activeRoles, userRole)
+		}
+	}
+	return activeRoles
+}
+
+func (user *User) FindPermissionGrant(permissionName string) *UserPermission {
+	for i := range user.PermissionGrants {
+		if user.PermissionGrants[i].PermissionName == permissionName {
+			return &user.PermissionGrants[i]
+		}
+	}
+	return nil
+}
+
+func (user *User) FindRoleGrant(roleName string) *UserRole {
+	for i := range user.RoleGrants {
+		if user.RoleGrants[i].RoleName == roleName {
+			return &user.RoleGrants[i]
+		}
+	}
+	return nil
+}
+
+func (user *User) findPermissionGrantValidity(permissionName string) *GrantValidity {
+	if grant := user.FindPermissionGrant(permissionName); grant != nil {
+		return &grant.GrantValidity
+	}
+	return nil
+}
+
+func (user *User) findRoleGrantValidity(roleName string) *GrantValidity {
+	if grant := user.FindRoleGrant(roleName); grant != nil {
+		return &grant.GrantValidity
+	}
+	return nil
+}
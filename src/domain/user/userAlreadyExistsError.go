@@ -0,0 +1,11 @@
+package user
+
+import "fmt"
+
+type UserAlreadyExistsError struct {
+	Email string
+}
+
+func (err UserAlreadyExistsError) Error() string {
+	return fmt.Sprintf("User %s already exists", err.Email)
+}
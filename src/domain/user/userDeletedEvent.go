@@ -0,0 +1,9 @@
+package user
+
+type UserDeletedEvent struct {
+	Email string `validate:"required"`
+}
+
+func (event *UserDeletedEvent) PartitionKey() string {
+	return event.Email
+}
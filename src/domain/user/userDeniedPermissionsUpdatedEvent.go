@@ -0,0 +1,6 @@
+package user
+
+type UserDeniedPermissionsUpdatedEvent struct {
+	Email             string
+	DeniedPermissions []string
+}
@@ -0,0 +1,7 @@
+package user
+
+// UserDeprovisionedEvent is published by this service when a user and all its grants are deleted,
+// unlike UserDeletedEvent which is consumed from IAM
+type UserDeprovisionedEvent struct {
+	Email string
+}
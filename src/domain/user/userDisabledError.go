@@ -0,0 +1,11 @@
+package user
+
+import "fmt"
+
+type UserDisabledError struct {
+	Email string
+}
+
+func (err UserDisabledError) Error() string {
+	return fmt.Sprintf("User %s is disabled", err.Email)
+}
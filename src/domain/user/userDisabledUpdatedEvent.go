@@ -0,0 +1,6 @@
+package user
+
+type UserDisabledUpdatedEvent struct {
+	Email    string
+	Disabled bool
+}
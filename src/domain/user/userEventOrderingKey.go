@@ -0,0 +1,8 @@
+package user
+
+import "fmt"
+
+// EventOrderingKey is the key IAM events about the user with email are ordered by
+func EventOrderingKey(email string) string {
+	return fmt.Sprintf("user:%s", email)
+}
@@ -0,0 +1,12 @@
+package user
+
+type UserFilter struct {
+	RoleName       string
+	PermissionName string
+}
+
+// MatchesPermission applies the same effective permission rules as permission checks,
+// so denials, grant validity windows, disabled users and superusers are honoured
+func (filter UserFilter) MatchesPermission(user *User) bool {
+	return filter.PermissionName == "" || user.HasPermission(filter.PermissionName)
+}
@@ -0,0 +1,68 @@
+package user
+
+import (
+	"go-as/src/domain/permission"
+	"testing"
+	"time"
+)
+
+func TestMatchesPermissionWithoutPermissionFilter(t *testing.T) {
+	filter := UserFilter{}
+
+	if !filter.MatchesPermission(&User{Email: "test@test.com"}) {
+		t.Fatal("Expected user to match an empty permission filter")
+	}
+}
+
+func TestMatchesPermissionSuperuser(t *testing.T) {
+	filter := UserFilter{PermissionName: "testPermission"}
+
+	if !filter.MatchesPermission(&User{Email: "test@test.com", Superuser: true}) {
+		t.Fatal("Expected superuser to match the permission filter")
+	}
+}
+
+func TestMatchesPermissionDenied(t *testing.T) {
+	filter := UserFilter{PermissionName: "testPermission"}
+	testPermission := permission.Permission{Name: "testPermission"}
+	deniedUser := User{
+		Email:             "test@test.com",
+		Permissions:       []permission.Permission{testPermission},
+		DeniedPermissions: []permission.Permission{testPermission},
+	}
+
+	if filter.MatchesPermission(&deniedUser) {
+		t.Fatal("Expected user with a denied permission not to match the permission filter")
+	}
+}
+
+func TestMatchesPermissionExpiredGrant(t *testing.T) {
+	filter := UserFilter{PermissionName: "testPermission"}
+	validUntil := time.Now().Add(-time.Hour)
+	expiredUser := User{
+		Email:       "test@test.com",
+		Permissions: []permission.Permission{{Name: "testPermission"}},
+		PermissionGrants: []UserPermission{{
+			UserEmail:      "test@test.com",
+			PermissionName: "testPermission",
+			GrantValidity:  GrantValidity{ValidUntil: &validUntil},
+		}},
+	}
+
+	if filter.MatchesPermission(&expiredUser) {
+		t.Fatal("Expected user with an expired grant not to match the permission filter")
+	}
+}
+
+func TestMatchesPermissionDisabled(t *testing.T) {
+	filter := UserFilter{PermissionName: "testPermission"}
+	disabledUser := User{
+		Email:       "test@test.com",
+		Disabled:    true,
+		Permissions: []permission.Permission{{Name: "testPermission"}},
+	}
+
+	if filter.MatchesPermission(&disabledUser) {
+		t.Fatal("Expected disabled user not to match the permission filter")
+	}
+}
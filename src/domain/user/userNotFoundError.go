@@ -0,0 +1,11 @@
+package user
+
+import "fmt"
+
+type UserNotFoundError struct {
+	Email string
+}
+
+func (err UserNotFoundError) Error() string {
+	return fmt.Sprintf("User %s not found", err.Email)
+}
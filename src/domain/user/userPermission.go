@@ -0,0 +1,11 @@
+package user
+
+type UserPermission struct {
+	UserEmail      string `gorm:"column:user_email;primaryKey"`
+	PermissionName string `gorm:"column:permission_name;primaryKey"`
+	GrantValidity
+}
+
+func (UserPermission) TableName() string {
+	return "user_permission"
+}
@@ -0,0 +1,9 @@
+package user
+
+import "time"
+
+type UserPermissionGrantExpiredEvent struct {
+	Email          string
+	PermissionName string
+	ExpiredAt      time.Time
+}
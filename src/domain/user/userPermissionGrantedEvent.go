@@ -0,0 +1,10 @@
+package user
+
+import "time"
+
+type UserPermissionGrantedEvent struct {
+	Email          string
+	PermissionName string
+	ValidFrom      *time.Time
+	ValidUntil     *time.Time
+}
@@ -0,0 +1,6 @@
+package user
+
+type UserPermissionRevokedEvent struct {
+	Email          string
+	PermissionName string
+}
@@ -0,0 +1,6 @@
+package user
+
+type UserPermissionsUpdatedEvent struct {
+	Email       string
+	Permissions []string
+}
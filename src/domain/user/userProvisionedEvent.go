@@ -0,0 +1,8 @@
+package user
+
+// UserProvisionedEvent is published by this service when a user is created,
+// unlike UserCreatedEvent which is consumed from IAM
+type UserProvisionedEvent struct {
+	Email     string
+	Superuser bool
+}
@@ -2,9 +2,18 @@ package user
 
 import (
 	"context"
+	"go-as/src/domain/pagination"
 )
 
 type UserRepository interface {
 	Save(ctx context.Context, user User) error
 	FindByEmail(ctx context.Context, email string) (*User, error)
+	FindByEmails(ctx context.Context, emails []string) ([]User, error)
+	FindPage(ctx context.Context, filter UserFilter, pageRequest pagination.PageRequest) (*pagination.Page[User], error)
+	Delete(ctx context.Context, email string) error
+	ChangeEmail(ctx context.Context, email string, newEmail string) error
+	SavePermissionGrant(ctx context.Context, grant UserPermission, version int64) error
+	DeletePermissionGrant(ctx context.Context, email string, permissionName string, version int64) error
+	SaveRoleGrant(ctx context.Context, grant UserRole, version int64) error
+	DeleteRoleGrant(ctx context.Context, email string, roleName string, version int64) error
 }
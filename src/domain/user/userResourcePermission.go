@@ -0,0 +1,12 @@
+package user
+
+import "go-as/src/domain/permission"
+
+type UserResourcePermission struct {
+	UserEmail string `gorm:"column:user_email;primaryKey"`
+	permission.ResourceGrant
+}
+
+func (UserResourcePermission) TableName() string {
+	return "user_resource_permission"
+}
@@ -0,0 +1,8 @@
+package user
+
+type UserResourcePermissionGrantedEvent struct {
+	Email          string
+	PermissionName string
+	ResourceType   string
+	ResourceID     string
+}
@@ -0,0 +1,11 @@
+package user
+
+type UserRole struct {
+	UserEmail string `gorm:"column:user_email;primaryKey"`
+	RoleName  string `gorm:"column:role_name;primaryKey"`
+	GrantValidity
+}
+
+func (UserRole) TableName() string {
+	return "user_role"
+}
@@ -0,0 +1,9 @@
+package user
+
+import "time"
+
+type UserRoleGrantExpiredEvent struct {
+	Email     string
+	RoleName  string
+	ExpiredAt time.Time
+}
@@ -0,0 +1,10 @@
+package user
+
+import "time"
+
+type UserRoleGrantedEvent struct {
+	Email      string
+	RoleName   string
+	ValidFrom  *time.Time
+	ValidUntil *time.Time
+}
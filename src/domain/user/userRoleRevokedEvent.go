@@ -0,0 +1,6 @@
+package user
+
+type UserRoleRevokedEvent struct {
+	Email    string
+	RoleName string
+}
@@ -0,0 +1,6 @@
+package user
+
+type UserRolesUpdatedEvent struct {
+	Email string
+	Roles []string
+}
@@ -0,0 +1,8 @@
+package user
+
+// UserSyncedEvent is published by this service once a UserUpdatedEvent from IAM changed a user
+type UserSyncedEvent struct {
+	PreviousEmail string
+	Email         string
+	Superuser     bool
+}
@@ -0,0 +1,26 @@
+package user
+
+import "encoding/json"
+
+const UserUpdatedEventVersion = 2
+
+type UserUpdatedEvent struct {
+	PreviousEmail string `validate:"required"`
+	Email         string `validate:"required"`
+	Superuser     bool
+}
+
+func (event *UserUpdatedEvent) PartitionKey() string {
+	return event.PreviousEmail
+}
+
+func UpcastUserUpdatedEventV1(payload json.RawMessage) (json.RawMessage, error) {
+	var event UserUpdatedEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, err
+	}
+	if event.PreviousEmail == "" {
+		event.PreviousEmail = event.Email
+	}
+	return json.Marshal(event)
+}
@@ -0,0 +1,12 @@
+package user
+
+import "fmt"
+
+type UserVersionMismatchError struct {
+	Email   string
+	Version int64
+}
+
+func (err UserVersionMismatchError) Error() string {
+	return fmt.Sprintf("User %s has been modified since version %d", err.Email, err.Version)
+}
@@ -0,0 +1,61 @@
+package controllers
+
+import (
+	"go-as/src/application/batchCheckUserPermissions"
+	"go-as/src/domain/internals"
+	"go-as/src/infrastructure/api"
+	"go-as/src/infrastructure/dto"
+	"go-as/src/infrastructure/transformers"
+
+	"github.com/labstack/echo/v4"
+)
+
+type BatchCheckPermissionsController struct {
+	batchCheckUserPermissionsUseCase *batchCheckUserPermissions.BatchCheckUserPermissionsUseCase
+	useCaseExecutor                  *internals.AuthorizedUseCaseExecutor
+	accessTokenFinder                *api.HTTPAccessTokenFinder
+	dtoDeserializer                  *dto.EchoDTODeserializer
+	dtoSerializer                    *dto.EchoDTOSerializer
+	errorTransformer                 *transformers.ErrorToEchoErrorTransformer
+	requestTransformer               *transformers.CheckPermissionsRequestTransformer
+}
+
+func (controller *BatchCheckPermissionsController) Handle(c echo.Context) error {
+	request := c.Request()
+	accessToken, err := controller.accessTokenFinder.Find(request)
+	if err != nil {
+		return controller.errorTransformer.Transform(err)
+	}
+
+	var batchCheckRequestDTO dto.BatchCheckUserPermissionsRequestDTO
+	if err := controller.dtoDeserializer.Deserialize(c, &batchCheckRequestDTO); err != nil {
+		return controller.errorTransformer.Transform(err)
+	}
+	batchCheckRequest := batchCheckUserPermissions.BatchCheckUserPermissionsRequest{
+		UserEmails:      batchCheckRequestDTO.Subjects,
+		PermissionNames: batchCheckRequestDTO.Permissions,
+		Resource:        controller.requestTransformer.TransformResource(batchCheckRequestDTO.Resource),
+	}
+	ctx := c.Request().Context()
+	useCaseResponse := controller.useCaseExecutor.Execute(ctx, controller.batchCheckUserPermissionsUseCase, &batchCheckRequest, accessToken)
+	if useCaseResponse.Err != nil {
+		return controller.errorTransformer.Transform(useCaseResponse.Err)
+	}
+	batchCheckResponse := useCaseResponse.Content.(*batchCheckUserPermissions.BatchCheckUserPermissionsResponse)
+	return controller.dtoSerializer.Serialize(c, dto.BatchCheckUserPermissionsResponseDTO{
+		Decisions:       batchCheckResponse.Decisions,
+		MissingSubjects: batchCheckResponse.MissingUsers,
+	})
+}
+
+func NewBatchCheckPermissionsController(batchCheckUserPermissionsUseCase *batchCheckUserPermissions.BatchCheckUserPermissionsUseCase, useCaseExecutor *internals.AuthorizedUseCaseExecutor, accessTokenFinder *api.HTTPAccessTokenFinder, dtoDeserializer *dto.EchoDTODeserializer, dtoSerializer *dto.EchoDTOSerializer, errorTransformer *transformers.ErrorToEchoErrorTransformer, requestTransformer *transformers.CheckPermissionsRequestTransformer) *BatchCheckPermissionsController {
+	return &BatchCheckPermissionsController{
+		batchCheckUserPermissionsUseCase: batchCheckUserPermissionsUseCase,
+		useCaseExecutor:                  useCaseExecutor,
+		accessTokenFinder:                accessTokenFinder,
+		dtoDeserializer:                  dtoDeserializer,
+		dtoSerializer:                    dtoSerializer,
+		errorTransformer:                 errorTransformer,
+		requestTransformer:               requestTransformer,
+	}
+}
@@ -0,0 +1,58 @@
+package controllers
+
+import (
+	"go-as/src/application/checkOtherUserHasPermissions"
+	"go-as/src/application/checkUserHasPermissions"
+	"go-as/src/domain/internals"
+	"go-as/src/infrastructure/api"
+	"go-as/src/infrastructure/dto"
+	"go-as/src/infrastructure/transformers"
+
+	"github.com/labstack/echo/v4"
+)
+
+type CheckOtherUserPermissionsController struct {
+	checkOtherUserPermissionsUseCase *checkOtherUserHasPermissions.CheckOtherUserHasPermissionUseCase
+	useCaseExecutor                  *internals.AuthorizedUseCaseExecutor
+	accessTokenFinder                *api.HTTPAccessTokenFinder
+	dtoDeserializer                  *dto.EchoDTODeserializer
+	dtoSerializer                    *dto.EchoDTOSerializer
+	errorTransformer                 *transformers.ErrorToEchoErrorTransformer
+	requestTransformer               *transformers.CheckPermissionsRequestTransformer
+	responseTransformer              *transformers.CheckPermissionsResponseTransformer
+}
+
+func (controller *CheckOtherUserPermissionsController) Handle(c echo.Context) error {
+	userEmail := c.Param("email")
+	request := c.Request()
+	accessToken, err := controller.accessTokenFinder.Find(request)
+	if err != nil {
+		return controller.errorTransformer.Transform(err)
+	}
+
+	var checkPermissionsRequestDTO dto.CheckUserPermissionsRequestDTO
+	if err := controller.dtoDeserializer.Deserialize(c, &checkPermissionsRequestDTO); err != nil {
+		return controller.errorTransformer.Transform(err)
+	}
+	checkPermissionsRequest := controller.requestTransformer.Transform(userEmail, &checkPermissionsRequestDTO)
+	ctx := c.Request().Context()
+	useCaseResponse := controller.useCaseExecutor.Execute(ctx, controller.checkOtherUserPermissionsUseCase, checkPermissionsRequest, accessToken)
+	if useCaseResponse.Err != nil {
+		return controller.errorTransformer.Transform(useCaseResponse.Err)
+	}
+	checkResponse := useCaseResponse.Content.(*checkUserHasPermissions.CheckUserHasPermissionResponse)
+	return controller.dtoSerializer.Serialize(c, controller.responseTransformer.Transform(checkResponse))
+}
+
+func NewCheckOtherUserPermissionsController(checkOtherUserPermissionsUseCase *checkOtherUserHasPermissions.CheckOtherUserHasPermissionUseCase, useCaseExecutor *internals.AuthorizedUseCaseExecutor, accessTokenFinder *api.HTTPAccessTokenFinder, dtoDeserializer *dto.EchoDTODeserializer, dtoSerializer *dto.EchoDTOSerializer, errorTransformer *transformers.ErrorToEchoErrorTransformer, requestTransformer *transformers.CheckPermissionsRequestTransformer, responseTransformer *transformers.CheckPermissionsResponseTransformer) *CheckOtherUserPermissionsController {
+	return &CheckOtherUserPermissionsController{
+		checkOtherUserPermissionsUseCase: checkOtherUserPermissionsUseCase,
+		useCaseExecutor:                  useCaseExecutor,
+		accessTokenFinder:                accessTokenFinder,
+		dtoDeserializer:                  dtoDeserializer,
+		dtoSerializer:                    dtoSerializer,
+		errorTransformer:                 errorTransformer,
+		requestTransformer:               requestTransformer,
+		responseTransformer:              responseTransformer,
+	}
+}
@@ -17,6 +17,8 @@ type CheckPermissionsController struct {
 	dtoDeserializer             *dto.EchoDTODeserializer
 	dtoSerializer               *dto.EchoDTOSerializer
 	errorTransformer            *transformers.ErrorToEchoErrorTransformer
+	requestTransformer          *transformers.CheckPermissionsRequestTransformer
+	responseTransformer         *transformers.CheckPermissionsResponseTransformer
 }
 
 func (controller *CheckPermissionsController) Handle(c echo.Context) error {
@@ -33,22 +35,17 @@ func (controller *CheckPermissionsController) Handle(c echo.Context) error {
 	if err := controller.dtoDeserializer.Deserialize(c, &checkPermissionsRequestDTO); err != nil {
 		return controller.errorTransformer.Transform(err)
 	}
-	checkPermissionsRequest := checkUserHasPermissions.CheckUserHasPermissionRequest{
-		UserEmail:       accessToken.Sub,
-		PermissionNames: checkPermissionsRequestDTO.Permissions,
-	}
+	checkPermissionsRequest := controller.requestTransformer.Transform(accessToken.Sub, &checkPermissionsRequestDTO)
 	ctx := c.Request().Context()
-	useCaseResponse := controller.useCaseExecutor.Execute(ctx, controller.checkUserPermissionsUseCase, &checkPermissionsRequest, accessToken)
+	useCaseResponse := controller.useCaseExecutor.Execute(ctx, controller.checkUserPermissionsUseCase, checkPermissionsRequest, accessToken)
 	if useCaseResponse.Err != nil {
 		return controller.errorTransformer.Transform(useCaseResponse.Err)
 	}
-	checkResponse := dto.CheckUserPermissionsResponseDTO{
-		Result: useCaseResponse.Content.(bool),
-	}
-	return controller.dtoSerializer.Serialize(c, checkResponse)
+	checkResponse := useCaseResponse.Content.(*checkUserHasPermissions.CheckUserHasPermissionResponse)
+	return controller.dtoSerializer.Serialize(c, controller.responseTransformer.Transform(checkResponse))
 }
 
-func NewCheckPermissionsController(checkUserPermissionsUseCase *checkUserHasPermissions.CheckUserHasPermissionUseCase, useCaseExecutor *internals.AuthorizedUseCaseExecutor, accessTokenFinder *api.HTTPAccessTokenFinder, dtoDeserializer *dto.EchoDTODeserializer, dtoSerializer *dto.EchoDTOSerializer, errorTransformer *transformers.ErrorToEchoErrorTransformer) *CheckPermissionsController {
+func NewCheckPermissionsController(checkUserPermissionsUseCase *checkUserHasPermissions.CheckUserHasPermissionUseCase, useCaseExecutor *internals.AuthorizedUseCaseExecutor, accessTokenFinder *api.HTTPAccessTokenFinder, dtoDeserializer *dto.EchoDTODeserializer, dtoSerializer *dto.EchoDTOSerializer, errorTransformer *transformers.ErrorToEchoErrorTransformer, requestTransformer *transformers.CheckPermissionsRequestTransformer, responseTransformer *transformers.CheckPermissionsResponseTransformer) *CheckPermissionsController {
 	return &CheckPermissionsController{
 		checkUserPermissionsUseCase: checkUserPermissionsUseCase,
 		useCaseExecutor:             useCaseExecutor,
@@ -56,5 +53,7 @@ func NewCheckPermissionsController(checkUserPermissionsUseCase *checkUserHasPerm
 		dtoDeserializer:             dtoDeserializer,
 		dtoSerializer:               dtoSerializer,
 		errorTransformer:            errorTransformer,
+		requestTransformer:          requestTransformer,
+		responseTransformer:         responseTransformer,
 	}
 }
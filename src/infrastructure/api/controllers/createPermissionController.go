@@ -34,7 +34,9 @@ func (controller *CreatePermissionController) Handle(c echo.Context) error {
 		return controller.errorTransformer.Transform(err)
 	}
 	createPermissionRequest := createPermission.CreatePermissionRequest{
-		Name: creationRequestDTO.Name,
+		Name:        creationRequestDTO.Name,
+		Description: creationRequestDTO.Description,
+		Service:     creationRequestDTO.Service,
 	}
 	ctx := c.Request().Context()
 	useCaseResponse := controller.useCaseExecutor.Execute(ctx, controller.createPermissionUseCase, &createPermissionRequest, accessToken)
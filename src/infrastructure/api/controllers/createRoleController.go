@@ -35,8 +35,10 @@ func (controller *CreateRoleController) Handle(c echo.Context) error {
 	}
 	ctx := c.Request().Context()
 	createRoleRequest := createRole.CreateRoleRequest{
-		Name:        creationRequestDTO.Name,
-		Permissions: creationRequestDTO.Permissions,
+		Name:              creationRequestDTO.Name,
+		Permissions:       creationRequestDTO.Permissions,
+		DeniedPermissions: creationRequestDTO.DeniedPermissions,
+		ParentNames:       creationRequestDTO.Parents,
 	}
 	useCaseResponse := controller.useCaseExecutor.Execute(ctx, controller.createRoleUseCase, &createRoleRequest, accessToken)
 	if useCaseResponse.Err != nil {
@@ -0,0 +1,54 @@
+package controllers
+
+import (
+	"go-as/src/application/deletePermission"
+	"go-as/src/domain/internals"
+	"go-as/src/infrastructure/api"
+	"go-as/src/infrastructure/dto"
+	"go-as/src/infrastructure/transformers"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type DeletePermissionController struct {
+	deletePermissionUseCase *deletePermission.DeletePermissionUseCase
+	useCaseExecutor         *internals.AuthorizedUseCaseExecutor
+	accessTokenFinder       *api.HTTPAccessTokenFinder
+	dtoDeserializer         *dto.EchoDTODeserializer
+	errorTransformer        *transformers.ErrorToEchoErrorTransformer
+}
+
+func (controller *DeletePermissionController) Handle(c echo.Context) error {
+	permissionName := c.Param("name")
+	request := c.Request()
+	accessToken, err := controller.accessTokenFinder.Find(request)
+	if err != nil {
+		return controller.errorTransformer.Transform(err)
+	}
+
+	var queryDTO dto.DeletePermissionQueryDTO
+	if err := controller.dtoDeserializer.Deserialize(c, &queryDTO); err != nil {
+		return controller.errorTransformer.Transform(err)
+	}
+	deletePermissionRequest := deletePermission.DeletePermissionRequest{
+		Name:  permissionName,
+		Force: queryDTO.Force,
+	}
+	ctx := c.Request().Context()
+	useCaseResponse := controller.useCaseExecutor.Execute(ctx, controller.deletePermissionUseCase, &deletePermissionRequest, accessToken)
+	if useCaseResponse.Err != nil {
+		return controller.errorTransformer.Transform(useCaseResponse.Err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func NewDeletePermissionController(useCase *deletePermission.DeletePermissionUseCase, useCaseExecutor *internals.AuthorizedUseCaseExecutor, accessTokenFinder *api.HTTPAccessTokenFinder, dtoDeserializer *dto.EchoDTODeserializer, errorTransformer *transformers.ErrorToEchoErrorTransformer) *DeletePermissionController {
+	return &DeletePermissionController{
+		deletePermissionUseCase: useCase,
+		useCaseExecutor:         useCaseExecutor,
+		accessTokenFinder:       accessTokenFinder,
+		dtoDeserializer:         dtoDeserializer,
+		errorTransformer:        errorTransformer,
+	}
+}
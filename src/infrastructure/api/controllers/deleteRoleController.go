@@ -0,0 +1,53 @@
+package controllers
+
+import (
+	"go-as/src/application/deleteRole"
+	"go-as/src/domain/internals"
+	"go-as/src/infrastructure/api"
+	"go-as/src/infrastructure/transformers"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type DeleteRoleController struct {
+	deleteRoleUseCase *deleteRole.DeleteRoleUseCase
+	useCaseExecutor   *internals.AuthorizedUseCaseExecutor
+	accessTokenFinder *api.HTTPAccessTokenFinder
+	versionTagHandler *api.HTTPVersionTagHandler
+	errorTransformer  *transformers.ErrorToEchoErrorTransformer
+}
+
+func (controller *DeleteRoleController) Handle(c echo.Context) error {
+	roleName := c.Param("name")
+	request := c.Request()
+	accessToken, err := controller.accessTokenFinder.Find(request)
+	if err != nil {
+		return controller.errorTransformer.Transform(err)
+	}
+	expectedVersion, err := controller.versionTagHandler.FindExpectedVersion(request)
+	if err != nil {
+		return controller.errorTransformer.Transform(err)
+	}
+
+	deleteRoleRequest := deleteRole.DeleteRoleRequest{
+		Name:            roleName,
+		ExpectedVersion: expectedVersion,
+	}
+	ctx := c.Request().Context()
+	useCaseResponse := controller.useCaseExecutor.Execute(ctx, controller.deleteRoleUseCase, &deleteRoleRequest, accessToken)
+	if useCaseResponse.Err != nil {
+		return controller.errorTransformer.Transform(useCaseResponse.Err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func NewDeleteRoleController(useCase *deleteRole.DeleteRoleUseCase, useCaseExecutor *internals.AuthorizedUseCaseExecutor, accessTokenFinder *api.HTTPAccessTokenFinder, versionTagHandler *api.HTTPVersionTagHandler, errorTransformer *transformers.ErrorToEchoErrorTransformer) *DeleteRoleController {
+	return &DeleteRoleController{
+		deleteRoleUseCase: useCase,
+		useCaseExecutor:   useCaseExecutor,
+		accessTokenFinder: accessTokenFinder,
+		versionTagHandler: versionTagHandler,
+		errorTransformer:  errorTransformer,
+	}
+}
@@ -0,0 +1,53 @@
+package controllers
+
+import (
+	"go-as/src/application/deleteUser"
+	"go-as/src/domain/internals"
+	"go-as/src/infrastructure/api"
+	"go-as/src/infrastructure/transformers"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type DeleteUserController struct {
+	deleteUserUseCase *deleteUser.DeleteUserUseCase
+	useCaseExecutor   *internals.AuthorizedUseCaseExecutor
+	accessTokenFinder *api.HTTPAccessTokenFinder
+	versionTagHandler *api.HTTPVersionTagHandler
+	errorTransformer  *transformers.ErrorToEchoErrorTransformer
+}
+
+func (controller *DeleteUserController) Handle(c echo.Context) error {
+	userEmail := c.Param("email")
+	request := c.Request()
+	accessToken, err := controller.accessTokenFinder.Find(request)
+	if err != nil {
+		return controller.errorTransformer.Transform(err)
+	}
+	expectedVersion, err := controller.versionTagHandler.FindExpectedVersion(request)
+	if err != nil {
+		return controller.errorTransformer.Transform(err)
+	}
+
+	deleteUserRequest := deleteUser.DeleteUserRequest{
+		Email:           userEmail,
+		ExpectedVersion: expectedVersion,
+	}
+	ctx := c.Request().Context()
+	useCaseResponse := controller.useCaseExecutor.Execute(ctx, controller.deleteUserUseCase, &deleteUserRequest, accessToken)
+	if useCaseResponse.Err != nil {
+		return controller.errorTransformer.Transform(useCaseResponse.Err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func NewDeleteUserController(useCase *deleteUser.DeleteUserUseCase, useCaseExecutor *internals.AuthorizedUseCaseExecutor, accessTokenFinder *api.HTTPAccessTokenFinder, versionTagHandler *api.HTTPVersionTagHandler, errorTransformer *transformers.ErrorToEchoErrorTransformer) *DeleteUserController {
+	return &DeleteUserController{
+		deleteUserUseCase: useCase,
+		useCaseExecutor:   useCaseExecutor,
+		accessTokenFinder: accessTokenFinder,
+		versionTagHandler: versionTagHandler,
+		errorTransformer:  errorTransformer,
+	}
+}
@@ -0,0 +1,69 @@
+package controllers
+
+import (
+	"go-as/src/application/checkUserHasPermissions"
+	"go-as/src/domain/internals"
+	"go-as/src/infrastructure/api"
+	"go-as/src/infrastructure/dto"
+	"go-as/src/infrastructure/transformers"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ExplainPermissionsController struct {
+	checkUserPermissionsUseCase *checkUserHasPermissions.CheckUserHasPermissionUseCase
+	useCaseExecutor             *internals.AuthorizedUseCaseExecutor
+	accessTokenFinder           *api.HTTPAccessTokenFinder
+	dtoDeserializer             *dto.EchoDTODeserializer
+	dtoSerializer               *dto.EchoDTOSerializer
+	errorTransformer            *transformers.ErrorToEchoErrorTransformer
+	explanationTransformer      *transformers.PermissionExplanationToResponseTransformer
+}
+
+func (controller *ExplainPermissionsController) Handle(c echo.Context) error {
+	request := c.Request()
+	accessToken, err := controller.accessTokenFinder.Find(request)
+	if err != nil {
+		return controller.errorTransformer.Transform(err)
+	}
+
+	var explainPermissionsRequestDTO dto.ExplainUserPermissionsRequestDTO
+	if err := controller.dtoDeserializer.Deserialize(c, &explainPermissionsRequestDTO); err != nil {
+		return controller.errorTransformer.Transform(err)
+	}
+	checkPermissionsRequest := checkUserHasPermissions.CheckUserHasPermissionRequest{
+		UserEmail:       accessToken.Sub,
+		PermissionNames: explainPermissionsRequestDTO.Permissions,
+		Explain:         true,
+	}
+	ctx := c.Request().Context()
+	useCaseResponse := controller.useCaseExecutor.Execute(ctx, controller.checkUserPermissionsUseCase, &checkPermissionsRequest, accessToken)
+	if useCaseResponse.Err != nil {
+		return controller.errorTransformer.Transform(useCaseResponse.Err)
+	}
+	checkResponse := useCaseResponse.Content.(*checkUserHasPermissions.CheckUserHasPermissionResponse)
+	return controller.dtoSerializer.Serialize(c, controller.transformResponse(checkResponse))
+}
+
+func (controller *ExplainPermissionsController) transformResponse(checkResponse *checkUserHasPermissions.CheckUserHasPermissionResponse) dto.ExplainUserPermissionsResponseDTO {
+	responseDTO := dto.ExplainUserPermissionsResponseDTO{
+		Result:      checkResponse.Result,
+		Permissions: make([]dto.PermissionExplanationDTO, 0, len(checkResponse.Explanations)),
+	}
+	for _, explanation := range checkResponse.Explanations {
+		responseDTO.Permissions = append(responseDTO.Permissions, *controller.explanationTransformer.Transform(&explanation))
+	}
+	return responseDTO
+}
+
+func NewExplainPermissionsController(checkUserPermissionsUseCase *checkUserHasPermissions.CheckUserHasPermissionUseCase, useCaseExecutor *internals.AuthorizedUseCaseExecutor, accessTokenFinder *api.HTTPAccessTokenFinder, dtoDeserializer *dto.EchoDTODeserializer, dtoSerializer *dto.EchoDTOSerializer, errorTransformer *transformers.ErrorToEchoErrorTransformer, explanationTransformer *transformers.PermissionExplanationToResponseTransformer) *ExplainPermissionsController {
+	return &ExplainPermissionsController{
+		checkUserPermissionsUseCase: checkUserPermissionsUseCase,
+		useCaseExecutor:             useCaseExecutor,
+		accessTokenFinder:           accessTokenFinder,
+		dtoDeserializer:             dtoDeserializer,
+		dtoSerializer:               dtoSerializer,
+		errorTransformer:            errorTransformer,
+		explanationTransformer:      explanationTransformer,
+	}
+}
@@ -0,0 +1,50 @@
+package controllers
+
+import (
+	"go-as/src/application/getUserEffectivePermissions"
+	"go-as/src/domain/internals"
+	"go-as/src/infrastructure/api"
+	"go-as/src/infrastructure/dto"
+	"go-as/src/infrastructure/transformers"
+
+	"github.com/labstack/echo/v4"
+)
+
+type GetMyPermissionsController struct {
+	getUserEffectivePermissionsUseCase *getUserEffectivePermissions.GetUserEffectivePermissionsUseCase
+	useCaseExecutor                    *internals.AuthorizedUseCaseExecutor
+	accessTokenFinder                  *api.HTTPAccessTokenFinder
+	dtoSerializer                      *dto.EchoDTOSerializer
+	errorTransformer                   *transformers.ErrorToEchoErrorTransformer
+	responseTransformer                *transformers.EffectivePermissionsResponseTransformer
+}
+
+func (controller *GetMyPermissionsController) Handle(c echo.Context) error {
+	request := c.Request()
+	accessToken, err := controller.accessTokenFinder.Find(request)
+	if err != nil {
+		return controller.errorTransformer.Transform(err)
+	}
+
+	getEffectivePermissionsRequest := getUserEffectivePermissions.GetUserEffectivePermissionsRequest{
+		UserEmail: accessToken.Sub,
+	}
+	ctx := c.Request().Context()
+	useCaseResponse := controller.useCaseExecutor.Execute(ctx, controller.getUserEffectivePermissionsUseCase, &getEffectivePermissionsRequest, accessToken)
+	if useCaseResponse.Err != nil {
+		return controller.errorTransformer.Transform(useCaseResponse.Err)
+	}
+	effectivePermissionsResponse := useCaseResponse.Content.(*getUserEffectivePermissions.GetUserEffectivePermissionsResponse)
+	return controller.dtoSerializer.Serialize(c, controller.responseTransformer.Transform(effectivePermissionsResponse))
+}
+
+func NewGetMyPermissionsController(useCase *getUserEffectivePermissions.GetUserEffectivePermissionsUseCase, useCaseExecutor *internals.AuthorizedUseCaseExecutor, accessTokenFinder *api.HTTPAccessTokenFinder, dtoSerializer *dto.EchoDTOSerializer, errorTransformer *transformers.ErrorToEchoErrorTransformer, responseTransformer *transformers.EffectivePermissionsResponseTransformer) *GetMyPermissionsController {
+	return &GetMyPermissionsController{
+		getUserEffectivePermissionsUseCase: useCase,
+		useCaseExecutor:                    useCaseExecutor,
+		accessTokenFinder:                  accessTokenFinder,
+		dtoSerializer:                      dtoSerializer,
+		errorTransformer:                   errorTransformer,
+		responseTransformer:                responseTransformer,
+	}
+}
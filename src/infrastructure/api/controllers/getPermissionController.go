@@ -0,0 +1,52 @@
+package controllers
+
+import (
+	"go-as/src/application/getPermission"
+	"go-as/src/domain/internals"
+	"go-as/src/domain/permission"
+	"go-as/src/infrastructure/api"
+	"go-as/src/infrastructure/dto"
+	"go-as/src/infrastructure/transformers"
+
+	"github.com/labstack/echo/v4"
+)
+
+type GetPermissionController struct {
+	getPermissionUseCase *getPermission.GetPermissionUseCase
+	useCaseExecutor      *internals.AuthorizedUseCaseExecutor
+	accessTokenFinder    *api.HTTPAccessTokenFinder
+	dtoSerializer        *dto.EchoDTOSerializer
+	errorTransformer     *transformers.ErrorToEchoErrorTransformer
+	responseTransformer  *transformers.PermissionToResponseTransformer
+}
+
+func (controller *GetPermissionController) Handle(c echo.Context) error {
+	permissionName := c.Param("name")
+	request := c.Request()
+	accessToken, err := controller.accessTokenFinder.Find(request)
+	if err != nil {
+		return controller.errorTransformer.Transform(err)
+	}
+
+	getPermissionRequest := getPermission.GetPermissionRequest{
+		Name: permissionName,
+	}
+	ctx := c.Request().Context()
+	useCaseResponse := controller.useCaseExecutor.Execute(ctx, controller.getPermissionUseCase, &getPermissionRequest, accessToken)
+	if useCaseResponse.Err != nil {
+		return controller.errorTransformer.Transform(useCaseResponse.Err)
+	}
+	foundPermission := useCaseResponse.Content.(*permission.Permission)
+	return controller.dtoSerializer.Serialize(c, controller.responseTransformer.Transform(foundPermission))
+}
+
+func NewGetPermissionController(useCase *getPermission.GetPermissionUseCase, useCaseExecutor *internals.AuthorizedUseCaseExecutor, accessTokenFinder *api.HTTPAccessTokenFinder, dtoSerializer *dto.EchoDTOSerializer, errorTransformer *transformers.ErrorToEchoErrorTransformer, responseTransformer *transformers.PermissionToResponseTransformer) *GetPermissionController {
+	return &GetPermissionController{
+		getPermissionUseCase: useCase,
+		useCaseExecutor:      useCaseExecutor,
+		accessTokenFinder:    accessTokenFinder,
+		dtoSerializer:        dtoSerializer,
+		errorTransformer:     errorTransformer,
+		responseTransformer:  responseTransformer,
+	}
+}
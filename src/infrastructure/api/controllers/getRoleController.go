@@ -0,0 +1,55 @@
+package controllers
+
+import (
+	"go-as/src/application/getRole"
+	"go-as/src/domain/internals"
+	"go-as/src/domain/role"
+	"go-as/src/infrastructure/api"
+	"go-as/src/infrastructure/dto"
+	"go-as/src/infrastructure/transformers"
+
+	"github.com/labstack/echo/v4"
+)
+
+type GetRoleController struct {
+	getRoleUseCase      *getRole.GetRoleUseCase
+	useCaseExecutor     *internals.AuthorizedUseCaseExecutor
+	accessTokenFinder   *api.HTTPAccessTokenFinder
+	versionTagHandler   *api.HTTPVersionTagHandler
+	dtoSerializer       *dto.EchoDTOSerializer
+	errorTransformer    *transformers.ErrorToEchoErrorTransformer
+	responseTransformer *transformers.RoleToResponseTransformer
+}
+
+func (controller *GetRoleController) Handle(c echo.Context) error {
+	roleName := c.Param("name")
+	request := c.Request()
+	accessToken, err := controller.accessTokenFinder.Find(request)
+	if err != nil {
+		return controller.errorTransformer.Transform(err)
+	}
+
+	getRoleRequest := getRole.GetRoleRequest{
+		Name: roleName,
+	}
+	ctx := c.Request().Context()
+	useCaseResponse := controller.useCaseExecutor.Execute(ctx, controller.getRoleUseCase, &getRoleRequest, accessToken)
+	if useCaseResponse.Err != nil {
+		return controller.errorTransformer.Transform(useCaseResponse.Err)
+	}
+	foundRole := useCaseResponse.Content.(*role.Role)
+	controller.versionTagHandler.SetVersionTag(c, foundRole.Version)
+	return controller.dtoSerializer.Serialize(c, controller.responseTransformer.Transform(foundRole))
+}
+
+func NewGetRoleController(useCase *getRole.GetRoleUseCase, useCaseExecutor *internals.AuthorizedUseCaseExecutor, accessTokenFinder *api.HTTPAccessTokenFinder, versionTagHandler *api.HTTPVersionTagHandler, dtoSerializer *dto.EchoDTOSerializer, errorTransformer *transformers.ErrorToEchoErrorTransformer, responseTransformer *transformers.RoleToResponseTransformer) *GetRoleController {
+	return &GetRoleController{
+		getRoleUseCase:      useCase,
+		useCaseExecutor:     useCaseExecutor,
+		accessTokenFinder:   accessTokenFinder,
+		versionTagHandler:   versionTagHandler,
+		dtoSerializer:       dtoSerializer,
+		errorTransformer:    errorTransformer,
+		responseTransformer: responseTransformer,
+	}
+}
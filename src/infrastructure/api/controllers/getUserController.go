@@ -0,0 +1,55 @@
+package controllers
+
+import (
+	"go-as/src/application/getUser"
+	"go-as/src/domain/internals"
+	"go-as/src/domain/user"
+	"go-as/src/infrastructure/api"
+	"go-as/src/infrastructure/dto"
+	"go-as/src/infrastructure/transformers"
+
+	"github.com/labstack/echo/v4"
+)
+
+type GetUserController struct {
+	getUserUseCase      *getUser.GetUserUseCase
+	useCaseExecutor     *internals.AuthorizedUseCaseExecutor
+	accessTokenFinder   *api.HTTPAccessTokenFinder
+	versionTagHandler   *api.HTTPVersionTagHandler
+	dtoSerializer       *dto.EchoDTOSerializer
+	errorTransformer    *transformers.ErrorToEchoErrorTransformer
+	responseTransformer *transformers.UserToResponseTransformer
+}
+
+func (controller *GetUserController) Handle(c echo.Context) error {
+	userEmail := c.Param("email")
+	request := c.Request()
+	accessToken, err := controller.accessTokenFinder.Find(request)
+	if err != nil {
+		return controller.errorTransformer.Transform(err)
+	}
+
+	getUserRequest := getUser.GetUserRequest{
+		Email: userEmail,
+	}
+	ctx := c.Request().Context()
+	useCaseResponse := controller.useCaseExecutor.Execute(ctx, controller.getUserUseCase, &getUserRequest, accessToken)
+	if useCaseResponse.Err != nil {
+		return controller.errorTransformer.Transform(useCaseResponse.Err)
+	}
+	foundUser := useCaseResponse.Content.(*user.User)
+	controller.versionTagHandler.SetVersionTag(c, foundUser.Version)
+	return controller.dtoSerializer.Serialize(c, controller.responseTransformer.Transform(foundUser))
+}
+
+func NewGetUserController(useCase *getUser.GetUserUseCase, useCaseExecutor *internals.AuthorizedUseCaseExecutor, accessTokenFinder *api.HTTPAccessTokenFinder, versionTagHandler *api.HTTPVersionTagHandler, dtoSerializer *dto.EchoDTOSerializer, errorTransformer *transformers.ErrorToEchoErrorTransformer, responseTransformer *transformers.UserToResponseTransformer) *GetUserController {
+	return &GetUserController{
+		getUserUseCase:      useCase,
+		useCaseExecutor:     useCaseExecutor,
+		accessTokenFinder:   accessTokenFinder,
+		versionTagHandler:   versionTagHandler,
+		dtoSerializer:       dtoSerializer,
+		errorTransformer:    errorTransformer,
+		responseTransformer: responseTransformer,
+	}
+}
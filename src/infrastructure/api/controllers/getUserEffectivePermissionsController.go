@@ -0,0 +1,52 @@
+package controllers
+
+import (
+	"go-as/src/application/getOtherUserEffectivePermissions"
+	"go-as/src/application/getUserEffectivePermissions"
+	"go-as/src/domain/internals"
+	"go-as/src/infrastructure/api"
+	"go-as/src/infrastructure/dto"
+	"go-as/src/infrastructure/transformers"
+
+	"github.com/labstack/echo/v4"
+)
+
+type GetUserEffectivePermissionsController struct {
+	getOtherUserEffectivePermissionsUseCase *getOtherUserEffectivePermissions.GetOtherUserEffectivePermissionsUseCase
+	useCaseExecutor                         *internals.AuthorizedUseCaseExecutor
+	accessTokenFinder                       *api.HTTPAccessTokenFinder
+	dtoSerializer                           *dto.EchoDTOSerializer
+	errorTransformer                        *transformers.ErrorToEchoErrorTransformer
+	responseTransformer                     *transformers.EffectivePermissionsResponseTransformer
+}
+
+func (controller *GetUserEffectivePermissionsController) Handle(c echo.Context) error {
+	userEmail := c.Param("email")
+	request := c.Request()
+	accessToken, err := controller.accessTokenFinder.Find(request)
+	if err != nil {
+		return controller.errorTransformer.Transform(err)
+	}
+
+	getEffectivePermissionsRequest := getUserEffectivePermissions.GetUserEffectivePermissionsRequest{
+		UserEmail: userEmail,
+	}
+	ctx := c.Request().Context()
+	useCaseResponse := controller.useCaseExecutor.Execute(ctx, controller.getOtherUserEffectivePermissionsUseCase, &getEffectivePermissionsRequest, accessToken)
+	if useCaseResponse.Err != nil {
+		return controller.errorTransformer.Transform(useCaseResponse.Err)
+	}
+	effectivePermissionsResponse := useCaseResponse.Content.(*getUserEffectivePermissions.GetUserEffectivePermissionsResponse)
+	return controller.dtoSerializer.Serialize(c, controller.responseTransformer.Transform(effectivePermissionsResponse))
+}
+
+func NewGetUserEffectivePermissionsController(useCase *getOtherUserEffectivePermissions.GetOtherUserEffectivePermissionsUseCase, useCaseExecutor *internals.AuthorizedUseCaseExecutor, accessTokenFinder *api.HTTPAccessTokenFinder, dtoSerializer *dto.EchoDTOSerializer, errorTransformer *transformers.ErrorToEchoErrorTransformer, responseTransformer *transformers.EffectivePermissionsResponseTransformer) *GetUserEffectivePermissionsController {
+	return &GetUserEffectivePermissionsController{
+		getOtherUserEffectivePermissionsUseCase: useCase,
+		useCaseExecutor:                         useCaseExecutor,
+		accessTokenFinder:                       accessTokenFinder,
+		dtoSerializer:                           dtoSerializer,
+		errorTransformer:                        errorTransformer,
+		responseTransformer:                     responseTransformer,
+	}
+}
@@ -0,0 +1,65 @@
+package controllers
+
+import (
+	"go-as/src/application/grantUserPermission"
+	"go-as/src/domain/internals"
+	"go-as/src/infrastructure/api"
+	"go-as/src/infrastructure/dto"
+	"go-as/src/infrastructure/transformers"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type GrantUserPermissionController struct {
+	grantUserPermissionUseCase *grantUserPermission.GrantUserPermissionUseCase
+	useCaseExecutor            *internals.AuthorizedUseCaseExecutor
+	accessTokenFinder          *api.HTTPAccessTokenFinder
+	versionTagHandler          *api.HTTPVersionTagHandler
+	dtoDeserializer            *dto.EchoDTODeserializer
+	errorTransformer           *transformers.ErrorToEchoErrorTransformer
+	validityTransformer        *transformers.GrantValidityDTOToDomainTransformer
+}
+
+func (controller *GrantUserPermissionController) Handle(c echo.Context) error {
+	userEmail := c.Param("email")
+	permissionName := c.Param("name")
+	request := c.Request()
+	accessToken, err := controller.accessTokenFinder.Find(request)
+	if err != nil {
+		return controller.errorTransformer.Transform(err)
+	}
+	expectedVersion, err := controller.versionTagHandler.FindExpectedVersion(request)
+	if err != nil {
+		return controller.errorTransformer.Transform(err)
+	}
+
+	var validityDTO dto.GrantValidityDTO
+	if err := controller.dtoDeserializer.Deserialize(c, &validityDTO); err != nil {
+		return controller.errorTransformer.Transform(err)
+	}
+	grantUserPermissionRequest := grantUserPermission.GrantUserPermissionRequest{
+		UserEmail:       userEmail,
+		PermissionName:  permissionName,
+		Validity:        controller.validityTransformer.TransformValidity(validityDTO),
+		ExpectedVersion: expectedVersion,
+	}
+	ctx := c.Request().Context()
+	useCaseResponse := controller.useCaseExecutor.Execute(ctx, controller.grantUserPermissionUseCase, &grantUserPermissionRequest, accessToken)
+	if useCaseResponse.Err != nil {
+		return controller.errorTransformer.Transform(useCaseResponse.Err)
+	}
+	return c.NoContent(http.StatusOK)
+}
+
+func NewGrantUserPermissionController(useCase *grantUserPermission.GrantUserPermissionUseCase, useCaseExecutor *internals.AuthorizedUseCaseExecutor, accessTokenFinder *api.HTTPAccessTokenFinder, versionTagHandler *api.HTTPVersionTagHandler, dtoDeserializer *dto.EchoDTODeserializer, errorTransformer *transformers.ErrorToEchoErrorTransformer, validityTransformer *transformers.GrantValidityDTOToDomainTransformer) *GrantUserPermissionController {
+	return &GrantUserPermissionController{
+		grantUserPermissionUseCase: useCase,
+		useCaseExecutor:            useCaseExecutor,
+		accessTokenFinder:          accessTokenFinder,
+		versionTagHandler:          versionTagHandler,
+		dtoDeserializer:            dtoDeserializer,
+		errorTransformer:           errorTransformer,
+		validityTransformer:        validityTransformer,
+	}
+}
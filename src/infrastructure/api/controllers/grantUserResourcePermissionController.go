@@ -0,0 +1,63 @@
+package controllers
+
+import (
+	"go-as/src/application/grantUserResourcePermission"
+	"go-as/src/domain/internals"
+	"go-as/src/infrastructure/api"
+	"go-as/src/infrastructure/dto"
+	"go-as/src/infrastructure/transformers"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type GrantUserResourcePermissionController struct {
+	grantUserResourcePermissionUseCase *grantUserResourcePermission.GrantUserResourcePermissionUseCase
+	useCaseExecutor                    *internals.AuthorizedUseCaseExecutor
+	accessTokenFinder                  *api.HTTPAccessTokenFinder
+	versionTagHandler                  *api.HTTPVersionTagHandler
+	dtoDeserializer                    *dto.EchoDTODeserializer
+	errorTransformer                   *transformers.ErrorToEchoErrorTransformer
+}
+
+func (controller *GrantUserResourcePermissionController) Handle(c echo.Context) error {
+	userEmail := c.Param("email")
+	request := c.Request()
+	accessToken, err := controller.accessTokenFinder.Find(request)
+	if err != nil {
+		return controller.errorTransformer.Transform(err)
+	}
+	expectedVersion, err := controller.versionTagHandler.FindExpectedVersion(request)
+	if err != nil {
+		return controller.errorTransformer.Transform(err)
+	}
+
+	var grantRequestDTO dto.ResourcePermissionGrantRequestDTO
+	if err := controller.dtoDeserializer.Deserialize(c, &grantRequestDTO); err != nil {
+		return controller.errorTransformer.Transform(err)
+	}
+	grantRequest := grantUserResourcePermission.GrantUserResourcePermissionRequest{
+		UserEmail:       userEmail,
+		PermissionName:  grantRequestDTO.Permission,
+		ResourceType:    grantRequestDTO.ResourceType,
+		ResourceID:      grantRequestDTO.ResourceID,
+		ExpectedVersion: expectedVersion,
+	}
+	ctx := c.Request().Context()
+	useCaseResponse := controller.useCaseExecutor.Execute(ctx, controller.grantUserResourcePermissionUseCase, &grantRequest, accessToken)
+	if useCaseResponse.Err != nil {
+		return controller.errorTransformer.Transform(useCaseResponse.Err)
+	}
+	return c.NoContent(http.StatusCreated)
+}
+
+func NewGrantUserResourcePermissionController(useCase *grantUserResourcePermission.GrantUserResourcePermissionUseCase, useCaseExecutor *internals.AuthorizedUseCaseExecutor, accessTokenFinder *api.HTTPAccessTokenFinder, versionTagHandler *api.HTTPVersionTagHandler, dtoDeserializer *dto.EchoDTODeserializer, errorTransformer *transformers.ErrorToEchoErrorTransformer) *GrantUserResourcePermissionController {
+	return &GrantUserResourcePermissionController{
+		grantUserResourcePermissionUseCase: useCase,
+		useCaseExecutor:                    useCaseExecutor,
+		accessTokenFinder:                  accessTokenFinder,
+		versionTagHandler:                  versionTagHandler,
+		dtoDeserializer:                    dtoDeserializer,
+		errorTransformer:                   errorTransformer,
+	}
+}
@@ -0,0 +1,65 @@
+package controllers
+
+import (
+	"go-as/src/application/grantUserRole"
+	"go-as/src/domain/internals"
+	"go-as/src/infrastructure/api"
+	"go-as/src/infrastructure/dto"
+	"go-as/src/infrastructure/transformers"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type GrantUserRoleController struct {
+	grantUserRoleUseCase *grantUserRole.GrantUserRoleUseCase
+	useCaseExecutor      *internals.AuthorizedUseCaseExecutor
+	accessTokenFinder    *api.HTTPAccessTokenFinder
+	versionTagHandler    *api.HTTPVersionTagHandler
+	dtoDeserializer      *dto.EchoDTODeserializer
+	errorTransformer     *transformers.ErrorToEchoErrorTransformer
+	validityTransformer  *transformers.GrantValidityDTOToDomainTransformer
+}
+
+func (controller *GrantUserRoleController) Handle(c echo.Context) error {
+	userEmail := c.Param("email")
+	roleName := c.Param("name")
+	request := c.Request()
+	accessToken, err := controller.accessTokenFinder.Find(request)
+	if err != nil {
+		return controller.errorTransformer.Transform(err)
+	}
+	expectedVersion, err := controller.versionTagHandler.FindExpectedVersion(request)
+	if err != nil {
+		return controller.errorTransformer.Transform(err)
+	}
+
+	var validityDTO dto.GrantValidityDTO
+	if err := controller.dtoDeserializer.Deserialize(c, &validityDTO); err != nil {
+		return controller.errorTransformer.Transform(err)
+	}
+	grantUserRoleRequest := grantUserRole.GrantUserRoleRequest{
+		UserEmail:       userEmail,
+		RoleName:        roleName,
+		Validity:        controller.validityTransformer.TransformValidity(validityDTO),
+		ExpectedVersion: expectedVersion,
+	}
+	ctx := c.Request().Context()
+	useCaseResponse := controller.useCaseExecutor.Execute(ctx, controller.grantUserRoleUseCase, &grantUserRoleRequest, accessToken)
+	if useCaseResponse.Err != nil {
+		return controller.errorTransformer.Transform(useCaseResponse.Err)
+	}
+	return c.NoContent(http.StatusOK)
+}
+
+func NewGrantUserRoleController(useCase *grantUserRole.GrantUserRoleUseCase, useCaseExecutor *internals.AuthorizedUseCaseExecutor, accessTokenFinder *api.HTTPAccessTokenFinder, versionTagHandler *api.HTTPVersionTagHandler, dtoDeserializer *dto.EchoDTODeserializer, errorTransformer *transformers.ErrorToEchoErrorTransformer, validityTransformer *transformers.GrantValidityDTOToDomainTransformer) *GrantUserRoleController {
+	return &GrantUserRoleController{
+		grantUserRoleUseCase: useCase,
+		useCaseExecutor:      useCaseExecutor,
+		accessTokenFinder:    accessTokenFinder,
+		versionTagHandler:    versionTagHandler,
+		dtoDeserializer:      dtoDeserializer,
+		errorTransformer:     errorTransformer,
+		validityTransformer:  validityTransformer,
+	}
+}
@@ -0,0 +1,65 @@
+package controllers
+
+import (
+	"go-as/src/application/listAuditEntries"
+	"go-as/src/domain/audit"
+	"go-as/src/domain/internals"
+	"go-as/src/domain/pagination"
+	"go-as/src/infrastructure/api"
+	"go-as/src/infrastructure/dto"
+	"go-as/src/infrastructure/transformers"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ListAuditEntriesController struct {
+	listAuditEntriesUseCase *listAuditEntries.ListAuditEntriesUseCase
+	useCaseExecutor         *internals.AuthorizedUseCaseExecutor
+	accessTokenFinder       *api.HTTPAccessTokenFinder
+	dtoDeserializer         *dto.EchoDTODeserializer
+	dtoSerializer           *dto.EchoDTOSerializer
+	errorTransformer        *transformers.ErrorToEchoErrorTransformer
+	responseTransformer     *transformers.AuditEntryPageToResponseTransformer
+}
+
+func (controller *ListAuditEntriesController) Handle(c echo.Context) error {
+	request := c.Request()
+	accessToken, err := controller.accessTokenFinder.Find(request)
+	if err != nil {
+		return controller.errorTransformer.Transform(err)
+	}
+
+	var queryDTO dto.ListAuditEntriesQueryDTO
+	if err := controller.dtoDeserializer.Deserialize(c, &queryDTO); err != nil {
+		return controller.errorTransformer.Transform(err)
+	}
+	listAuditEntriesRequest := listAuditEntries.ListAuditEntriesRequest{
+		Actor:      queryDTO.Actor,
+		Action:     queryDTO.Action,
+		TargetType: queryDTO.TargetType,
+		Target:     queryDTO.Target,
+		From:       queryDTO.From,
+		To:         queryDTO.To,
+		Page:       queryDTO.Page,
+		Size:       queryDTO.Size,
+	}
+	ctx := c.Request().Context()
+	useCaseResponse := controller.useCaseExecutor.Execute(ctx, controller.listAuditEntriesUseCase, &listAuditEntriesRequest, accessToken)
+	if useCaseResponse.Err != nil {
+		return controller.errorTransformer.Transform(useCaseResponse.Err)
+	}
+	page := useCaseResponse.Content.(*pagination.Page[audit.AuditEntry])
+	return controller.dtoSerializer.Serialize(c, controller.responseTransformer.Transform(page))
+}
+
+func NewListAuditEntriesController(useCase *listAuditEntries.ListAuditEntriesUseCase, useCaseExecutor *internals.AuthorizedUseCaseExecutor, accessTokenFinder *api.HTTPAccessTokenFinder, dtoDeserializer *dto.EchoDTODeserializer, dtoSerializer *dto.EchoDTOSerializer, errorTransformer *transformers.ErrorToEchoErrorTransformer, responseTransformer *transformers.AuditEntryPageToResponseTransformer) *ListAuditEntriesController {
+	return &ListAuditEntriesController{
+		listAuditEntriesUseCase: useCase,
+		useCaseExecutor:         useCaseExecutor,
+		accessTokenFinder:       accessTokenFinder,
+		dtoDeserializer:         dtoDeserializer,
+		dtoSerializer:           dtoSerializer,
+		errorTransformer:        errorTransformer,
+		responseTransformer:     responseTransformer,
+	}
+}
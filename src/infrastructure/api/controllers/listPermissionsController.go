@@ -0,0 +1,60 @@
+package controllers
+
+import (
+	"go-as/src/application/listPermissions"
+	"go-as/src/domain/internals"
+	"go-as/src/domain/pagination"
+	"go-as/src/domain/permission"
+	"go-as/src/infrastructure/api"
+	"go-as/src/infrastructure/dto"
+	"go-as/src/infrastructure/transformers"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ListPermissionsController struct {
+	listPermissionsUseCase *listPermissions.ListPermissionsUseCase
+	useCaseExecutor        *internals.AuthorizedUseCaseExecutor
+	accessTokenFinder      *api.HTTPAccessTokenFinder
+	dtoDeserializer        *dto.EchoDTODeserializer
+	dtoSerializer          *dto.EchoDTOSerializer
+	errorTransformer       *transformers.ErrorToEchoErrorTransformer
+	responseTransformer    *transformers.PermissionPageToResponseTransformer
+}
+
+func (controller *ListPermissionsController) Handle(c echo.Context) error {
+	request := c.Request()
+	accessToken, err := controller.accessTokenFinder.Find(request)
+	if err != nil {
+		return controller.errorTransformer.Transform(err)
+	}
+
+	var queryDTO dto.ListPermissionsQueryDTO
+	if err := controller.dtoDeserializer.Deserialize(c, &queryDTO); err != nil {
+		return controller.errorTransformer.Transform(err)
+	}
+	listPermissionsRequest := listPermissions.ListPermissionsRequest{
+		Prefix: queryDTO.Prefix,
+		Page:   queryDTO.Page,
+		Size:   queryDTO.Size,
+	}
+	ctx := c.Request().Context()
+	useCaseResponse := controller.useCaseExecutor.Execute(ctx, controller.listPermissionsUseCase, &listPermissionsRequest, accessToken)
+	if useCaseResponse.Err != nil {
+		return controller.errorTransformer.Transform(useCaseResponse.Err)
+	}
+	page := useCaseResponse.Content.(*pagination.Page[permission.Permission])
+	return controller.dtoSerializer.Serialize(c, controller.responseTransformer.Transform(page))
+}
+
+func NewListPermissionsController(useCase *listPermissions.ListPermissionsUseCase, useCaseExecutor *internals.AuthorizedUseCaseExecutor, accessTokenFinder *api.HTTPAccessTokenFinder, dtoDeserializer *dto.EchoDTODeserializer, dtoSerializer *dto.EchoDTOSerializer, errorTransformer *transformers.ErrorToEchoErrorTransformer, responseTransformer *transformers.PermissionPageToResponseTransformer) *ListPermissionsController {
+	return &ListPermissionsController{
+		listPermissionsUseCase: useCase,
+		useCaseExecutor:        useCaseExecutor,
+		accessTokenFinder:      accessTokenFinder,
+		dtoDeserializer:        dtoDeserializer,
+		dtoSerializer:          dtoSerializer,
+		errorTransformer:       errorTransformer,
+		responseTransformer:    responseTransformer,
+	}
+}
@@ -0,0 +1,59 @@
+package controllers
+
+import (
+	"go-as/src/application/listRoles"
+	"go-as/src/domain/internals"
+	"go-as/src/domain/pagination"
+	"go-as/src/domain/role"
+	"go-as/src/infrastructure/api"
+	"go-as/src/infrastructure/dto"
+	"go-as/src/infrastructure/transformers"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ListRolesController struct {
+	listRolesUseCase    *listRoles.ListRolesUseCase
+	useCaseExecutor     *internals.AuthorizedUseCaseExecutor
+	accessTokenFinder   *api.HTTPAccessTokenFinder
+	dtoDeserializer     *dto.EchoDTODeserializer
+	dtoSerializer       *dto.EchoDTOSerializer
+	errorTransformer    *transformers.ErrorToEchoErrorTransformer
+	responseTransformer *transformers.RolePageToResponseTransformer
+}
+
+func (controller *ListRolesController) Handle(c echo.Context) error {
+	request := c.Request()
+	accessToken, err := controller.accessTokenFinder.Find(request)
+	if err != nil {
+		return controller.errorTransformer.Transform(err)
+	}
+
+	var pageQueryDTO dto.PageQueryDTO
+	if err := controller.dtoDeserializer.Deserialize(c, &pageQueryDTO); err != nil {
+		return controller.errorTransformer.Transform(err)
+	}
+	listRolesRequest := listRoles.ListRolesRequest{
+		Page: pageQueryDTO.Page,
+		Size: pageQueryDTO.Size,
+	}
+	ctx := c.Request().Context()
+	useCaseResponse := controller.useCaseExecutor.Execute(ctx, controller.listRolesUseCase, &listRolesRequest, accessToken)
+	if useCaseResponse.Err != nil {
+		return controller.errorTransformer.Transform(useCaseResponse.Err)
+	}
+	page := useCaseResponse.Content.(*pagination.Page[role.Role])
+	return controller.dtoSerializer.Serialize(c, controller.responseTransformer.Transform(page))
+}
+
+func NewListRolesController(useCase *listRoles.ListRolesUseCase, useCaseExecutor *internals.AuthorizedUseCaseExecutor, accessTokenFinder *api.HTTPAccessTokenFinder, dtoDeserializer *dto.EchoDTODeserializer, dtoSerializer *dto.EchoDTOSerializer, errorTransformer *transformers.ErrorToEchoErrorTransformer, responseTransformer *transformers.RolePageToResponseTransformer) *ListRolesController {
+	return &ListRolesController{
+		listRolesUseCase:    useCase,
+		useCaseExecutor:     useCaseExecutor,
+		accessTokenFinder:   accessTokenFinder,
+		dtoDeserializer:     dtoDeserializer,
+		dtoSerializer:       dtoSerializer,
+		errorTransformer:    errorTransformer,
+		responseTransformer: responseTransformer,
+	}
+}
@@ -0,0 +1,61 @@
+package controllers
+
+import (
+	"go-as/src/application/listUsers"
+	"go-as/src/domain/internals"
+	"go-as/src/domain/pagination"
+	"go-as/src/domain/user"
+	"go-as/src/infrastructure/api"
+	"go-as/src/infrastructure/dto"
+	"go-as/src/infrastructure/transformers"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ListUsersController struct {
+	listUsersUseCase    *listUsers.ListUsersUseCase
+	useCaseExecutor     *internals.AuthorizedUseCaseExecutor
+	accessTokenFinder   *api.HTTPAccessTokenFinder
+	dtoDeserializer     *dto.EchoDTODeserializer
+	dtoSerializer       *dto.EchoDTOSerializer
+	errorTransformer    *transformers.ErrorToEchoErrorTransformer
+	responseTransformer *transformers.UserPageToResponseTransformer
+}
+
+func (controller *ListUsersController) Handle(c echo.Context) error {
+	request := c.Request()
+	accessToken, err := controller.accessTokenFinder.Find(request)
+	if err != nil {
+		return controller.errorTransformer.Transform(err)
+	}
+
+	var queryDTO dto.ListUsersQueryDTO
+	if err := controller.dtoDeserializer.Deserialize(c, &queryDTO); err != nil {
+		return controller.errorTransformer.Transform(err)
+	}
+	listUsersRequest := listUsers.ListUsersRequest{
+		RoleName:       queryDTO.Role,
+		PermissionName: queryDTO.Permission,
+		Page:           queryDTO.Page,
+		Size:           queryDTO.Size,
+	}
+	ctx := c.Request().Context()
+	useCaseResponse := controller.useCaseExecutor.Execute(ctx, controller.listUsersUseCase, &listUsersRequest, accessToken)
+	if useCaseResponse.Err != nil {
+		return controller.errorTransformer.Transform(useCaseResponse.Err)
+	}
+	page := useCaseResponse.Content.(*pagination.Page[user.User])
+	return controller.dtoSerializer.Serialize(c, controller.responseTransformer.Transform(page))
+}
+
+func NewListUsersController(useCase *listUsers.ListUsersUseCase, useCaseExecutor *internals.AuthorizedUseCaseExecutor, accessTokenFinder *api.HTTPAccessTokenFinder, dtoDeserializer *dto.EchoDTODeserializer, dtoSerializer *dto.EchoDTOSerializer, errorTransformer *transformers.ErrorToEchoErrorTransformer, responseTransformer *transformers.UserPageToResponseTransformer) *ListUsersController {
+	return &ListUsersController{
+		listUsersUseCase:    useCase,
+		useCaseExecutor:     useCaseExecutor,
+		accessTokenFinder:   accessTokenFinder,
+		dtoDeserializer:     dtoDeserializer,
+		dtoSerializer:       dtoSerializer,
+		errorTransformer:    errorTransformer,
+		responseTransformer: responseTransformer,
+	}
+}
@@ -0,0 +1,54 @@
+package controllers
+
+import (
+	"go-as/src/application/createUser"
+	"go-as/src/application/registerUser"
+	"go-as/src/domain/internals"
+	"go-as/src/infrastructure/api"
+	"go-as/src/infrastructure/dto"
+	"go-as/src/infrastructure/transformers"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type RegisterUserController struct {
+	registerUserUseCase *registerUser.RegisterUserUseCase
+	useCaseExecutor     *internals.AuthorizedUseCaseExecutor
+	accessTokenFinder   *api.HTTPAccessTokenFinder
+	dtoDeserializer     *dto.EchoDTODeserializer
+	errorTransformer    *transformers.ErrorToEchoErrorTransformer
+}
+
+func (controller *RegisterUserController) Handle(c echo.Context) error {
+	request := c.Request()
+	accessToken, err := controller.accessTokenFinder.Find(request)
+	if err != nil {
+		return controller.errorTransformer.Transform(err)
+	}
+
+	var creationRequestDTO dto.UserCreationRequestDTO
+	if err := controller.dtoDeserializer.Deserialize(c, &creationRequestDTO); err != nil {
+		return controller.errorTransformer.Transform(err)
+	}
+	createUserRequest := createUser.CreateUserRequest{
+		Email:     creationRequestDTO.Email,
+		Superuser: creationRequestDTO.Superuser,
+	}
+	ctx := c.Request().Context()
+	useCaseResponse := controller.useCaseExecutor.Execute(ctx, controller.registerUserUseCase, &createUserRequest, accessToken)
+	if useCaseResponse.Err != nil {
+		return controller.errorTransformer.Transform(useCaseResponse.Err)
+	}
+	return c.NoContent(http.StatusCreated)
+}
+
+func NewRegisterUserController(useCase *registerUser.RegisterUserUseCase, useCaseExecutor *internals.AuthorizedUseCaseExecutor, accessTokenFinder *api.HTTPAccessTokenFinder, dtoDeserializer *dto.EchoDTODeserializer, errorTransformer *transformers.ErrorToEchoErrorTransformer) *RegisterUserController {
+	return &RegisterUserController{
+		registerUserUseCase: useCase,
+		useCaseExecutor:     useCaseExecutor,
+		accessTokenFinder:   accessTokenFinder,
+		dtoDeserializer:     dtoDeserializer,
+		errorTransformer:    errorTransformer,
+	}
+}
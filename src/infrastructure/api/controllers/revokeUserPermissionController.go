@@ -0,0 +1,55 @@
+package controllers
+
+import (
+	"go-as/src/application/revokeUserPermission"
+	"go-as/src/domain/internals"
+	"go-as/src/infrastructure/api"
+	"go-as/src/infrastructure/transformers"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type RevokeUserPermissionController struct {
+	revokeUserPermissionUseCase *revokeUserPermission.RevokeUserPermissionUseCase
+	useCaseExecutor             *internals.AuthorizedUseCaseExecutor
+	accessTokenFinder           *api.HTTPAccessTokenFinder
+	versionTagHandler           *api.HTTPVersionTagHandler
+	errorTransformer            *transformers.ErrorToEchoErrorTransformer
+}
+
+func (controller *RevokeUserPermissionController) Handle(c echo.Context) error {
+	userEmail := c.Param("email")
+	permissionName := c.Param("name")
+	request := c.Request()
+	accessToken, err := controller.accessTokenFinder.Find(request)
+	if err != nil {
+		return controller.errorTransformer.Transform(err)
+	}
+	expectedVersion, err := controller.versionTagHandler.FindExpectedVersion(request)
+	if err != nil {
+		return controller.errorTransformer.Transform(err)
+	}
+
+	revokeUserPermissionRequest := revokeUserPermission.RevokeUserPermissionRequest{
+		UserEmail:       userEmail,
+		PermissionName:  permissionName,
+		ExpectedVersion: expectedVersion,
+	}
+	ctx := c.Request().Context()
+	useCaseResponse := controller.useCaseExecutor.Execute(ctx, controller.revokeUserPermissionUseCase, &revokeUserPermissionRequest, accessToken)
+	if useCaseResponse.Err != nil {
+		return controller.errorTransformer.Transform(useCaseResponse.Err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func NewRevokeUserPermissionController(useCase *revokeUserPermission.RevokeUserPermissionUseCase, useCaseExecutor *internals.AuthorizedUseCaseExecutor, accessTokenFinder *api.HTTPAccessTokenFinder, versionTagHandler *api.HTTPVersionTagHandler, errorTransformer *transformers.ErrorToEchoErrorTransformer) *RevokeUserPermissionController {
+	return &RevokeUserPermissionController{
+		revokeUserPermissionUseCase: useCase,
+		useCaseExecutor:             useCaseExecutor,
+		accessTokenFinder:           accessTokenFinder,
+		versionTagHandler:           versionTagHandler,
+		errorTransformer:            errorTransformer,
+	}
+}
@@ -0,0 +1,55 @@
+package controllers
+
+import (
+	"go-as/src/application/revokeUserRole"
+	"go-as/src/domain/internals"
+	"go-as/src/infrastructure/api"
+	"go-as/src/infrastructure/transformers"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type RevokeUserRoleController struct {
+	revokeUserRoleUseCase *revokeUserRole.RevokeUserRoleUseCase
+	useCaseExecutor       *internals.AuthorizedUseCaseExecutor
+	accessTokenFinder     *api.HTTPAccessTokenFinder
+	versionTagHandler     *api.HTTPVersionTagHandler
+	errorTransformer      *transformers.ErrorToEchoErrorTransformer
+}
+
+func (controller *RevokeUserRoleController) Handle(c echo.Context) error {
+	userEmail := c.Param("email")
+	roleName := c.Param("name")
+	request := c.Request()
+	accessToken, err := controller.accessTokenFinder.Find(request)
+	if err != nil {
+		return controller.errorTransformer.Transform(err)
+	}
+	expectedVersion, err := controller.versionTagHandler.FindExpectedVersion(request)
+	if err != nil {
+		return controller.errorTransformer.Transform(err)
+	}
+
+	revokeUserRoleRequest := revokeUserRole.RevokeUserRoleRequest{
+		UserEmail:       userEmail,
+		RoleName:        roleName,
+		ExpectedVersion: expectedVersion,
+	}
+	ctx := c.Request().Context()
+	useCaseResponse := controller.useCaseExecutor.Execute(ctx, controller.revokeUserRoleUseCase, &revokeUserRoleRequest, accessToken)
+	if useCaseResponse.Err != nil {
+		return controller.errorTransformer.Transform(useCaseResponse.Err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func NewRevokeUserRoleController(useCase *revokeUserRole.RevokeUserRoleUseCase, useCaseExecutor *internals.AuthorizedUseCaseExecutor, accessTokenFinder *api.HTTPAccessTokenFinder, versionTagHandler *api.HTTPVersionTagHandler, errorTransformer *transformers.ErrorToEchoErrorTransformer) *RevokeUserRoleController {
+	return &RevokeUserRoleController{
+		revokeUserRoleUseCase: useCase,
+		useCaseExecutor:       useCaseExecutor,
+		accessTokenFinder:     accessTokenFinder,
+		versionTagHandler:     versionTagHandler,
+		errorTransformer:      errorTransformer,
+	}
+}
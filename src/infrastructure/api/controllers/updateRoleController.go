@@ -0,0 +1,61 @@
+package controllers
+
+import (
+	"go-as/src/application/updateRole"
+	"go-as/src/domain/internals"
+	"go-as/src/infrastructure/api"
+	"go-as/src/infrastructure/dto"
+	"go-as/src/infrastructure/transformers"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type UpdateRoleController struct {
+	updateRoleUseCase *updateRole.UpdateRoleUseCase
+	useCaseExecutor   *internals.AuthorizedUseCaseExecutor
+	accessTokenFinder *api.HTTPAccessTokenFinder
+	versionTagHandler *api.HTTPVersionTagHandler
+	dtoDeserializer   *dto.EchoDTODeserializer
+	errorTransformer  *transformers.ErrorToEchoErrorTransformer
+}
+
+func (controller *UpdateRoleController) Handle(c echo.Context) error {
+	roleName := c.Param("name")
+	request := c.Request()
+	accessToken, err := controller.accessTokenFinder.Find(request)
+	if err != nil {
+		return controller.errorTransformer.Transform(err)
+	}
+	expectedVersion, err := controller.versionTagHandler.FindExpectedVersion(request)
+	if err != nil {
+		return controller.errorTransformer.Transform(err)
+	}
+
+	var updateRoleDTO dto.UpdateRoleDTO
+	if err := controller.dtoDeserializer.Deserialize(c, &updateRoleDTO); err != nil {
+		return controller.errorTransformer.Transform(err)
+	}
+	updateRoleRequest := updateRole.UpdateRoleRequest{
+		Name:            roleName,
+		Permissions:     updateRoleDTO.Permissions,
+		ExpectedVersion: expectedVersion,
+	}
+	ctx := c.Request().Context()
+	useCaseResponse := controller.useCaseExecutor.Execute(ctx, controller.updateRoleUseCase, &updateRoleRequest, accessToken)
+	if useCaseResponse.Err != nil {
+		return controller.errorTransformer.Transform(useCaseResponse.Err)
+	}
+	return c.NoContent(http.StatusOK)
+}
+
+func NewUpdateRoleController(useCase *updateRole.UpdateRoleUseCase, useCaseExecutor *internals.AuthorizedUseCaseExecutor, accessTokenFinder *api.HTTPAccessTokenFinder, versionTagHandler *api.HTTPVersionTagHandler, dtoDeserializer *dto.EchoDTODeserializer, errorTransformer *transformers.ErrorToEchoErrorTransformer) *UpdateRoleController {
+	return &UpdateRoleController{
+		updateRoleUseCase: useCase,
+		useCaseExecutor:   useCaseExecutor,
+		accessTokenFinder: accessTokenFinder,
+		versionTagHandler: versionTagHandler,
+		dtoDeserializer:   dtoDeserializer,
+		errorTransformer:  errorTransformer,
+	}
+}
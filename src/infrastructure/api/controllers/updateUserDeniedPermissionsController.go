@@ -0,0 +1,61 @@
+package controllers
+
+import (
+	"go-as/src/application/updateUserDeniedPermissions"
+	"go-as/src/domain/internals"
+	"go-as/src/infrastructure/api"
+	"go-as/src/infrastructure/dto"
+	"go-as/src/infrastructure/transformers"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type UpdateUserDeniedPermissionsController struct {
+	updateUserDeniedPermissionsUseCase *updateUserDeniedPermissions.UpdateUserDeniedPermissionsUseCase
+	useCaseExecutor                    *internals.AuthorizedUseCaseExecutor
+	accessTokenFinder                  *api.HTTPAccessTokenFinder
+	versionTagHandler                  *api.HTTPVersionTagHandler
+	dtoDeserializer                    *dto.EchoDTODeserializer
+	errorTransformer                   *transformers.ErrorToEchoErrorTransformer
+}
+
+func (controller *UpdateUserDeniedPermissionsController) Handle(c echo.Context) error {
+	userEmail := c.Param("email")
+	request := c.Request()
+	accessToken, err := controller.accessTokenFinder.Find(request)
+	if err != nil {
+		return controller.errorTransformer.Transform(err)
+	}
+	expectedVersion, err := controller.versionTagHandler.FindExpectedVersion(request)
+	if err != nil {
+		return controller.errorTransformer.Transform(err)
+	}
+
+	var updateUserDeniedPermissionsDTO dto.UpdateUserDeniedPermissionsDTO
+	if err := controller.dtoDeserializer.Deserialize(c, &updateUserDeniedPermissionsDTO); err != nil {
+		return controller.errorTransformer.Transform(err)
+	}
+	updateUserDeniedPermissionsRequest := updateUserDeniedPermissions.UpdateUserDeniedPermissionsRequest{
+		UserEmail:       userEmail,
+		PermissionNames: updateUserDeniedPermissionsDTO.DeniedPermissions,
+		ExpectedVersion: expectedVersion,
+	}
+	ctx := c.Request().Context()
+	useCaseResponse := controller.useCaseExecutor.Execute(ctx, controller.updateUserDeniedPermissionsUseCase, &updateUserDeniedPermissionsRequest, accessToken)
+	if useCaseResponse.Err != nil {
+		return controller.errorTransformer.Transform(useCaseResponse.Err)
+	}
+	return c.NoContent(http.StatusOK)
+}
+
+func NewUpdateUserDeniedPermissionsController(useCase *updateUserDeniedPermissions.UpdateUserDeniedPermissionsUseCase, useCaseExecutor *internals.AuthorizedUseCaseExecutor, accessTokenFinder *api.HTTPAccessTokenFinder, versionTagHandler *api.HTTPVersionTagHandler, dtoDeserializer *dto.EchoDTODeserializer, errorTransformer *transformers.ErrorToEchoErrorTransformer) *UpdateUserDeniedPermissionsController {
+	return &UpdateUserDeniedPermissionsController{
+		updateUserDeniedPermissionsUseCase: useCase,
+		useCaseExecutor:                    useCaseExecutor,
+		accessTokenFinder:                  accessTokenFinder,
+		versionTagHandler:                  versionTagHandler,
+		dtoDeserializer:                    dtoDeserializer,
+		errorTransformer:                   errorTransformer,
+	}
+}
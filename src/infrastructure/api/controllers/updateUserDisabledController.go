@@ -0,0 +1,61 @@
+package controllers
+
+import (
+	"go-as/src/application/updateUserDisabled"
+	"go-as/src/domain/internals"
+	"go-as/src/infrastructure/api"
+	"go-as/src/infrastructure/dto"
+	"go-as/src/infrastructure/transformers"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type UpdateUserDisabledController struct {
+	updateUserDisabledUseCase *updateUserDisabled.UpdateUserDisabledUseCase
+	useCaseExecutor           *internals.AuthorizedUseCaseExecutor
+	accessTokenFinder         *api.HTTPAccessTokenFinder
+	versionTagHandler         *api.HTTPVersionTagHandler
+	dtoDeserializer           *dto.EchoDTODeserializer
+	errorTransformer          *transformers.ErrorToEchoErrorTransformer
+}
+
+func (controller *UpdateUserDisabledController) Handle(c echo.Context) error {
+	userEmail := c.Param("email")
+	request := c.Request()
+	accessToken, err := controller.accessTokenFinder.Find(request)
+	if err != nil {
+		return controller.errorTransformer.Transform(err)
+	}
+	expectedVersion, err := controller.versionTagHandler.FindExpectedVersion(request)
+	if err != nil {
+		return controller.errorTransformer.Transform(err)
+	}
+
+	var updateUserDisabledDTO dto.UpdateUserDisabledDTO
+	if err := controller.dtoDeserializer.Deserialize(c, &updateUserDisabledDTO); err != nil {
+		return controller.errorTransformer.Transform(err)
+	}
+	updateUserDisabledRequest := updateUserDisabled.UpdateUserDisabledRequest{
+		UserEmail:       userEmail,
+		Disabled:        *updateUserDisabledDTO.Disabled,
+		ExpectedVersion: expectedVersion,
+	}
+	ctx := c.Request().Context()
+	useCaseResponse := controller.useCaseExecutor.Execute(ctx, controller.updateUserDisabledUseCase, &updateUserDisabledRequest, accessToken)
+	if useCaseResponse.Err != nil {
+		return controller.errorTransformer.Transform(useCaseResponse.Err)
+	}
+	return c.NoContent(http.StatusOK)
+}
+
+func NewUpdateUserDisabledController(useCase *updateUserDisabled.UpdateUserDisabledUseCase, useCaseExecutor *internals.AuthorizedUseCaseExecutor, accessTokenFinder *api.HTTPAccessTokenFinder, versionTagHandler *api.HTTPVersionTagHandler, dtoDeserializer *dto.EchoDTODeserializer, errorTransformer *transformers.ErrorToEchoErrorTransformer) *UpdateUserDisabledController {
+	return &UpdateUserDisabledController{
+		updateUserDisabledUseCase: useCase,
+		useCaseExecutor:           useCaseExecutor,
+		accessTokenFinder:         accessTokenFinder,
+		versionTagHandler:         versionTagHandler,
+		dtoDeserializer:           dtoDeserializer,
+		errorTransformer:          errorTransformer,
+	}
+}
@@ -15,8 +15,10 @@ type UpdateUserPermissionsController struct {
 	updateUserPermissionsUseCase *updateUserPermissions.UpdateUserPermissionsUseCase
 	useCaseExecutor              *internals.AuthorizedUseCaseExecutor
 	accessTokenFinder            *api.HTTPAccessTokenFinder
+	versionTagHandler            *api.HTTPVersionTagHandler
 	dtoDeserializer              *dto.EchoDTODeserializer
 	errorTransformer             *transformers.ErrorToEchoErrorTransformer
+	validityTransformer          *transformers.GrantValidityDTOToDomainTransformer
 }
 
 func (controller *UpdateUserPermissionsController) Handle(c echo.Context) error {
@@ -26,6 +28,7 @@ func (controller *UpdateUserPermissionsController) Handle(c echo.Context) error
 	if err != nil {
 		return controller.errorTransformer.Transform(err)
 	}
+	expectedVersion, err := controller.versionTagHandler.FindExpectedVersion(request)
 	if err != nil {
 		return controller.errorTransformer.Transform(err)
 	}
@@ -37,6 +40,8 @@ func (controller *UpdateUserPermissionsController) Handle(c echo.Context) error
 	updateUserPermissionsRequest := updateUserPermissions.UpdateUserPermissionsRequest{
 		UserEmail:       userEmail,
 		PermissionNames: updateUserPermissionsDTO.Permissions,
+		Validities:      controller.validityTransformer.Transform(updateUserPermissionsDTO.Validities),
+		ExpectedVersion: expectedVersion,
 	}
 	ctx := c.Request().Context()
 	useCaseResponse := controller.useCaseExecutor.Execute(ctx, controller.updateUserPermissionsUseCase, &updateUserPermissionsRequest, accessToken)
@@ -46,12 +51,14 @@ func (controller *UpdateUserPermissionsController) Handle(c echo.Context) error
 	return c.NoContent(http.StatusOK)
 }
 
-func NewUpdateUserPermissionsController(useCase *updateUserPermissions.UpdateUserPermissionsUseCase, useCaseExecutor *internals.AuthorizedUseCaseExecutor, accessTokenFinder *api.HTTPAccessTokenFinder, dtoDeserializer *dto.EchoDTODeserializer, errorTransformer *transformers.ErrorToEchoErrorTransformer) *UpdateUserPermissionsController {
+func NewUpdateUserPermissionsController(useCase *updateUserPermissions.UpdateUserPermissionsUseCase, useCaseExecutor *internals.AuthorizedUseCaseExecutor, accessTokenFinder *api.HTTPAccessTokenFinder, versionTagHandler *api.HTTPVersionTagHandler, dtoDeserializer *dto.EchoDTODeserializer, errorTransformer *transformers.ErrorToEchoErrorTransformer, validityTransformer *transformers.GrantValidityDTOToDomainTransformer) *UpdateUserPermissionsController {
 	return &UpdateUserPermissionsController{
 		updateUserPermissionsUseCase: useCase,
 		useCaseExecutor:              useCaseExecutor,
 		accessTokenFinder:            accessTokenFinder,
+		versionTagHandler:            versionTagHandler,
 		dtoDeserializer:              dtoDeserializer,
 		errorTransformer:             errorTransformer,
+		validityTransformer:          validityTransformer,
 	}
 }
@@ -15,8 +15,10 @@ type UpdateUserRolesController struct {
 	updateUserRolesUseCase *updateUserRoles.UpdateUserRolesUseCase
 	useCaseExecutor        *internals.AuthorizedUseCaseExecutor
 	accessTokenFinder      *api.HTTPAccessTokenFinder
+	versionTagHandler      *api.HTTPVersionTagHandler
 	dtoDeserializer        *dto.EchoDTODeserializer
 	errorTransformer       *transformers.ErrorToEchoErrorTransformer
+	validityTransformer    *transformers.GrantValidityDTOToDomainTransformer
 }
 
 func (controller *UpdateUserRolesController) Handle(c echo.Context) error {
@@ -26,6 +28,7 @@ func (controller *UpdateUserRolesController) Handle(c echo.Context) error {
 	if err != nil {
 		return controller.errorTransformer.Transform(err)
 	}
+	expectedVersion, err := controller.versionTagHandler.FindExpectedVersion(request)
 	if err != nil {
 		return controller.errorTransformer.Transform(err)
 	}
@@ -35,8 +38,10 @@ func (controller *UpdateUserRolesController) Handle(c echo.Context) error {
 		return controller.errorTransformer.Transform(err)
 	}
 	updateUserRolesRequest := updateUserRoles.UpdateUserRolesRequest{
-		UserEmail: userEmail,
-		RoleNames: updateUserRolesDTO.Roles,
+		UserEmail:       userEmail,
+		RoleNames:       updateUserRolesDTO.Roles,
+		Validities:      controller.validityTransformer.Transform(updateUserRolesDTO.Validities),
+		ExpectedVersion: expectedVersion,
 	}
 	ctx := c.Request().Context()
 	useCaseResponse := controller.useCaseExecutor.Execute(ctx, controller.updateUserRolesUseCase, &updateUserRolesRequest, accessToken)
@@ -46,12 +51,14 @@ func (controller *UpdateUserRolesController) Handle(c echo.Context) error {
 	return c.NoContent(http.StatusOK)
 }
 
-func NewUpdateUserRolesController(useCase *updateUserRoles.UpdateUserRolesUseCase, useCaseExecutor *internals.AuthorizedUseCaseExecutor, accessTokenFinder *api.HTTPAccessTokenFinder, dtoDeserializer *dto.EchoDTODeserializer, errorTransformer *transformers.ErrorToEchoErrorTransformer) *UpdateUserRolesController {
+func NewUpdateUserRolesController(useCase *updateUserRoles.UpdateUserRolesUseCase, useCaseExecutor *internals.AuthorizedUseCaseExecutor, accessTokenFinder *api.HTTPAccessTokenFinder, versionTagHandler *api.HTTPVersionTagHandler, dtoDeserializer *dto.EchoDTODeserializer, errorTransformer *transformers.ErrorToEchoErrorTransformer, validityTransformer *transformers.GrantValidityDTOToDomainTransformer) *UpdateUserRolesController {
 	return &UpdateUserRolesController{
 		updateUserRolesUseCase: useCase,
 		useCaseExecutor:        useCaseExecutor,
 		accessTokenFinder:      accessTokenFinder,
+		versionTagHandler:      versionTagHandler,
 		dtoDeserializer:        dtoDeserializer,
 		errorTransformer:       errorTransformer,
+		validityTransformer:    validityTransformer,
 	}
 }
@@ -0,0 +1,34 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+type HTTPVersionTagHandler struct{}
+
+func (*HTTPVersionTagHandler) FindExpectedVersion(httpRequest *http.Request) (*int64, error) {
+	ifMatchHeader := strings.TrimSpace(httpRequest.Header.Get("If-Match"))
+	if ifMatchHeader == "" || ifMatchHeader == "*" {
+		return nil, nil
+	}
+	versionTag := strings.TrimPrefix(ifMatchHeader, "W/")
+	versionTag = strings.Trim(versionTag, `"`)
+	version, err := strconv.ParseInt(versionTag, 10, 64)
+	if err != nil || version < 1 {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "Malformed If-Match header")
+	}
+	return &version, nil
+}
+
+func (*HTTPVersionTagHandler) SetVersionTag(c echo.Context, version int64) {
+	c.Response().Header().Set("ETag", fmt.Sprintf(`"%d"`, version))
+}
+
+func NewHTTPVersionTagHandler() *HTTPVersionTagHandler {
+	return &HTTPVersionTagHandler{}
+}
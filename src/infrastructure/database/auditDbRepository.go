@@ -0,0 +1,73 @@
+package database
+
+import (
+	"context"
+	"go-as/src/domain/audit"
+	"go-as/src/domain/pagination"
+
+	"go.elastic.co/apm/v2"
+	"gorm.io/gorm"
+)
+
+type AuditDbRepository struct {
+	db *gorm.DB
+}
+
+func (repo *AuditDbRepository) Save(ctx context.Context, entry audit.AuditEntry) error {
+	db := dbFromContext(ctx, repo.db)
+	if entry.TraceID == "" {
+		if transaction := apm.TransactionFromContext(ctx); transaction != nil {
+			entry.TraceID = transaction.TraceContext().Trace.String()
+		}
+	}
+	result := db.Create(&entry)
+	return result.Error
+}
+
+func (repo *AuditDbRepository) FindPage(ctx context.Context, filter audit.AuditFilter, pageRequest pagination.PageRequest) (*pagination.Page[audit.AuditEntry], error) {
+	db := dbFromContext(ctx, repo.db)
+	filteredDb := db.Model(&audit.AuditEntry{})
+	if filter.Actor != "" {
+		filteredDb = filteredDb.Where("actor = ?", filter.Actor)
+	}
+	if filter.Action != "" {
+		filteredDb = filteredDb.Where("action = ?", filter.Action)
+	}
+	if filter.TargetType != "" {
+		filteredDb = filteredDb.Where("target_type = ?", filter.TargetType)
+	}
+	if filter.Target != "" {
+		filteredDb = filteredDb.Where("target = ?", filter.Target)
+	}
+	if filter.From != nil {
+		filteredDb = filteredDb.Where("created_at >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		filteredDb = filteredDb.Where("created_at < ?", *filter.To)
+	}
+
+	var total int64
+	if result := filteredDb.Count(&total); result.Error != nil {
+		return nil, result.Error
+	}
+	var foundEntries []audit.AuditEntry
+	result := filteredDb.
+		Order("created_at DESC, id DESC").
+		Offset(pageRequest.Offset()).
+		Limit(pageRequest.Size).
+		Find(&foundEntries)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &pagination.Page[audit.AuditEntry]{
+		Items:       foundEntries,
+		Total:       total,
+		PageRequest: pageRequest,
+	}, nil
+}
+
+func NewAuditDbRepository(db *gorm.DB) *AuditDbRepository {
+	return &AuditDbRepository{
+		db: db,
+	}
+}
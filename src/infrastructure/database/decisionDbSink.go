@@ -0,0 +1,24 @@
+package database
+
+import (
+	"context"
+	"go-as/src/domain/decision"
+
+	"gorm.io/gorm"
+)
+
+type DecisionDbSink struct {
+	db *gorm.DB
+}
+
+func (sink *DecisionDbSink) Write(ctx context.Context, decisions []decision.Decision) error {
+	db := sink.db.WithContext(ctx)
+	result := db.Create(&decisions)
+	return result.Error
+}
+
+func NewDecisionDbSink(db *gorm.DB) *DecisionDbSink {
+	return &DecisionDbSink{
+		db: db,
+	}
+}
@@ -0,0 +1,42 @@
+package database
+
+import (
+	"context"
+	"go-as/src/domain/events"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type EventPositionDbRepository struct {
+	db *gorm.DB
+}
+
+func (repo *EventPositionDbRepository) Lock(ctx context.Context, key string) (*events.EventPosition, error) {
+	db := dbFromContext(ctx, repo.db)
+	result := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&events.EventPosition{Key: key})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	var position events.EventPosition
+	result = db.Clauses(clause.Locking{Strength: "UPDATE"}).Where("key = ?", key).First(&position)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &position, nil
+}
+
+func (repo *EventPositionDbRepository) Save(ctx context.Context, position events.EventPosition) error {
+	db := dbFromContext(ctx, repo.db)
+	result := db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "key"}},
+		DoUpdates: clause.AssignmentColumns([]string{"occurred_at", "deleted"}),
+	}).Create(&position)
+	return result.Error
+}
+
+func NewEventPositionDbRepository(db *gorm.DB) *EventPositionDbRepository {
+	return &EventPositionDbRepository{
+		db: db,
+	}
+}
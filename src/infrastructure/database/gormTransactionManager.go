@@ -0,0 +1,32 @@
+package database
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+type transactionContextKey struct{}
+
+type GormTransactionManager struct {
+	db *gorm.DB
+}
+
+func (manager *GormTransactionManager) Transaction(ctx context.Context, operation func(ctx context.Context) error) error {
+	return dbFromContext(ctx, manager.db).Transaction(func(tx *gorm.DB) error {
+		return operation(context.WithValue(ctx, transactionContextKey{}, tx))
+	})
+}
+
+func dbFromContext(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(transactionContextKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+	return db.WithContext(ctx)
+}
+
+func NewGormTransactionManager(db *gorm.DB) *GormTransactionManager {
+	return &GormTransactionManager{
+		db: db,
+	}
+}
@@ -0,0 +1,71 @@
+package database
+
+import (
+	"context"
+	"go-as/src/domain/user"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type GrantDbRepository struct {
+	db *gorm.DB
+}
+
+func (repo *GrantDbRepository) DeleteExpiredPermissionGrants(ctx context.Context, instant time.Time) ([]user.UserPermission, error) {
+	var expiredGrants []user.UserPermission
+	db := dbFromContext(ctx, repo.db)
+	err := db.Transaction(func(tx *gorm.DB) error {
+		userEmails, err := incrementExpiredGrantUsersVersion(tx, &user.UserPermission{}, instant)
+		if err != nil || len(userEmails) == 0 {
+			return err
+		}
+		result := tx.Clauses(clause.Returning{}).Where("valid_until <= ? AND user_email IN ?", instant, userEmails).Delete(&expiredGrants)
+		return result.Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return expiredGrants, nil
+}
+
+func (repo *GrantDbRepository) DeleteExpiredRoleGrants(ctx context.Context, instant time.Time) ([]user.UserRole, error) {
+	var expiredGrants []user.UserRole
+	db := dbFromContext(ctx, repo.db)
+	err := db.Transaction(func(tx *gorm.DB) error {
+		userEmails, err := incrementExpiredGrantUsersVersion(tx, &user.UserRole{}, instant)
+		if err != nil || len(userEmails) == 0 {
+			return err
+		}
+		result := tx.Clauses(clause.Returning{}).Where("valid_until <= ? AND user_email IN ?", instant, userEmails).Delete(&expiredGrants)
+		return result.Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return expiredGrants, nil
+}
+
+// incrementExpiredGrantUsersVersion bumps the users before their grants are deleted, locking them
+// in the same order as user updates, so a concurrent update of a loaded user cannot re-insert the
+// expired grants and fails with a version mismatch instead.
+func incrementExpiredGrantUsersVersion(tx *gorm.DB, grantModel interface{}, instant time.Time) ([]string, error) {
+	var userEmails []string
+	result := tx.Model(grantModel).Distinct("user_email").Where("valid_until <= ?", instant).Pluck("user_email", &userEmails)
+	if result.Error != nil || len(userEmails) == 0 {
+		return nil, result.Error
+	}
+	result = tx.Model(&user.User{}).Where("email IN ?", userEmails).UpdateColumn("version", gorm.Expr("version + 1"))
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return userEmails, nil
+}
+
+func NewGrantDbRepository(db *gorm.DB) *GrantDbRepository {
+	repo := GrantDbRepository{
+		db: db,
+	}
+	return &repo
+}
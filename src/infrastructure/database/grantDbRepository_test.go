@@ -0,0 +1,52 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestDeleteExpiredPermissionGrantsBumpsUsersVersionFirst(t *testing.T) {
+	db, sqlMock := setUpMockDb(t)
+	instant := time.Unix(100, 0)
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(`SELECT DISTINCT "user_email" FROM "user_permission" WHERE valid_until <= `).
+		WillReturnRows(sqlmock.NewRows([]string{"user_email"}).AddRow("testEmail"))
+	sqlMock.ExpectExec(`UPDATE "users" SET "version"=version \+ 1 WHERE email IN `).
+		WithArgs("testEmail").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectQuery(`DELETE FROM "user_permission" WHERE valid_until <= .* AND user_email IN .* RETURNING`).
+		WillReturnRows(sqlmock.NewRows([]string{"user_email", "permission_name", "valid_until"}).AddRow("testEmail", "testPermission", instant))
+	sqlMock.ExpectCommit()
+
+	expiredGrants, err := NewGrantDbRepository(db).DeleteExpiredPermissionGrants(context.Background(), instant)
+
+	if err != nil {
+		t.Fatalf("Expected expired grants to be deleted, got %s", err.Error())
+	}
+	if len(expiredGrants) != 1 || expiredGrants[0].UserEmail != "testEmail" {
+		t.Fatal("Expected deleted grants to be returned")
+	}
+	if err = sqlMock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("Expected users version to be bumped before their grants are deleted: %s", err.Error())
+	}
+}
+
+func TestDeleteExpiredRoleGrantsWithoutExpiredGrants(t *testing.T) {
+	db, sqlMock := setUpMockDb(t)
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(`SELECT DISTINCT "user_email" FROM "user_role" WHERE valid_until <= `).
+		WillReturnRows(sqlmock.NewRows([]string{"user_email"}))
+	sqlMock.ExpectCommit()
+
+	expiredGrants, err := NewGrantDbRepository(db).DeleteExpiredRoleGrants(context.Background(), time.Unix(100, 0))
+
+	if err != nil || len(expiredGrants) != 0 {
+		t.Fatal("Expected no grants to be deleted")
+	}
+	if err = sqlMock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("Expected no user version to be bumped: %s", err.Error())
+	}
+}
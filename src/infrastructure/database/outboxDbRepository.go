@@ -0,0 +1,73 @@
+package database
+
+import (
+	"context"
+	"go-as/src/domain/events"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type OutboxDbRepository struct {
+	db *gorm.DB
+}
+
+func (repo *OutboxDbRepository) Save(ctx context.Context, event events.OutboxEvent) error {
+	db := dbFromContext(ctx, repo.db)
+	result := db.Create(&event)
+	return result.Error
+}
+
+func (repo *OutboxDbRepository) ClaimPending(ctx context.Context, instant time.Time, leaseUntil time.Time, limit int) ([]events.OutboxEvent, error) {
+	var pendingEvents []events.OutboxEvent
+	db := dbFromContext(ctx, repo.db)
+	err := db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("sent_at IS NULL AND failed_at IS NULL AND next_attempt_at <= ?", instant).
+			Order("id").
+			Limit(limit).
+			Find(&pendingEvents)
+		if result.Error != nil || len(pendingEvents) == 0 {
+			return result.Error
+		}
+		ids := make([]int64, 0, len(pendingEvents))
+		for _, pendingEvent := range pendingEvents {
+			ids = append(ids, pendingEvent.ID)
+		}
+		return tx.Model(&events.OutboxEvent{}).Where("id IN ?", ids).Update("next_attempt_at", leaseUntil).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pendingEvents, nil
+}
+
+func (repo *OutboxDbRepository) MarkSent(ctx context.Context, id int64, sentAt time.Time) error {
+	db := dbFromContext(ctx, repo.db)
+	result := db.Model(&events.OutboxEvent{}).Where("id = ?", id).Update("sent_at", sentAt)
+	return result.Error
+}
+
+func (repo *OutboxDbRepository) MarkFailed(ctx context.Context, event events.OutboxEvent) error {
+	db := dbFromContext(ctx, repo.db)
+	result := db.Model(&events.OutboxEvent{}).Where("id = ?", event.ID).Updates(map[string]any{
+		"attempts":        event.Attempts,
+		"next_attempt_at": event.NextAttemptAt,
+		"last_error":      event.LastError,
+		"failed_at":       event.FailedAt,
+	})
+	return result.Error
+}
+
+func (repo *OutboxDbRepository) DeleteSentBefore(ctx context.Context, instant time.Time) (int64, error) {
+	db := dbFromContext(ctx, repo.db)
+	result := db.Where("sent_at < ?", instant).Delete(&events.OutboxEvent{})
+	return result.RowsAffected, result.Error
+}
+
+func NewOutboxDbRepository(db *gorm.DB) *OutboxDbRepository {
+	return &OutboxDbRepository{
+		db: db,
+	}
+}
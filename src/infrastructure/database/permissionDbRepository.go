@@ -2,7 +2,10 @@ package database
 
 import (
 	"context"
+	"database/sql"
+	"go-as/src/domain/pagination"
 	"go-as/src/domain/permission"
+	"strings"
 
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -13,7 +16,7 @@ type PermissionDbRepository struct {
 }
 
 func (repo *PermissionDbRepository) Save(ctx context.Context, permission permission.Permission) error {
-	db := repo.db.WithContext(ctx)
+	db := dbFromContext(ctx, repo.db)
 	result := db.Clauses(clause.OnConflict{
 		UpdateAll: true,
 	}).Create(&permission)
@@ -22,7 +25,7 @@ func (repo *PermissionDbRepository) Save(ctx context.Context, permission permiss
 
 func (repo *PermissionDbRepository) FindByNames(ctx context.Context, permissionNames []string) ([]permission.Permission, error) {
 	var foundPermissions []permission.Permission
-	db := repo.db.WithContext(ctx)
+	db := dbFromContext(ctx, repo.db)
 	result := db.Where("name IN ?", permissionNames).Find(&foundPermissions)
 	if result.Error != nil {
 		return nil, result.Error
@@ -30,6 +33,87 @@ func (repo *PermissionDbRepository) FindByNames(ctx context.Context, permissionN
 	return foundPermissions, nil
 }
 
+var permissionRoleTables = []string{
+	"role_permission",
+	"role_denied_permission",
+	"role_resource_permission",
+}
+
+var permissionUserTables = []string{
+	"user_permission",
+	"user_denied_permission",
+	"user_resource_permission",
+}
+
+var permissionReferenceTables = append(append([]string{}, permissionRoleTables...), permissionUserTables...)
+
+func permissionHoldersQuery(holderColumn string, tables []string) string {
+	queries := make([]string, 0, len(tables))
+	for _, table := range tables {
+		queries = append(queries, "SELECT "+holderColumn+" FROM "+table+" WHERE permission_name = @permission")
+	}
+	return strings.Join(queries, " UNION ")
+}
+
+var likePatternEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+func (repo *PermissionDbRepository) FindPageByPrefix(ctx context.Context, prefix string, pageRequest pagination.PageRequest) (*pagination.Page[permission.Permission], error) {
+	db := dbFromContext(ctx, repo.db).Model(&permission.Permission{})
+	if prefix != "" {
+		db = db.Where("name LIKE ?", likePatternEscaper.Replace(prefix)+"%")
+	}
+
+	var total int64
+	if result := db.Count(&total); result.Error != nil {
+		return nil, result.Error
+	}
+	var foundPermissions []permission.Permission
+	result := db.Order("name").
+		Offset(pageRequest.Offset()).
+		Limit(pageRequest.Size).
+		Find(&foundPermissions)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &pagination.Page[permission.Permission]{
+		Items:       foundPermissions,
+		Total:       total,
+		PageRequest: pageRequest,
+	}, nil
+}
+
+func (repo *PermissionDbRepository) CountReferences(ctx context.Context, permissionName string) (int64, error) {
+	db := dbFromContext(ctx, repo.db)
+	var references int64
+	for _, table := range permissionReferenceTables {
+		var tableReferences int64
+		if result := db.Table(table).Where("permission_name = ?", permissionName).Count(&tableReferences); result.Error != nil {
+			return 0, result.Error
+		}
+		references += tableReferences
+	}
+	return references, nil
+}
+
+func (repo *PermissionDbRepository) Delete(ctx context.Context, permissionName string) error {
+	db := dbFromContext(ctx, repo.db)
+	return db.Transaction(func(tx *gorm.DB) error {
+		if result := tx.Exec("UPDATE roles SET version = version + 1 WHERE name IN ("+permissionHoldersQuery("role_name", permissionRoleTables)+")", sql.Named("permission", permissionName)); result.Error != nil {
+			return result.Error
+		}
+		if result := tx.Exec("UPDATE users SET version = version + 1 WHERE email IN ("+permissionHoldersQuery("user_email", permissionUserTables)+")", sql.Named("permission", permissionName)); result.Error != nil {
+			return result.Error
+		}
+		for _, table := range permissionReferenceTables {
+			if result := tx.Exec("DELETE FROM "+table+" WHERE permission_name = ?", permissionName); result.Error != nil {
+				return result.Error
+			}
+		}
+		result := tx.Delete(&permission.Permission{Name: permissionName})
+		return result.Error
+	})
+}
+
 func NewPermissionDbRepository(db *gorm.DB) *PermissionDbRepository {
 	repo := PermissionDbRepository{
 		db: db,
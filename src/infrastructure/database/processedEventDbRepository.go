@@ -0,0 +1,35 @@
+package database
+
+import (
+	"context"
+	"go-as/src/domain/events"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ProcessedEventDbRepository struct {
+	db *gorm.DB
+}
+
+func (repo *ProcessedEventDbRepository) SaveIfAbsent(ctx context.Context, event events.ProcessedEvent) (bool, error) {
+	db := dbFromContext(ctx, repo.db)
+	result := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&event)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (repo *ProcessedEventDbRepository) DeleteExpired(ctx context.Context, instant time.Time) error {
+	db := dbFromContext(ctx, repo.db)
+	result := db.Where("expires_at <= ?", instant).Delete(&events.ProcessedEvent{})
+	return result.Error
+}
+
+func NewProcessedEventDbRepository(db *gorm.DB) *ProcessedEventDbRepository {
+	return &ProcessedEventDbRepository{
+		db: db,
+	}
+}
@@ -2,7 +2,9 @@ package database
 
 import (
 	"context"
+	"go-as/src/domain/pagination"
 	"go-as/src/domain/role"
+	"go-as/src/domain/user"
 
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -12,24 +14,176 @@ type RoleDbRepository struct {
 	db *gorm.DB
 }
 
-func (repo *RoleDbRepository) Save(ctx context.Context, role role.Role) error {
-	db := repo.db.WithContext(ctx)
-	result := db.Clauses(clause.OnConflict{
-		UpdateAll: true,
-	}).Create(&role)
-	return result.Error
+var roleOmittedAssociations = []string{"Permissions", "DeniedPermissions", "Parents"}
+
+func (repo *RoleDbRepository) Save(ctx context.Context, savedRole role.Role) error {
+	db := dbFromContext(ctx, repo.db)
+	return db.Transaction(func(tx *gorm.DB) error {
+		onConflict := clause.OnConflict{
+			Columns:   []clause.Column{{Name: "name"}},
+			DoUpdates: clause.AssignmentColumns([]string{"version"}),
+		}
+		if savedRole.Version == 0 {
+			// a new role never overwrites an existing one, updates go through the version check
+			savedRole.Version = 1
+			onConflict = clause.OnConflict{DoNothing: true}
+		} else {
+			if err := incrementRoleVersion(tx, savedRole.Name, savedRole.Version); err != nil {
+				return err
+			}
+			savedRole.Version++
+		}
+
+		result := tx.Omit(roleOmittedAssociations...).Clauses(onConflict).Create(&savedRole)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return role.RoleAlreadyExistsError{RoleName: savedRole.Name}
+		}
+		return replaceRoleAssociations(tx, savedRole)
+	})
+}
+
+func replaceRoleAssociations(tx *gorm.DB, savedRole role.Role) error {
+	if err := tx.Model(&savedRole).Association("Permissions").Replace(savedRole.Permissions); err != nil {
+		return err
+	}
+	if err := tx.Model(&savedRole).Association("DeniedPermissions").Replace(savedRole.DeniedPermissions); err != nil {
+		return err
+	}
+	return tx.Model(&savedRole).Association("Parents").Replace(savedRole.Parents)
+}
+
+func incrementRoleVersion(tx *gorm.DB, roleName string, version int64) error {
+	result := tx.Model(&role.Role{}).
+		Where("name = ? AND version = ?", roleName, version).
+		UpdateColumn("version", version+1)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return role.RoleVersionMismatchError{
+			RoleName: roleName,
+			Version:  version,
+		}
+	}
+	return nil
 }
 
 func (repo *RoleDbRepository) FindByNames(ctx context.Context, roleNames []string) ([]role.Role, error) {
 	var foundRoles []role.Role
-	db := repo.db.WithContext(ctx)
-	result := db.Where("name IN ?", roleNames).Find(&foundRoles)
+	db := dbFromContext(ctx, repo.db)
+	result := db.Preload("Permissions").Preload("DeniedPermissions").Preload("ResourcePermissions").Where("name IN ?", roleNames).Find(&foundRoles)
 	if result.Error != nil {
 		return nil, result.Error
 	}
+	if err := loadRolesAncestors(db, foundRoles, make(map[string][]role.Role)); err != nil {
+		return nil, err
+	}
 	return foundRoles, nil
 }
 
+func (repo *RoleDbRepository) FindPage(ctx context.Context, pageRequest pagination.PageRequest) (*pagination.Page[role.Role], error) {
+	var total int64
+	db := dbFromContext(ctx, repo.db)
+	if result := db.Model(&role.Role{}).Count(&total); result.Error != nil {
+		return nil, result.Error
+	}
+
+	var foundRoles []role.Role
+	result := db.Preload("Permissions").
+		Preload("DeniedPermissions").
+		Preload("ResourcePermissions").
+		Order("name").
+		Offset(pageRequest.Offset()).
+		Limit(pageRequest.Size).
+		Find(&foundRoles)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if err := loadRolesAncestors(db, foundRoles, make(map[string][]role.Role)); err != nil {
+		return nil, err
+	}
+	return &pagination.Page[role.Role]{
+		Items:       foundRoles,
+		Total:       total,
+		PageRequest: pageRequest,
+	}, nil
+}
+
+func (repo *RoleDbRepository) UpdatePermissions(ctx context.Context, updatedRole role.Role) error {
+	db := dbFromContext(ctx, repo.db)
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := incrementRoleVersion(tx, updatedRole.Name, updatedRole.Version); err != nil {
+			return err
+		}
+		updatedRole.Version++
+		return tx.Model(&updatedRole).Association("Permissions").Replace(updatedRole.Permissions)
+	})
+}
+
+func (repo *RoleDbRepository) Delete(ctx context.Context, roleName string) error {
+	db := dbFromContext(ctx, repo.db)
+	return db.Transaction(func(tx *gorm.DB) error {
+		if result := tx.Exec("UPDATE users SET version = version + 1 WHERE email IN (SELECT user_email FROM user_role WHERE role_name = ?)", roleName); result.Error != nil {
+			return result.Error
+		}
+		if result := tx.Where("role_name = ?", roleName).Delete(&user.UserRole{}); result.Error != nil {
+			return result.Error
+		}
+		if result := tx.Exec("UPDATE roles SET version = version + 1 WHERE name IN (SELECT role_name FROM role_parent WHERE parent_name = ?)", roleName); result.Error != nil {
+			return result.Error
+		}
+		if result := tx.Exec("DELETE FROM role_parent WHERE parent_name = ?", roleName); result.Error != nil {
+			return result.Error
+		}
+		result := tx.Select(clause.Associations).Delete(&role.Role{Name: roleName})
+		return result.Error
+	})
+}
+
+func loadRolesAncestors(db *gorm.DB, roles []role.Role, loadedParents map[string][]role.Role) error {
+	for i := range roles {
+		if err := loadRoleAncestors(db, &roles[i], make(map[string]bool), loadedParents); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func loadRoleAncestors(db *gorm.DB, childRole *role.Role, rolesInPath map[string]bool, loadedParents map[string][]role.Role) error {
+	if parents, loaded := loadedParents[childRole.Name]; loaded {
+		childRole.Parents = parents
+		return nil
+	}
+
+	var parents []role.Role
+	result := db.Preload("Permissions").
+		Preload("DeniedPermissions").
+		Preload("ResourcePermissions").
+		Joins("JOIN role_parent ON role_parent.parent_name = roles.name").
+		Where("role_parent.role_name = ?", childRole.Name).
+		Find(&parents)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	rolesInPath[childRole.Name] = true
+	defer delete(rolesInPath, childRole.Name)
+	for i := range parents {
+		if rolesInPath[parents[i].Name] {
+			continue
+		}
+		if err := loadRoleAncestors(db, &parents[i], rolesInPath, loadedParents); err != nil {
+			return err
+		}
+	}
+	childRole.Parents = parents
+	loadedParents[childRole.Name] = parents
+	return nil
+}
+
 func NewRoleDbRepository(db *gorm.DB) *RoleDbRepository {
 	repo := RoleDbRepository{
 		db: db,
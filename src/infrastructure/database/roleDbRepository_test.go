@@ -0,0 +1,26 @@
+package database
+
+import (
+	"context"
+	"go-as/src/domain/permission"
+	"go-as/src/domain/role"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestSaveNewRoleOverExistingIsRejected(t *testing.T) {
+	db, sqlMock := setUpMockDb(t)
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectExec(`INSERT INTO "roles" .* ON CONFLICT DO NOTHING`).WillReturnResult(sqlmock.NewResult(0, 0))
+	sqlMock.ExpectRollback()
+
+	err := NewRoleDbRepository(db).Save(context.Background(), role.Role{Name: "testRole", Permissions: []permission.Permission{{Name: "testPermission"}}})
+
+	if _, ok := err.(role.RoleAlreadyExistsError); !ok {
+		t.Fatal("Expected saving a new role over an existing one to return RoleAlreadyExistsError")
+	}
+	if err = sqlMock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("Expected existing role associations to be left untouched: %s", err.Error())
+	}
+}
@@ -2,37 +2,335 @@ package database
 
 import (
 	"context"
+	"database/sql"
+	"go-as/src/domain/pagination"
+	"go-as/src/domain/role"
 	"go-as/src/domain/user"
 
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
 
+var userOmittedAssociations = []string{"Roles", "Permissions", "DeniedPermissions", "ResourcePermissions", "PermissionGrants", "RoleGrants"}
+
+var userKeyedTables = []string{"user_role", "user_permission", "user_denied_permission", "user_resource_permission"}
+
 type UserDbRepository struct {
 	db *gorm.DB
 }
 
-func (repo *UserDbRepository) Save(ctx context.Context, user user.User) error {
-	db := repo.db.WithContext(ctx)
-	result := db.Clauses(clause.OnConflict{
+func (repo *UserDbRepository) Save(ctx context.Context, savedUser user.User) error {
+	db := dbFromContext(ctx, repo.db)
+	return db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		if savedUser.Version == 0 {
+			err = insertUser(tx, savedUser)
+		} else {
+			err = updateUser(tx, savedUser)
+		}
+		if err != nil {
+			return err
+		}
+		if err := saveResourcePermissions(tx, savedUser.ResourcePermissions); err != nil {
+			return err
+		}
+		if err := saveGrants(tx, savedUser.PermissionGrants); err != nil {
+			return err
+		}
+		return saveGrants(tx, savedUser.RoleGrants)
+	})
+}
+
+// insertUser never overwrites an existing user, so replayed creations cannot reset its flags or grants.
+// Associations are only added, since the saved value may not hold the full aggregate.
+func insertUser(tx *gorm.DB, savedUser user.User) error {
+	savedUser.Version = 1
+	result := tx.Omit(userOmittedAssociations...).Clauses(clause.OnConflict{DoNothing: true}).Create(&savedUser)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return user.UserAlreadyExistsError{Email: savedUser.Email}
+	}
+	if len(savedUser.Roles) > 0 {
+		if err := tx.Model(&savedUser).Association("Roles").Append(savedUser.Roles); err != nil {
+			return err
+		}
+	}
+	if len(savedUser.Permissions) > 0 {
+		if err := tx.Model(&savedUser).Association("Permissions").Append(savedUser.Permissions); err != nil {
+			return err
+		}
+	}
+	if len(savedUser.DeniedPermissions) > 0 {
+		return tx.Model(&savedUser).Association("DeniedPermissions").Append(savedUser.DeniedPermissions)
+	}
+	return nil
+}
+
+// updateUser expects the full aggregate loaded at savedUser.Version and replaces its associations with it.
+func updateUser(tx *gorm.DB, savedUser user.User) error {
+	if err := incrementUserVersion(tx, savedUser.Email, savedUser.Version); err != nil {
+		return err
+	}
+	savedUser.Version++
+	result := tx.Model(&user.User{}).
+		Where("email = ?", savedUser.Email).
+		Updates(map[string]interface{}{"superuser": savedUser.Superuser, "disabled": savedUser.Disabled})
+	if result.Error != nil {
+		return result.Error
+	}
+	return replaceUserAssociations(tx, savedUser)
+}
+
+func replaceUserAssociations(tx *gorm.DB, savedUser user.User) error {
+	if err := tx.Model(&savedUser).Association("Roles").Replace(savedUser.Roles); err != nil {
+		return err
+	}
+	if err := tx.Model(&savedUser).Association("Permissions").Replace(savedUser.Permissions); err != nil {
+		return err
+	}
+	return tx.Model(&savedUser).Association("DeniedPermissions").Replace(savedUser.DeniedPermissions)
+}
+
+func saveResourcePermissions(tx *gorm.DB, resourcePermissions []user.UserResourcePermission) error {
+	if len(resourcePermissions) == 0 {
+		return nil
+	}
+	result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&resourcePermissions)
+	return result.Error
+}
+
+func incrementUserVersion(tx *gorm.DB, email string, version int64) error {
+	result := tx.Model(&user.User{}).
+		Where("email = ? AND version = ?", email, version).
+		UpdateColumn("version", version+1)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return user.UserVersionMismatchError{
+			Email:   email,
+			Version: version,
+		}
+	}
+	return nil
+}
+
+func saveGrants[T user.UserPermission | user.UserRole](tx *gorm.DB, grants []T) error {
+	if len(grants) == 0 {
+		return nil
+	}
+	result := tx.Clauses(clause.OnConflict{
 		UpdateAll: true,
-	}).Create(&user)
+	}).Create(&grants)
 	return result.Error
 }
 
 func (repo *UserDbRepository) FindByEmail(ctx context.Context, email string) (*user.User, error) {
 	var foundUser user.User
-	db := repo.db.WithContext(ctx)
-	result := db.Preload("Permissions").Preload("Roles").Preload("Roles.Permissions").Where(user.User{Email: email}).First(&foundUser)
+	db := dbFromContext(ctx, repo.db)
+	result := preloadUserAssociations(db).Where(user.User{Email: email}).First(&foundUser)
 	if result.RowsAffected == 0 {
 		return nil, nil
 	}
 	if result.Error != nil {
 		return nil, result.Error
 	}
+	if err := loadRolesAncestors(db, foundUser.Roles, make(map[string][]role.Role)); err != nil {
+		return nil, err
+	}
 	return &foundUser, nil
 }
 
+func (repo *UserDbRepository) FindByEmails(ctx context.Context, emails []string) ([]user.User, error) {
+	var foundUsers []user.User
+	db := dbFromContext(ctx, repo.db)
+	result := preloadUserAssociations(db).Where("email IN ?", emails).Find(&foundUsers)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if err := loadUsersRolesAncestors(db, foundUsers); err != nil {
+		return nil, err
+	}
+	return foundUsers, nil
+}
+
+// usersGrantedPermissionQuery only narrows the candidates, effective permissions are checked on the loaded users
+const usersGrantedPermissionQuery = `
+SELECT user_email FROM user_permission WHERE permission_name = @permission
+UNION
+SELECT user_email FROM user_role WHERE role_name IN (
+	WITH RECURSIVE granting_roles(name) AS (
+		SELECT role_name FROM role_permission WHERE permission_name = @permission
+		UNION
+		SELECT role_parent.role_name FROM role_parent JOIN granting_roles ON role_parent.parent_name = granting_roles.name
+	)
+	SELECT name FROM granting_roles
+)`
+
+func (repo *UserDbRepository) FindPage(ctx context.Context, filter user.UserFilter, pageRequest pagination.PageRequest) (*pagination.Page[user.User], error) {
+	db := dbFromContext(ctx, repo.db)
+	filteredDb := db.Model(&user.User{})
+	if filter.RoleName != "" {
+		filteredDb = filteredDb.Where("email IN (SELECT user_email FROM user_role WHERE role_name = ?)", filter.RoleName)
+	}
+	if filter.PermissionName != "" {
+		return repo.findPermissionPage(db, filteredDb, filter, pageRequest)
+	}
+
+	var total int64
+	if result := filteredDb.Count(&total); result.Error != nil {
+		return nil, result.Error
+	}
+	var foundUsers []user.User
+	result := preloadUserAssociations(filteredDb).
+		Order("email").
+		Offset(pageRequest.Offset()).
+		Limit(pageRequest.Size).
+		Find(&foundUsers)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if err := loadUsersRolesAncestors(db, foundUsers); err != nil {
+		return nil, err
+	}
+	return &pagination.Page[user.User]{
+		Items:       foundUsers,
+		Total:       total,
+		PageRequest: pageRequest,
+	}, nil
+}
+
+func (*UserDbRepository) findPermissionPage(db *gorm.DB, filteredDb *gorm.DB, filter user.UserFilter, pageRequest pagination.PageRequest) (*pagination.Page[user.User], error) {
+	var candidateUsers []user.User
+	result := preloadUserAssociations(filteredDb).
+		Where("NOT disabled AND (superuser OR email IN ("+usersGrantedPermissionQuery+"))", sql.Named("permission", filter.PermissionName)).
+		Order("email").
+		Find(&candidateUsers)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if err := loadUsersRolesAncestors(db, candidateUsers); err != nil {
+		return nil, err
+	}
+	var matchingUsers []user.User
+	for i := range candidateUsers {
+		if filter.MatchesPermission(&candidateUsers[i]) {
+			matchingUsers = append(matchingUsers, candidateUsers[i])
+		}
+	}
+	start := pageRequest.Offset()
+	if start > len(matchingUsers) {
+		start = len(matchingUsers)
+	}
+	end := start + pageRequest.Size
+	if end > len(matchingUsers) {
+		end = len(matchingUsers)
+	}
+	return &pagination.Page[user.User]{
+		Items:       matchingUsers[start:end],
+		Total:       int64(len(matchingUsers)),
+		PageRequest: pageRequest,
+	}, nil
+}
+
+func loadUsersRolesAncestors(db *gorm.DB, users []user.User) error {
+	loadedParents := make(map[string][]role.Role)
+	for i := range users {
+		if err := loadRolesAncestors(db, users[i].Roles, loadedParents); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (repo *UserDbRepository) Delete(ctx context.Context, email string) error {
+	db := dbFromContext(ctx, repo.db)
+	return db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Select(clause.Associations).Delete(&user.User{Email: email})
+		return result.Error
+	})
+}
+
+func (repo *UserDbRepository) SavePermissionGrant(ctx context.Context, grant user.UserPermission, version int64) error {
+	db := dbFromContext(ctx, repo.db)
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := saveGrants(tx, []user.UserPermission{grant}); err != nil {
+			return err
+		}
+		return incrementUserVersion(tx, grant.UserEmail, version)
+	})
+}
+
+func (repo *UserDbRepository) DeletePermissionGrant(ctx context.Context, email string, permissionName string, version int64) error {
+	db := dbFromContext(ctx, repo.db)
+	return db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where(&user.UserPermission{UserEmail: email, PermissionName: permissionName}).Delete(&user.UserPermission{})
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		return incrementUserVersion(tx, email, version)
+	})
+}
+
+func (repo *UserDbRepository) SaveRoleGrant(ctx context.Context, grant user.UserRole, version int64) error {
+	db := dbFromContext(ctx, repo.db)
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := saveGrants(tx, []user.UserRole{grant}); err != nil {
+			return err
+		}
+		return incrementUserVersion(tx, grant.UserEmail, version)
+	})
+}
+
+func (repo *UserDbRepository) DeleteRoleGrant(ctx context.Context, email string, roleName string, version int64) error {
+	db := dbFromContext(ctx, repo.db)
+	return db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where(&user.UserRole{UserEmail: email, RoleName: roleName}).Delete(&user.UserRole{})
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		return incrementUserVersion(tx, email, version)
+	})
+}
+
+func (repo *UserDbRepository) ChangeEmail(ctx context.Context, email string, newEmail string) error {
+	db := dbFromContext(ctx, repo.db)
+	return db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Exec(
+			"INSERT INTO users (email, password, superuser, disabled, version) SELECT ?, password, superuser, disabled, version FROM users WHERE email = ?",
+			newEmail,
+			email,
+		)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return user.UserNotFoundError{Email: email}
+		}
+		for _, table := range userKeyedTables {
+			if result = tx.Table(table).Where("user_email = ?", email).Update("user_email", newEmail); result.Error != nil {
+				return result.Error
+			}
+		}
+		result = tx.Where("email = ?", email).Delete(&user.User{})
+		return result.Error
+	})
+}
+
+func preloadUserAssociations(db *gorm.DB) *gorm.DB {
+	return db.Preload("Permissions").
+		Preload("DeniedPermissions").
+		Preload("ResourcePermissions").
+		Preload("PermissionGrants").
+		Preload("RoleGrants").
+		Preload("Roles").
+		Preload("Roles.Permissions").
+		Preload("Roles.DeniedPermissions").
+		Preload("Roles.ResourcePermissions")
+}
+
 func NewUserDbRepository(db *gorm.DB) *UserDbRepository {
 	repo := UserDbRepository{
 		db: db,
@@ -0,0 +1,81 @@
+package database
+
+import (
+	"context"
+	"go-as/src/domain/permission"
+	"go-as/src/domain/role"
+	"go-as/src/domain/user"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func setUpMockDb(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	sqlDb, sqlMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Expected mock database, got %s", err.Error())
+	}
+	t.Cleanup(func() {
+		sqlDb.Close()
+	})
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDb}), &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		t.Fatalf("Expected gorm over the mock database, got %s", err.Error())
+	}
+	return db, sqlMock
+}
+
+func TestSavePartialUserKeepsExistingGrants(t *testing.T) {
+	db, sqlMock := setUpMockDb(t)
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectExec(`INSERT INTO "users" .* ON CONFLICT DO NOTHING`).WillReturnResult(sqlmock.NewResult(0, 0))
+	sqlMock.ExpectRollback()
+
+	err := NewUserDbRepository(db).Save(context.Background(), user.User{Email: "testEmail", Superuser: true})
+
+	if _, ok := err.(user.UserAlreadyExistsError); !ok {
+		t.Fatal("Expected saving a new user over an existing one to return UserAlreadyExistsError")
+	}
+	if err = sqlMock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("Expected existing user and its grants to be left untouched: %s", err.Error())
+	}
+}
+
+func TestSaveNewUserOnlyAddsAssociations(t *testing.T) {
+	db, sqlMock := setUpMockDb(t)
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectExec(`INSERT INTO "users" .* ON CONFLICT DO NOTHING`).WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectExec(`INSERT INTO "roles" .* ON CONFLICT DO NOTHING`).WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectExec(`INSERT INTO "user_role" .* ON CONFLICT DO NOTHING`).WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectCommit()
+
+	err := NewUserDbRepository(db).Save(context.Background(), user.User{Email: "testEmail", Roles: []role.Role{{Name: "testRole"}}})
+
+	if err != nil {
+		t.Fatalf("Expected new user to be saved, got %s", err.Error())
+	}
+	if err = sqlMock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("Expected new user associations to be appended without deleting any: %s", err.Error())
+	}
+}
+
+func TestSaveStaleUserIsRejected(t *testing.T) {
+	db, sqlMock := setUpMockDb(t)
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectExec(`UPDATE "users" SET "version"=.* WHERE email = .* AND version = `).
+		WithArgs(int64(3), "testEmail", int64(2)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	sqlMock.ExpectRollback()
+
+	err := NewUserDbRepository(db).Save(context.Background(), user.User{Email: "testEmail", Version: 2, Permissions: []permission.Permission{{Name: "testPermission"}}})
+
+	if _, ok := err.(user.UserVersionMismatchError); !ok {
+		t.Fatal("Expected stale user to return UserVersionMismatchError")
+	}
+	if err = sqlMock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("Expected stale user associations not to be replaced: %s", err.Error())
+	}
+}
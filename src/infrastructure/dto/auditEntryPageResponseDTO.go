@@ -0,0 +1,8 @@
+package dto
+
+type AuditEntryPageResponseDTO struct {
+	Items []AuditEntryResponseDTO `json:"items"`
+	Page  int                     `json:"page"`
+	Size  int                     `json:"size"`
+	Total int64                   `json:"total"`
+}
@@ -0,0 +1,18 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type AuditEntryResponseDTO struct {
+	ID         int64           `json:"id"`
+	Actor      string          `json:"actor"`
+	Action     string          `json:"action"`
+	TargetType string          `json:"targetType"`
+	Target     string          `json:"target"`
+	Before     json.RawMessage `json:"before"`
+	After      json.RawMessage `json:"after"`
+	TraceID    string          `json:"traceId"`
+	CreatedAt  time.Time       `json:"createdAt"`
+}
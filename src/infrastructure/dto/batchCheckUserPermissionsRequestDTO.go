@@ -0,0 +1,7 @@
+package dto
+
+type BatchCheckUserPermissionsRequestDTO struct {
+	Subjects    []string     `json:"subjects" validate:"required,min=1,max=100"`
+	Permissions []string     `json:"permissions" validate:"required,min=1,max=100"`
+	Resource    *ResourceDTO `json:"resource"`
+}
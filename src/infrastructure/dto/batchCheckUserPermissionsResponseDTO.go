@@ -0,0 +1,6 @@
+package dto
+
+type BatchCheckUserPermissionsResponseDTO struct {
+	Decisions       map[string]map[string]bool `json:"decisions"`
+	MissingSubjects []string                   `json:"missing_subjects,omitempty"`
+}
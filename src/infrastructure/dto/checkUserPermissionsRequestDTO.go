@@ -1,5 +1,7 @@
 package dto
 
 type CheckUserPermissionsRequestDTO struct {
-	Permissions []string `json:"permissions" validate:"required"`
+	Permissions []string     `json:"permissions" validate:"required"`
+	Resource    *ResourceDTO `json:"resource"`
+	Mode        string       `json:"mode" validate:"omitempty,oneof=all any"`
 }
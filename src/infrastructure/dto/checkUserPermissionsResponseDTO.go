@@ -1,5 +1,7 @@
 package dto
 
 type CheckUserPermissionsResponseDTO struct {
-	Result bool `json:"result" validate:"required"`
+	Result    bool                  `json:"result" validate:"required"`
+	Decisions map[string]bool       `json:"decisions"`
+	Denials   []PermissionDenialDTO `json:"denials,omitempty"`
 }
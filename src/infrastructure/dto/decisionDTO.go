@@ -0,0 +1,13 @@
+package dto
+
+import "time"
+
+type DecisionDTO struct {
+	Subject     string    `json:"subject"`
+	Permissions []string  `json:"permissions"`
+	Allowed     bool      `json:"allowed"`
+	Reason      string    `json:"reason"`
+	Source      string    `json:"source"`
+	TraceID     string    `json:"traceId"`
+	DecidedAt   time.Time `json:"decidedAt"`
+}
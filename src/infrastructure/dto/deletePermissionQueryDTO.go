@@ -0,0 +1,5 @@
+package dto
+
+type DeletePermissionQueryDTO struct {
+	Force bool `query:"force"`
+}
@@ -0,0 +1,16 @@
+package dto
+
+type PermissionSourceDTO struct {
+	Source   string   `json:"source"`
+	RolePath []string `json:"role_path,omitempty"`
+}
+
+type EffectivePermissionDTO struct {
+	Permission string                `json:"permission"`
+	Sources    []PermissionSourceDTO `json:"sources"`
+}
+
+type EffectivePermissionsResponseDTO struct {
+	Superuser   bool                     `json:"superuser"`
+	Permissions []EffectivePermissionDTO `json:"permissions"`
+}
@@ -0,0 +1,5 @@
+package dto
+
+type ExplainUserPermissionsRequestDTO struct {
+	Permissions []string `json:"permissions" validate:"required"`
+}
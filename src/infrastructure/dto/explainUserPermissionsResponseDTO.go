@@ -0,0 +1,6 @@
+package dto
+
+type ExplainUserPermissionsResponseDTO struct {
+	Result      bool                       `json:"result"`
+	Permissions []PermissionExplanationDTO `json:"permissions"`
+}
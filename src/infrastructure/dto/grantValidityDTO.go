@@ -0,0 +1,8 @@
+package dto
+
+import "time"
+
+type GrantValidityDTO struct {
+	ValidFrom  *time.Time `json:"valid_from"`
+	ValidUntil *time.Time `json:"valid_until"`
+}
@@ -0,0 +1,14 @@
+package dto
+
+import "time"
+
+type ListAuditEntriesQueryDTO struct {
+	Actor      string     `query:"actor"`
+	Action     string     `query:"action"`
+	TargetType string     `query:"targetType"`
+	Target     string     `query:"target"`
+	From       *time.Time `query:"from"`
+	To         *time.Time `query:"to"`
+	Page       int        `query:"page" validate:"gte=0"`
+	Size       int        `query:"size" validate:"gte=0"`
+}
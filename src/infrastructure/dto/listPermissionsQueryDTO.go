@@ -0,0 +1,7 @@
+package dto
+
+type ListPermissionsQueryDTO struct {
+	Prefix string `query:"prefix"`
+	Page   int    `query:"page" validate:"gte=0"`
+	Size   int    `query:"size" validate:"gte=0"`
+}
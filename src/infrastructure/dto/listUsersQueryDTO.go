@@ -0,0 +1,8 @@
+package dto
+
+type ListUsersQueryDTO struct {
+	Role       string `query:"role"`
+	Permission string `query:"permission"`
+	Page       int    `query:"page" validate:"gte=0"`
+	Size       int    `query:"size" validate:"gte=0"`
+}
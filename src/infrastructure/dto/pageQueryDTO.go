@@ -0,0 +1,6 @@
+package dto
+
+type PageQueryDTO struct {
+	Page int `query:"page" validate:"gte=0"`
+	Size int `query:"size" validate:"gte=0"`
+}
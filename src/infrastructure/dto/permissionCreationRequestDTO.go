@@ -1,5 +1,7 @@
 package dto
 
 type PermissionCreationRequestDTO struct {
-	Name string `json:"name" validate:"required"`
+	Name        string `json:"name" validate:"required"`
+	Description string `json:"description"`
+	Service     string `json:"service"`
 }
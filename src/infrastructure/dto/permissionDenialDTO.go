@@ -0,0 +1,7 @@
+package dto
+
+type PermissionDenialDTO struct {
+	Permission string `json:"permission"`
+	Source     string `json:"source"`
+	Role       string `json:"role,omitempty"`
+}
@@ -0,0 +1,10 @@
+package dto
+
+type PermissionExplanationDTO struct {
+	Permission     string               `json:"permission"`
+	Granted        bool                 `json:"granted"`
+	Source         string               `json:"source,omitempty"`
+	RolePath       []string             `json:"role_path,omitempty"`
+	Denial         *PermissionDenialDTO `json:"denial,omitempty"`
+	ClosestMatches []string             `json:"closest_matches,omitempty"`
+}
@@ -1,5 +1,7 @@
 package dto
 
 type PermissionResponseDTO struct {
-	Name string `json:"name"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Service     string `json:"service"`
 }
@@ -0,0 +1,6 @@
+package dto
+
+type ResourceDTO struct {
+	Type string `json:"type" validate:"required"`
+	ID   string `json:"id"`
+}
@@ -0,0 +1,7 @@
+package dto
+
+type ResourcePermissionGrantRequestDTO struct {
+	Permission   string `json:"permission" validate:"required"`
+	ResourceType string `json:"resource_type" validate:"required"`
+	ResourceID   string `json:"resource_id"`
+}
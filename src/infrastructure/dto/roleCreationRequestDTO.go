@@ -1,6 +1,8 @@
 package dto
 
 type RoleCreationRequestDTO struct {
-	Name        string   `json:"name" validate:"required"`
-	Permissions []string `json:"permissions" validate:"required"`
+	Name              string   `json:"name" validate:"required"`
+	Permissions       []string `json:"permissions" validate:"required"`
+	DeniedPermissions []string `json:"denied_permissions"`
+	Parents           []string `json:"parents"`
 }
@@ -0,0 +1,8 @@
+package dto
+
+type RolePageResponseDTO struct {
+	Items []RoleResponseDTO `json:"items"`
+	Page  int               `json:"page"`
+	Size  int               `json:"size"`
+	Total int64             `json:"total"`
+}
@@ -1,6 +1,8 @@
 package dto
 
 type RoleResponseDTO struct {
-	Name        string                  `json:"name"`
-	Permissions []PermissionResponseDTO `json:"permissions"`
+	Name              string                  `json:"name"`
+	Permissions       []PermissionResponseDTO `json:"permissions"`
+	DeniedPermissions []PermissionResponseDTO `json:"deniedPermissions,omitempty"`
+	Parents           []string                `json:"parents,omitempty"`
 }
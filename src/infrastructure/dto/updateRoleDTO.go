@@ -0,0 +1,5 @@
+package dto
+
+type UpdateRoleDTO struct {
+	Permissions []string `json:"permissions" validate:"required"`
+}
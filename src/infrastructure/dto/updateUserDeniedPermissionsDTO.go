@@ -0,0 +1,5 @@
+package dto
+
+type UpdateUserDeniedPermissionsDTO struct {
+	DeniedPermissions []string `json:"denied_permissions" validate:"required"`
+}
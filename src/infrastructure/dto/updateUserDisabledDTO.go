@@ -0,0 +1,5 @@
+package dto
+
+type UpdateUserDisabledDTO struct {
+	Disabled *bool `json:"disabled" validate:"required"`
+}
@@ -1,5 +1,6 @@
 package dto
 
 type UpdateUserPermissionsDTO struct {
-	Permissions []string `json:"permissions" validate:"required"`
+	Permissions []string                    `json:"permissions" validate:"required"`
+	Validities  map[string]GrantValidityDTO `json:"validities"`
 }
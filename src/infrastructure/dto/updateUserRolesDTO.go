@@ -1,5 +1,6 @@
 package dto
 
 type UpdateUserRolesDTO struct {
-	Roles []string `json:"roles" validate:"required"`
+	Roles      []string                    `json:"roles" validate:"required"`
+	Validities map[string]GrantValidityDTO `json:"validities"`
 }
@@ -0,0 +1,6 @@
+package dto
+
+type UserCreationRequestDTO struct {
+	Email     string `json:"email" validate:"required,email"`
+	Superuser bool   `json:"superuser"`
+}
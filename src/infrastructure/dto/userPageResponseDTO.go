@@ -0,0 +1,8 @@
+package dto
+
+type UserPageResponseDTO struct {
+	Items []UserResponseDTO `json:"items"`
+	Page  int               `json:"page"`
+	Size  int               `json:"size"`
+	Total int64             `json:"total"`
+}
@@ -0,0 +1,10 @@
+package dto
+
+type UserResponseDTO struct {
+	Email             string   `json:"email"`
+	Superuser         bool     `json:"superuser"`
+	Disabled          bool     `json:"disabled"`
+	Roles             []string `json:"roles"`
+	Permissions       []string `json:"permissions"`
+	DeniedPermissions []string `json:"deniedPermissions"`
+}
@@ -0,0 +1,31 @@
+package files
+
+import (
+	"context"
+	"encoding/json"
+	"go-as/src/domain/decision"
+	"go-as/src/infrastructure/transformers"
+	"io"
+)
+
+type JSONLDecisionSink struct {
+	writer              io.Writer
+	decisionTransformer *transformers.DecisionToDTOTransformer
+}
+
+func (sink *JSONLDecisionSink) Write(ctx context.Context, decisions []decision.Decision) error {
+	encoder := json.NewEncoder(sink.writer)
+	for _, recordedDecision := range decisions {
+		if err := encoder.Encode(sink.decisionTransformer.Transform(recordedDecision)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func NewJSONLDecisionSink(writer io.Writer, decisionTransformer *transformers.DecisionToDTOTransformer) *JSONLDecisionSink {
+	return &JSONLDecisionSink{
+		writer:              writer,
+		decisionTransformer: decisionTransformer,
+	}
+}
@@ -0,0 +1,172 @@
+package logging
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"go-as/src/domain/decision"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.elastic.co/apm/v2"
+	"go.uber.org/zap"
+)
+
+const SubjectDecisionField = "subject"
+const PermissionsDecisionField = "permissions"
+const ReasonDecisionField = "reason"
+
+const redactedReason = "[REDACTED]"
+
+type DecisionLogSettings struct {
+	SampleRate     float64
+	RedactedFields []string
+	HashKey        []byte
+	BufferSize     int
+	BatchSize      int
+	FlushInterval  time.Duration
+}
+
+type AsyncDecisionLog struct {
+	sink             decision.DecisionSink
+	settings         DecisionLogSettings
+	redactedFields   map[string]bool
+	decisions        chan decision.Decision
+	droppedDecisions uint64
+	closing          chan struct{}
+	closeOnce        sync.Once
+	done             chan struct{}
+	logger           *zap.Logger
+}
+
+func (decisionLog *AsyncDecisionLog) Record(ctx context.Context, recordedDecision decision.Decision) {
+	if recordedDecision.Allowed && rand.Float64() >= decisionLog.settings.SampleRate {
+		return
+	}
+	if recordedDecision.TraceID == "" {
+		if transaction := apm.TransactionFromContext(ctx); transaction != nil {
+			recordedDecision.TraceID = transaction.TraceContext().Trace.String()
+		}
+	}
+	if recordedDecision.DecidedAt.IsZero() {
+		recordedDecision.DecidedAt = time.Now().UTC()
+	}
+
+	select {
+	case decisionLog.decisions <- decisionLog.redact(recordedDecision):
+	default:
+		atomic.AddUint64(&decisionLog.droppedDecisions, 1)
+	}
+}
+
+func (decisionLog *AsyncDecisionLog) redact(recordedDecision decision.Decision) decision.Decision {
+	if decisionLog.redactedFields[SubjectDecisionField] {
+		recordedDecision.Subject = decisionLog.hash(recordedDecision.Subject)
+	}
+	if decisionLog.redactedFields[PermissionsDecisionField] {
+		redactedPermissions := make([]string, 0, len(recordedDecision.Permissions))
+		for _, permissionName := range recordedDecision.Permissions {
+			redactedPermissions = append(redactedPermissions, decisionLog.hash(permissionName))
+		}
+		recordedDecision.Permissions = redactedPermissions
+	}
+	if decisionLog.redactedFields[ReasonDecisionField] {
+		recordedDecision.Reason = redactedReason
+	}
+	return recordedDecision
+}
+
+func (decisionLog *AsyncDecisionLog) hash(value string) string {
+	mac := hmac.New(sha256.New, decisionLog.settings.HashKey)
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (decisionLog *AsyncDecisionLog) Run() {
+	go decisionLog.writePeriodically()
+}
+
+// Close stops the periodic writes and flushes the buffered decisions to the sink,
+// waiting until the flush finishes or ctx is done
+func (decisionLog *AsyncDecisionLog) Close(ctx context.Context) error {
+	decisionLog.closeOnce.Do(func() {
+		close(decisionLog.closing)
+	})
+	select {
+	case <-decisionLog.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (decisionLog *AsyncDecisionLog) writePeriodically() {
+	defer close(decisionLog.done)
+	ticker := time.NewTicker(decisionLog.settings.FlushInterval)
+	defer ticker.Stop()
+	batch := make([]decision.Decision, 0, decisionLog.settings.BatchSize)
+	for {
+		select {
+		case recordedDecision := <-decisionLog.decisions:
+			batch = append(batch, recordedDecision)
+			if len(batch) >= decisionLog.settings.BatchSize {
+				decisionLog.write(batch)
+				batch = batch[:0]
+			}
+		case <-ticker.C:
+			decisionLog.write(batch)
+			batch = batch[:0]
+		case <-decisionLog.closing:
+			decisionLog.drain(batch)
+			return
+		}
+	}
+}
+
+func (decisionLog *AsyncDecisionLog) drain(batch []decision.Decision) {
+	for {
+		select {
+		case recordedDecision := <-decisionLog.decisions:
+			batch = append(batch, recordedDecision)
+			if len(batch) >= decisionLog.settings.BatchSize {
+				decisionLog.write(batch)
+				batch = batch[:0]
+			}
+		default:
+			decisionLog.write(batch)
+			return
+		}
+	}
+}
+
+func (decisionLog *AsyncDecisionLog) write(batch []decision.Decision) {
+	if droppedDecisions := atomic.SwapUint64(&decisionLog.droppedDecisions, 0); droppedDecisions > 0 {
+		decisionLog.logger.Warn(fmt.Sprintf("Dropped %d decisions because the decision log buffer is full", droppedDecisions))
+	}
+	if len(batch) == 0 {
+		return
+	}
+	if err := decisionLog.sink.Write(context.Background(), batch); err != nil {
+		decisionLog.logger.Warn(fmt.Sprintf("Error writing %d decisions to the decision log: %s", len(batch), err.Error()))
+	}
+}
+
+func NewAsyncDecisionLog(sink decision.DecisionSink, settings DecisionLogSettings, logger *zap.Logger) *AsyncDecisionLog {
+	redactedFields := make(map[string]bool, len(settings.RedactedFields))
+	for _, field := range settings.RedactedFields {
+		redactedFields[field] = true
+	}
+	return &AsyncDecisionLog{
+		sink:           sink,
+		settings:       settings,
+		redactedFields: redactedFields,
+		decisions:      make(chan decision.Decision, settings.BufferSize),
+		closing:        make(chan struct{}),
+		done:           make(chan struct{}),
+		logger:         logger,
+	}
+}
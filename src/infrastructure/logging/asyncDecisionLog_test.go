@@ -0,0 +1,79 @@
+package logging
+
+import (
+	"context"
+	"go-as/src/domain/decision"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type fakeDecisionSink struct {
+	mutex     sync.Mutex
+	decisions []decision.Decision
+}
+
+func (sink *fakeDecisionSink) Write(_ context.Context, decisions []decision.Decision) error {
+	sink.mutex.Lock()
+	defer sink.mutex.Unlock()
+	sink.decisions = append(sink.decisions, decisions...)
+	return nil
+}
+
+func (sink *fakeDecisionSink) written() []decision.Decision {
+	sink.mutex.Lock()
+	defer sink.mutex.Unlock()
+	return append([]decision.Decision{}, sink.decisions...)
+}
+
+func newTestDecisionLog(sink decision.DecisionSink, hashKey string) *AsyncDecisionLog {
+	return NewAsyncDecisionLog(sink, DecisionLogSettings{
+		SampleRate:     1,
+		RedactedFields: []string{SubjectDecisionField},
+		HashKey:        []byte(hashKey),
+		BufferSize:     10,
+		BatchSize:      100,
+		FlushInterval:  time.Hour,
+	}, zap.NewNop())
+}
+
+func TestCloseFlushesBufferedDecisions(t *testing.T) {
+	sink := &fakeDecisionSink{}
+	decisionLog := newTestDecisionLog(sink, "key")
+	decisionLog.Run()
+	ctx := context.Background()
+	decisionLog.Record(ctx, decision.Decision{Subject: "user@test.com", Allowed: true})
+	decisionLog.Record(ctx, decision.Decision{Subject: "other@test.com", Allowed: false})
+
+	if err := decisionLog.Close(ctx); err != nil {
+		t.Fatalf("Expected decision log to close, got %s", err.Error())
+	}
+
+	if len(sink.written()) != 2 {
+		t.Fatal("Expected buffered decisions to be flushed on close")
+	}
+}
+
+func TestCloseReturnsContextErrorWhenNotRunning(t *testing.T) {
+	decisionLog := newTestDecisionLog(&fakeDecisionSink{}, "key")
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := decisionLog.Close(ctx); err == nil {
+		t.Fatal("Expected close to return the context error")
+	}
+}
+
+func TestRedactHashesSubjectWithKey(t *testing.T) {
+	redacted := newTestDecisionLog(&fakeDecisionSink{}, "key").redact(decision.Decision{Subject: "user@test.com"})
+	otherKeyRedacted := newTestDecisionLog(&fakeDecisionSink{}, "otherKey").redact(decision.Decision{Subject: "user@test.com"})
+
+	if redacted.Subject == "user@test.com" {
+		t.Fatal("Expected subject to be redacted")
+	}
+	if redacted.Subject == otherKeyRedacted.Subject {
+		t.Fatal("Expected subject hash to depend on the key")
+	}
+}
@@ -0,0 +1,43 @@
+package messaging
+
+import "github.com/streadway/amqp"
+
+type AMQPChannel interface {
+	ExchangeDeclare(name, kind string, durable, autoDelete, internal, noWait bool, args amqp.Table) error
+	QueueDeclare(name string, durable, autoDelete, exclusive, noWait bool, args amqp.Table) (amqp.Queue, error)
+	QueueBind(name, key, exchange string, noWait bool, args amqp.Table) error
+	Qos(prefetchCount, prefetchSize int, global bool) error
+	Consume(queue, consumer string, autoAck, exclusive, noLocal, noWait bool, args amqp.Table) (<-chan amqp.Delivery, error)
+	Publish(exchange, key string, mandatory, immediate bool, msg amqp.Publishing) error
+	Confirm(noWait bool) error
+	NotifyPublish(confirm chan amqp.Confirmation) chan amqp.Confirmation
+	NotifyClose(receiver chan *amqp.Error) chan *amqp.Error
+	Close() error
+}
+
+type AMQPConnection interface {
+	Channel() (AMQPChannel, error)
+	NotifyClose(receiver chan *amqp.Error) chan *amqp.Error
+	IsClosed() bool
+	Close() error
+}
+
+type AMQPDialer func() (AMQPConnection, error)
+
+type amqpConnectionAdapter struct {
+	*amqp.Connection
+}
+
+func (adapter amqpConnectionAdapter) Channel() (AMQPChannel, error) {
+	return adapter.Connection.Channel()
+}
+
+func NewAMQPDialer(url string) AMQPDialer {
+	return func() (AMQPConnection, error) {
+		connection, err := amqp.Dial(url)
+		if err != nil {
+			return nil, err
+		}
+		return amqpConnectionAdapter{connection}, nil
+	}
+}
@@ -0,0 +1,187 @@
+package messaging
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/streadway/amqp"
+	"go.uber.org/zap"
+)
+
+type AMQPConnectionSettings struct {
+	InitialBackoff           time.Duration
+	MaxBackoff               time.Duration
+	MaxChannelReopenAttempts int
+}
+
+type AMQPConnectionManager struct {
+	dial       AMQPDialer
+	settings   AMQPConnectionSettings
+	mutex      sync.Mutex
+	connection AMQPConnection
+	channels   []*AMQPManagedChannel
+	closed     bool
+	connected  int32
+	logger     *zap.Logger
+}
+
+func (manager *AMQPConnectionManager) Connect() {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+	manager.connectWithBackoff()
+}
+
+func (manager *AMQPConnectionManager) Channel() (*AMQPManagedChannel, error) {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+	if manager.connection == nil {
+		return nil, amqp.ErrClosed
+	}
+	channel, err := manager.connection.Channel()
+	if err != nil {
+		return nil, err
+	}
+	managedChannel := &AMQPManagedChannel{}
+	manager.channels = append(manager.channels, managedChannel)
+	manager.attach(managedChannel, channel)
+	return managedChannel, nil
+}
+
+func (manager *AMQPConnectionManager) IsConnected() bool {
+	return atomic.LoadInt32(&manager.connected) == 1
+}
+
+func (manager *AMQPConnectionManager) Close() error {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+	manager.closed = true
+	if manager.connection == nil {
+		return nil
+	}
+	return manager.connection.Close()
+}
+
+func (manager *AMQPConnectionManager) connectWithBackoff() {
+	backoff := manager.settings.InitialBackoff
+	for {
+		connection, err := manager.dial()
+		if err == nil {
+			manager.connection = connection
+			atomic.StoreInt32(&manager.connected, 1)
+			go manager.watchConnection(connection.NotifyClose(make(chan *amqp.Error, 1)))
+			return
+		}
+		manager.logger.Warn(fmt.Sprintf("Error connecting to the AMQP server, retrying in %s: %s", backoff, err.Error()))
+		time.Sleep(backoff)
+		backoff = manager.nextBackoff(backoff)
+	}
+}
+
+func (manager *AMQPConnectionManager) nextBackoff(backoff time.Duration) time.Duration {
+	backoff *= 2
+	if backoff > manager.settings.MaxBackoff {
+		return manager.settings.MaxBackoff
+	}
+	return backoff
+}
+
+func (manager *AMQPConnectionManager) reopenBackoff(failures int) time.Duration {
+	backoff := manager.settings.InitialBackoff
+	for attempt := 0; attempt < failures && backoff < manager.settings.MaxBackoff; attempt++ {
+		backoff = manager.nextBackoff(backoff)
+	}
+	return backoff
+}
+
+func (manager *AMQPConnectionManager) watchConnection(closeNotifications chan *amqp.Error) {
+	closeError, ok := <-closeNotifications
+	atomic.StoreInt32(&manager.connected, 0)
+	if !ok || closeError == nil {
+		return
+	}
+	manager.logger.Warn(fmt.Sprintf("AMQP connection lost: %s", closeError.Error()))
+	manager.reconnect()
+}
+
+func (manager *AMQPConnectionManager) reconnect() {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+	if manager.closed {
+		return
+	}
+	for _, managedChannel := range manager.channels {
+		managedChannel.detach()
+	}
+	manager.connectWithBackoff()
+	for _, managedChannel := range manager.channels {
+		managedChannel.resetReopenFailures()
+		manager.reopen(managedChannel)
+	}
+	manager.logger.Info("AMQP connection recovered")
+}
+
+func (manager *AMQPConnectionManager) reopen(managedChannel *AMQPManagedChannel) bool {
+	channel, err := manager.connection.Channel()
+	if err != nil {
+		manager.logger.Warn(fmt.Sprintf("Error reopening AMQP channel: %s", err.Error()))
+		managedChannel.recordReopenFailure()
+		return false
+	}
+	manager.attach(managedChannel, channel)
+	return true
+}
+
+func (manager *AMQPConnectionManager) attach(managedChannel *AMQPManagedChannel, channel AMQPChannel) {
+	closeNotifications := channel.NotifyClose(make(chan *amqp.Error, 1))
+	errs := managedChannel.replace(channel)
+	for _, err := range errs {
+		manager.logger.Warn(fmt.Sprintf("Error setting up AMQP channel: %s", err.Error()))
+	}
+	if len(errs) > 0 {
+		managedChannel.recordReopenFailure()
+	} else {
+		managedChannel.resetReopenFailures()
+	}
+	go manager.watchChannel(managedChannel, channel, closeNotifications)
+}
+
+// watchChannel reopens a channel closed by the broker with the same backoff as reconnections,
+// and stops replaying its setups once they failed MaxChannelReopenAttempts times in a row
+func (manager *AMQPConnectionManager) watchChannel(managedChannel *AMQPManagedChannel, channel AMQPChannel, closeNotifications chan *amqp.Error) {
+	closeError, ok := <-closeNotifications
+	if !ok || closeError == nil {
+		return
+	}
+	manager.logger.Warn(fmt.Sprintf("AMQP channel closed: %s", closeError.Error()))
+	for {
+		failures := managedChannel.reopenFailures()
+		if failures >= manager.settings.MaxChannelReopenAttempts {
+			manager.logger.Error(fmt.Sprintf("Giving up reopening AMQP channel after %d failed attempts", failures))
+			managedChannel.detachIfCurrent(channel)
+			return
+		}
+		time.Sleep(manager.reopenBackoff(failures))
+		if manager.reopenIfCurrent(managedChannel, channel) {
+			return
+		}
+	}
+}
+
+func (manager *AMQPConnectionManager) reopenIfCurrent(managedChannel *AMQPManagedChannel, channel AMQPChannel) bool {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+	if manager.closed || managedChannel.current() != channel || manager.connection.IsClosed() {
+		return true
+	}
+	return manager.reopen(managedChannel)
+}
+
+func NewAMQPConnectionManager(dial AMQPDialer, settings AMQPConnectionSettings, logger *zap.Logger) *AMQPConnectionManager {
+	return &AMQPConnectionManager{
+		dial:     dial,
+		settings: settings,
+		logger:   logger,
+	}
+}
@@ -0,0 +1,426 @@
+package messaging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-as/src/domain/events"
+	"go-as/src/infrastructure/transformers"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/streadway/amqp"
+	"go.uber.org/zap"
+)
+
+type fakeBroker struct {
+	mutex          sync.Mutex
+	dials          int
+	failingDials   int
+	connections    []*fakeConnection
+	declaredQueues map[string]int
+	queueArgs      map[string]amqp.Table
+	consumers      map[string][]chan amqp.Delivery
+	published      map[string][]amqp.Publishing
+	acks           int
+	deadLetters    int
+	requeues       int
+	prefetch       int
+	failingSetups  bool
+}
+
+func (broker *fakeBroker) dial() (AMQPConnection, error) {
+	broker.mutex.Lock()
+	defer broker.mutex.Unlock()
+	broker.dials++
+	if broker.failingDials > 0 {
+		broker.failingDials--
+		return nil, errors.New("Test dial error")
+	}
+	connection := &fakeConnection{broker: broker}
+	broker.connections = append(broker.connections, connection)
+	return connection, nil
+}
+
+func (broker *fakeBroker) dropConnection() {
+	broker.mutex.Lock()
+	connection := broker.connections[len(broker.connections)-1]
+	broker.consumers = make(map[string][]chan amqp.Delivery)
+	broker.mutex.Unlock()
+	connection.drop()
+}
+
+func (broker *fakeBroker) deliver(queue string, body string) bool {
+	return broker.deliverWithHeaders(queue, body, nil)
+}
+
+func (broker *fakeBroker) deliverWithHeaders(queue string, body string, headers amqp.Table) bool {
+	broker.mutex.Lock()
+	consumers := broker.consumers[queue]
+	broker.mutex.Unlock()
+	if len(consumers) == 0 {
+		return false
+	}
+	consumers[0] <- amqp.Delivery{Acknowledger: broker, Headers: headers, Body: []byte(body)}
+	return true
+}
+
+func (broker *fakeBroker) publishedTo(queue string) []amqp.Publishing {
+	broker.mutex.Lock()
+	defer broker.mutex.Unlock()
+	return append([]amqp.Publishing{}, broker.published[queue]...)
+}
+
+func (broker *fakeBroker) Ack(tag uint64, multiple bool) error {
+	broker.mutex.Lock()
+	defer broker.mutex.Unlock()
+	broker.acks++
+	return nil
+}
+
+func (broker *fakeBroker) Nack(tag uint64, multiple bool, requeue bool) error {
+	broker.mutex.Lock()
+	defer broker.mutex.Unlock()
+	if requeue {
+		broker.requeues++
+	} else {
+		broker.deadLetters++
+	}
+	return nil
+}
+
+func (*fakeBroker) Reject(tag uint64, requeue bool) error {
+	return nil
+}
+
+func (broker *fakeBroker) stats() (int, int, int, int) {
+	broker.mutex.Lock()
+	defer broker.mutex.Unlock()
+	return broker.dials, broker.declaredQueues["as.TestEvent"], len(broker.consumers["as.TestEvent"]), broker.acks
+}
+
+type fakeConnection struct {
+	broker   *fakeBroker
+	mutex    sync.Mutex
+	closed   bool
+	closes   []chan *amqp.Error
+	channels []*fakeChannel
+}
+
+func (connection *fakeConnection) Channel() (AMQPChannel, error) {
+	connection.mutex.Lock()
+	defer connection.mutex.Unlock()
+	if connection.closed {
+		return nil, amqp.ErrClosed
+	}
+	channel := &fakeChannel{broker: connection.broker}
+	connection.channels = append(connection.channels, channel)
+	return channel, nil
+}
+
+func (connection *fakeConnection) NotifyClose(receiver chan *amqp.Error) chan *amqp.Error {
+	connection.mutex.Lock()
+	defer connection.mutex.Unlock()
+	connection.closes = append(connection.closes, receiver)
+	return receiver
+}
+
+func (connection *fakeConnection) IsClosed() bool {
+	connection.mutex.Lock()
+	defer connection.mutex.Unlock()
+	return connection.closed
+}
+
+func (connection *fakeConnection) Close() error {
+	connection.mutex.Lock()
+	defer connection.mutex.Unlock()
+	connection.closed = true
+	for _, receiver := range connection.closes {
+		close(receiver)
+	}
+	return nil
+}
+
+func (connection *fakeConnection) drop() {
+	connection.mutex.Lock()
+	connection.closed = true
+	channels := connection.channels
+	closes := connection.closes
+	connection.mutex.Unlock()
+	for _, channel := range channels {
+		channel.drop()
+	}
+	for _, receiver := range closes {
+		receiver <- amqp.ErrClosed
+		close(receiver)
+	}
+}
+
+type fakeChannel struct {
+	broker *fakeBroker
+	mutex  sync.Mutex
+	closed bool
+	closes []chan *amqp.Error
+}
+
+func (*fakeChannel) ExchangeDeclare(name, kind string, durable, autoDelete, internal, noWait bool, args amqp.Table) error {
+	return nil
+}
+
+func (channel *fakeChannel) QueueDeclare(name string, durable, autoDelete, exclusive, noWait bool, args amqp.Table) (amqp.Queue, error) {
+	channel.broker.mutex.Lock()
+	defer channel.broker.mutex.Unlock()
+	channel.broker.declaredQueues[name]++
+	channel.broker.queueArgs[name] = args
+	if channel.broker.failingSetups {
+		channel.drop()
+		return amqp.Queue{}, errors.New("Test queue declaration error")
+	}
+	return amqp.Queue{Name: name}, nil
+}
+
+func (*fakeChannel) QueueBind(name, key, exchange string, noWait bool, args amqp.Table) error {
+	return nil
+}
+
+func (channel *fakeChannel) Qos(prefetchCount, prefetchSize int, global bool) error {
+	channel.broker.mutex.Lock()
+	defer channel.broker.mutex.Unlock()
+	channel.broker.prefetch = prefetchCount
+	return nil
+}
+
+func (channel *fakeChannel) Consume(queue, consumer string, autoAck, exclusive, noLocal, noWait bool, args amqp.Table) (<-chan amqp.Delivery, error) {
+	deliveries := make(chan amqp.Delivery, 1)
+	channel.broker.mutex.Lock()
+	defer channel.broker.mutex.Unlock()
+	channel.broker.consumers[queue] = append(channel.broker.consumers[queue], deliveries)
+	return deliveries, nil
+}
+
+func (channel *fakeChannel) Publish(exchange, key string, mandatory, immediate bool, msg amqp.Publishing) error {
+	channel.broker.mutex.Lock()
+	defer channel.broker.mutex.Unlock()
+	channel.broker.published[key] = append(channel.broker.published[key], msg)
+	return nil
+}
+
+func (*fakeChannel) Confirm(noWait bool) error {
+	return nil
+}
+
+func (*fakeChannel) NotifyPublish(confirm chan amqp.Confirmation) chan amqp.Confirmation {
+	return confirm
+}
+
+func (channel *fakeChannel) NotifyClose(receiver chan *amqp.Error) chan *amqp.Error {
+	channel.mutex.Lock()
+	defer channel.mutex.Unlock()
+	channel.closes = append(channel.closes, receiver)
+	return receiver
+}
+
+func (channel *fakeChannel) Close() error {
+	channel.mutex.Lock()
+	defer channel.mutex.Unlock()
+	channel.closed = true
+	return nil
+}
+
+func (channel *fakeChannel) drop() {
+	channel.mutex.Lock()
+	channel.closed = true
+	closes := channel.closes
+	channel.mutex.Unlock()
+	for _, receiver := range closes {
+		receiver <- amqp.ErrClosed
+		close(receiver)
+	}
+}
+
+func newFakeBroker(failingDials int) *fakeBroker {
+	return &fakeBroker{
+		failingDials:   failingDials,
+		declaredQueues: make(map[string]int),
+		queueArgs:      make(map[string]amqp.Table),
+		consumers:      make(map[string][]chan amqp.Delivery),
+		published:      make(map[string][]amqp.Publishing),
+	}
+}
+
+func newTestConnectionManager(broker *fakeBroker) *AMQPConnectionManager {
+	settings := AMQPConnectionSettings{
+		InitialBackoff:           time.Millisecond,
+		MaxBackoff:               5 * time.Millisecond,
+		MaxChannelReopenAttempts: 2,
+	}
+	return NewAMQPConnectionManager(broker.dial, settings, zap.NewNop())
+}
+
+func waitFor(t *testing.T, condition func() bool, message string) {
+	deadline := time.Now().Add(2 * time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatal(message)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func newTestListener(t *testing.T, connectionManager *AMQPConnectionManager, settings EventListenerSettings) events.EventListener {
+	amqpChannel, err := connectionManager.Channel()
+	if err != nil {
+		t.Fatal("Expected channel to be created")
+	}
+	factory := NewAMQPQueueEventListenerFactory(
+		amqpChannel,
+		NewAMQPExchangeManager(amqpChannel),
+		newTestEventRegistry(),
+		transformers.NewEventMessageToEnvelopeTransformer(),
+		settings,
+		zap.NewNop(),
+	)
+	listener, err := factory.CreateListener("TestEvent")
+	if err != nil {
+		t.Fatal("Expected listener to be created")
+	}
+	return listener
+}
+
+func listenTestEvents(t *testing.T, connectionManager *AMQPConnectionManager, handledEvents chan *TestEvent) {
+	listener := newTestListener(t, connectionManager, EventListenerSettings{MaxRetries: 1, RetryDelay: time.Second, Prefetch: 1, Workers: 1})
+	err := listener.Listen(events.HandleTyped(func(_ context.Context, event *TestEvent) error {
+		handledEvents <- event
+		return nil
+	}))
+	if err != nil {
+		t.Fatal("Expected listener to start listening")
+	}
+}
+
+func TestConnectRetriesUntilBrokerIsAvailable(t *testing.T) {
+	broker := newFakeBroker(3)
+	connectionManager := newTestConnectionManager(broker)
+
+	connectionManager.Connect()
+
+	dials, _, _, _ := broker.stats()
+	if dials != 4 {
+		t.Fatal("Expected connection manager to dial until the broker accepts the connection")
+	}
+	if !connectionManager.IsConnected() {
+		t.Fatal("Expected connection manager to be connected")
+	}
+}
+
+func TestReconnectRedeclaresQueuesAndResubscribesListeners(t *testing.T) {
+	broker := newFakeBroker(0)
+	connectionManager := newTestConnectionManager(broker)
+	connectionManager.Connect()
+	handledEvents := make(chan *TestEvent, 1)
+	listenTestEvents(t, connectionManager, handledEvents)
+
+	broker.mutex.Lock()
+	broker.failingDials = 2
+	broker.mutex.Unlock()
+	broker.dropConnection()
+
+	waitFor(t, func() bool {
+		dials, declarations, consumers, _ := broker.stats()
+		return dials == 4 && declarations == 2 && consumers == 1
+	}, "Expected queues to be redeclared and the listener to be resubscribed after reconnecting")
+	if !connectionManager.IsConnected() {
+		t.Fatal("Expected connection manager to be connected after reconnecting")
+	}
+	if !broker.deliver("as.TestEvent", `{"Email":"testEmail"}`) {
+		t.Fatal("Expected a consumer for the event queue")
+	}
+	select {
+	case event := <-handledEvents:
+		if event.Email != "testEmail" {
+			t.Fatal("Expected handled event to match the delivered message")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Expected resubscribed listener to handle the delivered message")
+	}
+	waitFor(t, func() bool {
+		_, _, _, acks := broker.stats()
+		return acks == 1
+	}, "Expected handled message to be acknowledged")
+}
+
+func TestHealthReflectsLostConnection(t *testing.T) {
+	broker := newFakeBroker(0)
+	connectionManager := newTestConnectionManager(broker)
+	connectionManager.Connect()
+	healthChecker := NewAMQPHealthChecker(connectionManager)
+
+	broker.mutex.Lock()
+	broker.failingDials = 1000000
+	broker.mutex.Unlock()
+	broker.dropConnection()
+
+	waitFor(t, func() bool {
+		return healthChecker.Check() != nil
+	}, "Expected health checker to report the lost connection")
+
+	broker.mutex.Lock()
+	broker.failingDials = 0
+	broker.mutex.Unlock()
+
+	waitFor(t, func() bool {
+		return healthChecker.Check() == nil
+	}, "Expected health checker to report the recovered connection")
+}
+
+func TestCloseDoesNotReconnect(t *testing.T) {
+	broker := newFakeBroker(0)
+	connectionManager := newTestConnectionManager(broker)
+	connectionManager.Connect()
+
+	if err := connectionManager.Close(); err != nil {
+		t.Fatal("Expected connection manager to close without error")
+	}
+
+	time.Sleep(10 * time.Millisecond)
+	dials, _, _, _ := broker.stats()
+	if dials != 1 {
+		t.Fatal("Expected closed connection manager not to reconnect")
+	}
+}
+
+func TestChannelReopenStopsAfterRepeatedSetupFailures(t *testing.T) {
+	broker := newFakeBroker(0)
+	connectionManager := newTestConnectionManager(broker)
+	connectionManager.Connect()
+	managedChannel, err := connectionManager.Channel()
+	if err != nil {
+		t.Fatal("Expected channel to be created")
+	}
+	err = managedChannel.Setup(func(channel AMQPChannel) error {
+		_, err := channel.QueueDeclare("as.TestEvent", true, false, false, false, nil)
+		return err
+	})
+	if err != nil {
+		t.Fatal("Expected channel setup to succeed")
+	}
+
+	broker.mutex.Lock()
+	broker.failingSetups = true
+	connection := broker.connections[len(broker.connections)-1]
+	broker.mutex.Unlock()
+	connection.mutex.Lock()
+	channel := connection.channels[len(connection.channels)-1]
+	connection.mutex.Unlock()
+	channel.drop()
+
+	waitFor(t, func() bool {
+		return managedChannel.current() == nil
+	}, "Expected channel to be given up after repeated setup failures")
+	time.Sleep(20 * time.Millisecond)
+	_, declarations, _, _ := broker.stats()
+	if declarations != 3 {
+		t.Fatal(fmt.Sprintf("Expected setups to be replayed only up to the max reopen attempts, got %d declarations", declarations))
+	}
+}
@@ -0,0 +1,42 @@
+package messaging
+
+import (
+	"context"
+	"go-as/src/domain/decision"
+	"go-as/src/infrastructure/transformers"
+)
+
+type AMQPDecisionSink struct {
+	amqpChannel               *AMQPManagedChannel
+	exchange                  string
+	decisionTransformer       *transformers.DecisionToDTOTransformer
+	eventToMessageTransformer *transformers.EventToAMQPMessageTransformer
+}
+
+func (sink *AMQPDecisionSink) Write(ctx context.Context, decisions []decision.Decision) error {
+	for _, recordedDecision := range decisions {
+		message, err := sink.eventToMessageTransformer.Transform(sink.decisionTransformer.Transform(recordedDecision))
+		if err != nil {
+			return err
+		}
+		if err = sink.amqpChannel.Publish(sink.exchange, "AS", false, false, *message); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func NewAMQPDecisionSink(amqpChannel *AMQPManagedChannel, exchange string, decisionTransformer *transformers.DecisionToDTOTransformer, eventToMessageTransformer *transformers.EventToAMQPMessageTransformer) (*AMQPDecisionSink, error) {
+	err := amqpChannel.Setup(func(channel AMQPChannel) error {
+		return channel.ExchangeDeclare(exchange, "fanout", true, false, false, false, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &AMQPDecisionSink{
+		amqpChannel:               amqpChannel,
+		exchange:                  exchange,
+		decisionTransformer:       decisionTransformer,
+		eventToMessageTransformer: eventToMessageTransformer,
+	}, nil
+}
@@ -1,18 +1,20 @@
 package messaging
 
 import (
-	"reflect"
-
-	"github.com/streadway/amqp"
+	"go-as/src/domain/events"
+	"sync"
 )
 
 type AMQPExchangeManager struct {
-	amqpChannel *amqp.Channel
+	amqpChannel *AMQPManagedChannel
+	mutex       sync.Mutex
 	exchanges   map[string]string
 }
 
 func (manager *AMQPExchangeManager) GetExchangeForEvent(event interface{}) (*string, error) {
-	eventType := manager.getEventTypeName(event)
+	eventType := events.EventName(event)
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
 	exchange := manager.exchanges[eventType]
 	if exchange != "" {
 		return &exchange, nil
@@ -27,23 +29,13 @@ func (manager *AMQPExchangeManager) GetExchangeForEvent(event interface{}) (*str
 	return &exchange, nil
 }
 
-func (manager *AMQPExchangeManager) getEventTypeName(event interface{}) string {
-	eventType := reflect.TypeOf(event)
-	if eventType.Kind() == reflect.Ptr {
-		eventType = eventType.Elem()
-	}
-	eventTypeName := eventType.Name()
-	if eventTypeName == "string" {
-		return event.(string)
-	}
-	return eventTypeName
-}
-
 func (manager *AMQPExchangeManager) createExchange(name string) error {
-	return manager.amqpChannel.ExchangeDeclare(name, "fanout", true, false, false, false, nil)
+	return manager.amqpChannel.Setup(func(channel AMQPChannel) error {
+		return channel.ExchangeDeclare(name, "fanout", true, false, false, false, nil)
+	})
 }
 
-func NewAMQPExchangeManager(amqpChannel *amqp.Channel) *AMQPExchangeManager {
+func NewAMQPExchangeManager(amqpChannel *AMQPManagedChannel) *AMQPExchangeManager {
 	manager := AMQPExchangeManager{
 		amqpChannel: amqpChannel,
 		exchanges:   make(map[string]string),
@@ -0,0 +1,89 @@
+package messaging
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/streadway/amqp"
+)
+
+type AMQPChannelSetup func(channel AMQPChannel) error
+
+type AMQPManagedChannel struct {
+	mutex    sync.RWMutex
+	channel  AMQPChannel
+	setups   []AMQPChannelSetup
+	failures int32
+}
+
+func (managedChannel *AMQPManagedChannel) Setup(setup AMQPChannelSetup) error {
+	managedChannel.mutex.Lock()
+	defer managedChannel.mutex.Unlock()
+	if managedChannel.channel == nil {
+		return amqp.ErrClosed
+	}
+	if err := setup(managedChannel.channel); err != nil {
+		return err
+	}
+	managedChannel.setups = append(managedChannel.setups, setup)
+	return nil
+}
+
+func (managedChannel *AMQPManagedChannel) Publish(exchange, key string, mandatory, immediate bool, msg amqp.Publishing) error {
+	channel := managedChannel.current()
+	if channel == nil {
+		return amqp.ErrClosed
+	}
+	return channel.Publish(exchange, key, mandatory, immediate, msg)
+}
+
+func (managedChannel *AMQPManagedChannel) current() AMQPChannel {
+	managedChannel.mutex.RLock()
+	defer managedChannel.mutex.RUnlock()
+	return managedChannel.channel
+}
+
+func (managedChannel *AMQPManagedChannel) replace(channel AMQPChannel) []error {
+	managedChannel.mutex.Lock()
+	previousChannel := managedChannel.channel
+	managedChannel.channel = channel
+	setups := append([]AMQPChannelSetup(nil), managedChannel.setups...)
+	managedChannel.mutex.Unlock()
+	if previousChannel != nil {
+		_ = previousChannel.Close()
+	}
+
+	var errs []error
+	for _, setup := range setups {
+		if err := setup(channel); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+func (managedChannel *AMQPManagedChannel) reopenFailures() int {
+	return int(atomic.LoadInt32(&managedChannel.failures))
+}
+
+func (managedChannel *AMQPManagedChannel) recordReopenFailure() {
+	atomic.AddInt32(&managedChannel.failures, 1)
+}
+
+func (managedChannel *AMQPManagedChannel) resetReopenFailures() {
+	atomic.StoreInt32(&managedChannel.failures, 0)
+}
+
+func (managedChannel *AMQPManagedChannel) detachIfCurrent(channel AMQPChannel) {
+	managedChannel.mutex.Lock()
+	defer managedChannel.mutex.Unlock()
+	if managedChannel.channel == channel {
+		managedChannel.channel = nil
+	}
+}
+
+func (managedChannel *AMQPManagedChannel) detach() {
+	managedChannel.mutex.Lock()
+	defer managedChannel.mutex.Unlock()
+	managedChannel.channel = nil
+}
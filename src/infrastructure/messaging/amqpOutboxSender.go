@@ -0,0 +1,93 @@
+package messaging
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go-as/src/domain/events"
+	"go-as/src/infrastructure/transformers"
+	"sync"
+	"time"
+
+	"github.com/streadway/amqp"
+)
+
+var ErrPublishNotConfirmed = errors.New("Event publication was not confirmed by the broker")
+
+type AMQPOutboxSender struct {
+	amqpExchangeManager       *AMQPExchangeManager
+	mutex                     sync.Mutex
+	confirmChannel            AMQPChannel
+	confirmations             chan amqp.Confirmation
+	lastDeliveryTag           uint64
+	eventToMessageTransformer *transformers.EventToAMQPMessageTransformer
+	confirmTimeout            time.Duration
+	confirmBufferSize         int
+}
+
+func (sender *AMQPOutboxSender) Send(pendingEvent events.OutboxEvent) error {
+	exchange, err := sender.amqpExchangeManager.GetExchangeForEvent(pendingEvent.EventName)
+	if err != nil {
+		return err
+	}
+	message, err := sender.eventToMessageTransformer.Transform(json.RawMessage(pendingEvent.Payload))
+	if err != nil {
+		return err
+	}
+	message.DeliveryMode = amqp.Persistent
+	message.MessageId = fmt.Sprintf("outbox-%d", pendingEvent.ID)
+
+	sender.mutex.Lock()
+	defer sender.mutex.Unlock()
+	if err = sender.confirmChannel.Publish(*exchange, "AS", false, false, *message); err != nil {
+		return err
+	}
+	sender.lastDeliveryTag++
+	return sender.waitForConfirmation(sender.lastDeliveryTag)
+}
+
+func (sender *AMQPOutboxSender) enableConfirms(channel AMQPChannel) error {
+	if err := channel.Confirm(false); err != nil {
+		return err
+	}
+	sender.mutex.Lock()
+	defer sender.mutex.Unlock()
+	sender.confirmChannel = channel
+	sender.confirmations = channel.NotifyPublish(make(chan amqp.Confirmation, sender.confirmBufferSize))
+	sender.lastDeliveryTag = 0
+	return nil
+}
+
+func (sender *AMQPOutboxSender) waitForConfirmation(deliveryTag uint64) error {
+	timeout := time.After(sender.confirmTimeout)
+	for {
+		select {
+		case confirmation, ok := <-sender.confirmations:
+			if !ok {
+				return amqp.ErrClosed
+			}
+			if confirmation.DeliveryTag < deliveryTag {
+				continue
+			}
+			if !confirmation.Ack {
+				return ErrPublishNotConfirmed
+			}
+			return nil
+		case <-timeout:
+			return ErrPublishNotConfirmed
+		}
+	}
+}
+
+func NewAMQPOutboxSender(amqpChannel *AMQPManagedChannel, eventToMessageTransformer *transformers.EventToAMQPMessageTransformer, settings OutboxRelaySettings) (*AMQPOutboxSender, error) {
+	sender := &AMQPOutboxSender{
+		amqpExchangeManager:       NewAMQPExchangeManager(amqpChannel),
+		eventToMessageTransformer: eventToMessageTransformer,
+		confirmTimeout:            settings.ConfirmTimeout,
+		confirmBufferSize:         settings.BatchSize,
+	}
+	if err := amqpChannel.Setup(sender.enableConfirms); err != nil {
+		return nil, err
+	}
+	return sender, nil
+}
@@ -1,49 +1,168 @@
 package messaging
 
 import (
+	"context"
 	"fmt"
+	"go-as/src/domain/events"
 	"go-as/src/infrastructure/transformers"
+	"strconv"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/streadway/amqp"
 	"go.uber.org/zap"
 )
 
+const retryCountHeader = "x-retry-count"
+
 type AMQPQueueEventListener struct {
-	amqpChannel                  *amqp.Channel
-	eventQueueName               string
-	amqpDeliveryToMapTransformer *transformers.AMQPDeliveryToMapTransformer
-	logger                       *zap.Logger
-}
-
-func (listener *AMQPQueueEventListener) Listen(eventChannel chan map[string]interface{}) error {
-	consumerTag := fmt.Sprintf("%sConsumer.%s", listener.eventQueueName, uuid.New().String())
-	deliveries, err := listener.amqpChannel.Consume(
-		listener.eventQueueName,
-		consumerTag,
-		false,
-		false,
-		false,
-		false,
-		nil,
-	)
-	if err != nil {
+	amqpChannel                       *AMQPManagedChannel
+	eventQueueName                    string
+	retryQueueName                    string
+	deadLetterQueueName               string
+	maxRetries                        int
+	retryDelay                        time.Duration
+	prefetch                          int
+	workers                           int
+	eventName                         string
+	eventRegistry                     *events.EventRegistry
+	eventMessageToEnvelopeTransformer *transformers.EventMessageToEnvelopeTransformer
+	logger                            *zap.Logger
+}
+
+func (listener *AMQPQueueEventListener) Listen(handler events.EventHandler) error {
+	return listener.amqpChannel.Setup(func(channel AMQPChannel) error {
+		if err := channel.Qos(listener.prefetch, 0, false); err != nil {
+			return err
+		}
+		consumerTag := fmt.Sprintf("%sConsumer.%s", listener.eventQueueName, uuid.New().String())
+		deliveries, err := channel.Consume(
+			listener.eventQueueName,
+			consumerTag,
+			false,
+			false,
+			false,
+			false,
+			nil,
+		)
+		if err != nil {
+			return err
+		}
+		go listener.dispatchDeliveries(deliveries, handler)
 		return nil
-	}
-	go listener.handleDelivery(deliveries, eventChannel)
-	return nil
+	})
 }
 
-func (listener *AMQPQueueEventListener) handleDelivery(deliveries <-chan amqp.Delivery, eventChannel chan map[string]interface{}) {
+func (listener *AMQPQueueEventListener) dispatchDeliveries(deliveries <-chan amqp.Delivery, handler events.EventHandler) {
+	partitions := startEventPartitions(listener.workers, listener.prefetch, func(decodedDelivery decodedMessage[amqp.Delivery]) {
+		listener.handleDelivery(decodedDelivery, handler)
+	})
+	defer partitions.close()
+
 	for delivery := range deliveries {
-		listener.logger.Info(fmt.Sprintf("got %dB delivery: [%v] %q", len(delivery.Body), delivery.DeliveryTag, delivery.Body))
-		eventMap, err := listener.amqpDeliveryToMapTransformer.Transform(&delivery)
+		listener.logger.Debug(fmt.Sprintf("Got %dB message %s", len(delivery.Body), describeDelivery(delivery)))
+		envelope, err := listener.eventMessageToEnvelopeTransformer.Transform(delivery.Body, delivery.MessageId, delivery.Timestamp, listener.eventName)
 		if err != nil {
-			listener.logger.Warn(fmt.Sprintf("Error transforming message %s to map: %s", string(delivery.Body), err.Error()))
+			listener.logger.Warn(fmt.Sprintf("Error transforming message %s to envelope: %s", describeDelivery(delivery), err.Error()))
+			listener.deadLetter(delivery)
+			continue
 		}
-		eventChannel <- eventMap
-		if err = delivery.Ack(false); err != nil {
-			listener.logger.Warn(fmt.Sprintf("Error acknowledging message %s due to %s", string(delivery.Body), err.Error()))
+		event, err := listener.eventRegistry.Decode(*envelope)
+		if err != nil {
+			listener.logger.Warn(fmt.Sprintf("Error decoding message %s: %s", describeDelivery(delivery), err.Error()))
+			listener.deadLetter(delivery)
+			continue
 		}
+		partitions.dispatch(delivery, *envelope, event)
+	}
+}
+
+func (listener *AMQPQueueEventListener) handleDelivery(decodedDelivery decodedMessage[amqp.Delivery], handler events.EventHandler) {
+	delivery := decodedDelivery.message
+	if err := handler(context.Background(), decodedDelivery.envelope, decodedDelivery.event); err != nil {
+		listener.logger.Warn(fmt.Sprintf("Error handling message %s: %s", describeDelivery(delivery), err.Error()))
+		listener.retry(delivery)
+		return
+	}
+	if err := delivery.Ack(false); err != nil {
+		listener.logger.Warn(fmt.Sprintf("Error acknowledging message %s due to %s", describeDelivery(delivery), err.Error()))
+	}
+}
+
+func (listener *AMQPQueueEventListener) retry(delivery amqp.Delivery) {
+	retryCount := getRetryCount(delivery)
+	if retryCount >= listener.maxRetries {
+		listener.logger.Warn(fmt.Sprintf("Message %s exhausted its %d retries", describeDelivery(delivery), listener.maxRetries))
+		listener.deadLetter(delivery)
+		return
+	}
+	headers := copyHeaders(delivery)
+	headers[retryCountHeader] = int32(retryCount + 1)
+	retryMessage := toPublishing(delivery, headers)
+	retryMessage.Expiration = strconv.FormatInt(listener.retryDelay.Milliseconds(), 10)
+	if err := listener.amqpChannel.Publish("", listener.retryQueueName, false, false, retryMessage); err != nil {
+		listener.logger.Warn(fmt.Sprintf("Error scheduling retry of message %s due to %s", describeDelivery(delivery), err.Error()))
+		listener.requeue(delivery)
+		return
+	}
+	if err := delivery.Ack(false); err != nil {
+		listener.logger.Warn(fmt.Sprintf("Error acknowledging message %s due to %s", describeDelivery(delivery), err.Error()))
+	}
+}
+
+// deadLetter copies the message to the dead-letter queue before rejecting it,
+// the event queue has no dead-letter arguments so rejecting alone would drop it
+func (listener *AMQPQueueEventListener) deadLetter(delivery amqp.Delivery) {
+	if err := listener.amqpChannel.Publish("", listener.deadLetterQueueName, false, false, toPublishing(delivery, copyHeaders(delivery))); err != nil {
+		listener.logger.Warn(fmt.Sprintf("Error dead-lettering message %s due to %s", describeDelivery(delivery), err.Error()))
+		listener.requeue(delivery)
+		return
+	}
+	if err := delivery.Nack(false, false); err != nil {
+		listener.logger.Warn(fmt.Sprintf("Error rejecting message %s due to %s", describeDelivery(delivery), err.Error()))
+	}
+}
+
+func (listener *AMQPQueueEventListener) requeue(delivery amqp.Delivery) {
+	if err := delivery.Nack(false, true); err != nil {
+		listener.logger.Warn(fmt.Sprintf("Error requeuing message %s due to %s", describeDelivery(delivery), err.Error()))
+	}
+}
+
+// describeDelivery identifies a delivery in logs without its body, which carries user data.
+func describeDelivery(delivery amqp.Delivery) string {
+	return fmt.Sprintf("%d (message id %s, routing key %s)", delivery.DeliveryTag, delivery.MessageId, delivery.RoutingKey)
+}
+
+func copyHeaders(delivery amqp.Delivery) amqp.Table {
+	headers := amqp.Table{}
+	for key, value := range delivery.Headers {
+		headers[key] = value
+	}
+	return headers
+}
+
+func toPublishing(delivery amqp.Delivery, headers amqp.Table) amqp.Publishing {
+	return amqp.Publishing{
+		Headers:      headers,
+		ContentType:  delivery.ContentType,
+		DeliveryMode: amqp.Persistent,
+		MessageId:    delivery.MessageId,
+		Timestamp:    delivery.Timestamp,
+		Type:         delivery.Type,
+		Body:         delivery.Body,
+	}
+}
+
+func getRetryCount(delivery amqp.Delivery) int {
+	switch retryCount := delivery.Headers[retryCountHeader].(type) {
+	case int32:
+		return int(retryCount)
+	case int64:
+		return int(retryCount)
+	case int:
+		return retryCount
+	default:
+		return 0
 	}
 }
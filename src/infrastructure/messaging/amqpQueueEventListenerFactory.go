@@ -10,55 +10,78 @@ import (
 )
 
 type AMQPQueueEventListenerFactory struct {
-	amqpChannel                  *amqp.Channel
-	amqpExchangeManager          *AMQPExchangeManager
-	amqpDeliveryToMapTransformer *transformers.AMQPDeliveryToMapTransformer
-	logger                       *zap.Logger
+	amqpChannel                       *AMQPManagedChannel
+	amqpExchangeManager               *AMQPExchangeManager
+	eventRegistry                     *events.EventRegistry
+	eventMessageToEnvelopeTransformer *transformers.EventMessageToEnvelopeTransformer
+	settings                          EventListenerSettings
+	logger                            *zap.Logger
 }
 
 func (factory *AMQPQueueEventListenerFactory) CreateListener(eventName string) (events.EventListener, error) {
 	eventQueueName := fmt.Sprintf("as.%s", eventName)
+	retryQueueName := fmt.Sprintf("%s.retry", eventQueueName)
+	deadLetterQueueName := fmt.Sprintf("%s.dead-letter", eventQueueName)
 	exchange, err := factory.amqpExchangeManager.GetExchangeForEvent(eventName)
 	if err != nil {
 		return nil, err
 	}
 
-	_, err = factory.amqpChannel.QueueDeclare(
-		eventQueueName,
-		true,
-		false,
-		false,
-		false,
-		nil,
-	)
+	err = factory.amqpChannel.Setup(func(channel AMQPChannel) error {
+		return factory.declareQueues(channel, *exchange, eventQueueName, retryQueueName, deadLetterQueueName)
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	err = factory.amqpChannel.QueueBind(
+	return &AMQPQueueEventListener{
+		amqpChannel:                       factory.amqpChannel,
+		eventQueueName:                    eventQueueName,
+		retryQueueName:                    retryQueueName,
+		deadLetterQueueName:               deadLetterQueueName,
+		retryDelay:                        factory.settings.RetryDelay,
+		maxRetries:                        factory.settings.MaxRetries,
+		prefetch:                          factory.settings.Prefetch,
+		workers:                           factory.settings.Workers,
+		eventName:                         eventName,
+		eventRegistry:                     factory.eventRegistry,
+		eventMessageToEnvelopeTransformer: factory.eventMessageToEnvelopeTransformer,
+		logger:                            factory.logger,
+	}, nil
+}
+
+// declareQueues keeps the event queue with the arguments it always had and gives the retry queue
+// fixed arguments, the retry delay is set per message so changing it never redeclares a queue
+func (*AMQPQueueEventListenerFactory) declareQueues(channel AMQPChannel, exchange string, eventQueueName string, retryQueueName string, deadLetterQueueName string) error {
+	if _, err := channel.QueueDeclare(deadLetterQueueName, true, false, false, false, nil); err != nil {
+		return err
+	}
+	_, err := channel.QueueDeclare(retryQueueName, true, false, false, false, amqp.Table{
+		"x-dead-letter-exchange":    "",
+		"x-dead-letter-routing-key": eventQueueName,
+	})
+	if err != nil {
+		return err
+	}
+	if _, err = channel.QueueDeclare(eventQueueName, true, false, false, false, nil); err != nil {
+		return err
+	}
+	return channel.QueueBind(
 		eventQueueName,
 		"AS",
-		*exchange,
+		exchange,
 		false,
 		nil,
 	)
-	if err != nil {
-		return nil, err
-	}
-
-	return &AMQPQueueEventListener{
-		amqpChannel:                  factory.amqpChannel,
-		eventQueueName:               eventQueueName,
-		amqpDeliveryToMapTransformer: factory.amqpDeliveryToMapTransformer,
-		logger:                       factory.logger,
-	}, nil
 }
 
-func NewAMQPQueueEventListenerFactory(amqpChannel *amqp.Channel, amqpExchangeManager *AMQPExchangeManager, amqpDeliveryToMapTransformer *transformers.AMQPDeliveryToMapTransformer, logger *zap.Logger) *AMQPQueueEventListenerFactory {
+func NewAMQPQueueEventListenerFactory(amqpChannel *AMQPManagedChannel, amqpExchangeManager *AMQPExchangeManager, eventRegistry *events.EventRegistry, eventMessageToEnvelopeTransformer *transformers.EventMessageToEnvelopeTransformer, settings EventListenerSettings, logger *zap.Logger) *AMQPQueueEventListenerFactory {
 	return &AMQPQueueEventListenerFactory{
-		amqpChannel:                  amqpChannel,
-		amqpExchangeManager:          amqpExchangeManager,
-		amqpDeliveryToMapTransformer: amqpDeliveryToMapTransformer,
-		logger:                       logger,
+		amqpChannel:                       amqpChannel,
+		amqpExchangeManager:               amqpExchangeManager,
+		eventRegistry:                     eventRegistry,
+		eventMessageToEnvelopeTransformer: eventMessageToEnvelopeTransformer,
+		settings:                          settings,
+		logger:                            logger,
 	}
 }
@@ -0,0 +1,281 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go-as/src/domain/events"
+	"go-as/src/infrastructure/dto"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/streadway/amqp"
+)
+
+type TestEvent struct {
+	Email    string `validate:"required"`
+	Sequence int
+}
+
+func (event *TestEvent) PartitionKey() string {
+	return event.Email
+}
+
+func newTestEventRegistry() *events.EventRegistry {
+	eventRegistry := events.NewEventRegistry(dto.NewDTOValidator())
+	eventRegistry.Register(TestEvent{}, 2)
+	if err := eventRegistry.RegisterUpcaster(TestEvent{}, 1, func(payload json.RawMessage) (json.RawMessage, error) {
+		return payload, nil
+	}); err != nil {
+		panic(err)
+	}
+	return eventRegistry
+}
+
+func TestListenSetsPrefetch(t *testing.T) {
+	broker := newFakeBroker(0)
+	connectionManager := newTestConnectionManager(broker)
+	connectionManager.Connect()
+	listener := newTestListener(t, connectionManager, EventListenerSettings{MaxRetries: 1, RetryDelay: time.Second, Prefetch: 7, Workers: 2})
+
+	err := listener.Listen(func(context.Context, events.EventEnvelope, any) error {
+		return nil
+	})
+
+	if err != nil {
+		t.Fatal("Expected listener to start listening")
+	}
+	broker.mutex.Lock()
+	defer broker.mutex.Unlock()
+	if broker.prefetch != 7 {
+		t.Fatal("Expected listener to set the configured prefetch on the channel")
+	}
+}
+
+func TestListenKeepsOrderPerKey(t *testing.T) {
+	broker := newFakeBroker(0)
+	connectionManager := newTestConnectionManager(broker)
+	connectionManager.Connect()
+	listener := newTestListener(t, connectionManager, EventListenerSettings{MaxRetries: 1, RetryDelay: time.Second, Prefetch: 8, Workers: 4})
+	var mutex sync.Mutex
+	handledSequences := make(map[string][]int)
+	err := listener.Listen(events.HandleTyped(func(_ context.Context, event *TestEvent) error {
+		time.Sleep(time.Duration(len(event.Email)) * time.Millisecond)
+		mutex.Lock()
+		defer mutex.Unlock()
+		handledSequences[event.Email] = append(handledSequences[event.Email], event.Sequence)
+		return nil
+	}))
+	if err != nil {
+		t.Fatal("Expected listener to start listening")
+	}
+
+	emails := []string{"a", "bb", "ccc", "dddd"}
+	for sequence := 0; sequence < 20; sequence++ {
+		broker.deliver("as.TestEvent", fmt.Sprintf(`{"Email":"%s","Sequence":%d}`, emails[sequence%len(emails)], sequence))
+	}
+
+	waitFor(t, func() bool {
+		_, _, _, acks := broker.stats()
+		return acks == 20
+	}, "Expected every delivered message to be acknowledged")
+	mutex.Lock()
+	defer mutex.Unlock()
+	for email, sequences := range handledSequences {
+		for i := 1; i < len(sequences); i++ {
+			if sequences[i] < sequences[i-1] {
+				t.Fatal(fmt.Sprintf("Expected events for %s to be handled in order", email))
+			}
+		}
+	}
+}
+
+func TestListenHandlesDifferentKeysConcurrently(t *testing.T) {
+	broker := newFakeBroker(0)
+	connectionManager := newTestConnectionManager(broker)
+	connectionManager.Connect()
+	listener := newTestListener(t, connectionManager, EventListenerSettings{MaxRetries: 1, RetryDelay: time.Second, Prefetch: 4, Workers: 2})
+	blockedEmail := "blockedEmail"
+	otherEmail := "otherEmail0"
+	for i := 1; getPartition(otherEmail, 2) == getPartition(blockedEmail, 2); i++ {
+		otherEmail = fmt.Sprintf("otherEmail%d", i)
+	}
+	release := make(chan struct{})
+	handledOther := make(chan struct{})
+	err := listener.Listen(events.HandleTyped(func(_ context.Context, event *TestEvent) error {
+		if event.Email == blockedEmail {
+			<-release
+		} else {
+			close(handledOther)
+		}
+		return nil
+	}))
+	if err != nil {
+		t.Fatal("Expected listener to start listening")
+	}
+	defer close(release)
+
+	broker.deliver("as.TestEvent", fmt.Sprintf(`{"Email":"%s"}`, blockedEmail))
+	broker.deliver("as.TestEvent", fmt.Sprintf(`{"Email":"%s"}`, otherEmail))
+
+	select {
+	case <-handledOther:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Expected an event for another key to be handled while the first one is blocked")
+	}
+}
+
+func TestListenDecodesEnvelopes(t *testing.T) {
+	broker := newFakeBroker(0)
+	connectionManager := newTestConnectionManager(broker)
+	connectionManager.Connect()
+	listener := newTestListener(t, connectionManager, EventListenerSettings{MaxRetries: 1, RetryDelay: time.Second, Prefetch: 1, Workers: 1})
+	handledEnvelopes := make(chan events.EventEnvelope, 1)
+	err := listener.Listen(func(_ context.Context, envelope events.EventEnvelope, event any) error {
+		if event.(*TestEvent).Email == "testEmail" {
+			handledEnvelopes <- envelope
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal("Expected listener to start listening")
+	}
+
+	broker.deliver("as.TestEvent", `{"id":"testID","name":"TestEvent","version":2,"occurredAt":"2026-10-18T10:00:00Z","payload":{"Email":"testEmail"}}`)
+
+	select {
+	case envelope := <-handledEnvelopes:
+		if envelope.ID != "testID" || envelope.Version != 2 || envelope.OccurredAt.IsZero() {
+			t.Fatal("Expected handler to receive the delivered envelope")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Expected enveloped event to be handled")
+	}
+}
+
+func TestListenDeadLettersUndecodableEvents(t *testing.T) {
+	broker := newFakeBroker(0)
+	connectionManager := newTestConnectionManager(broker)
+	connectionManager.Connect()
+	listener := newTestListener(t, connectionManager, EventListenerSettings{MaxRetries: 1, RetryDelay: time.Second, Prefetch: 1, Workers: 1})
+	handled := make(chan struct{}, 3)
+	err := listener.Listen(func(context.Context, events.EventEnvelope, any) error {
+		handled <- struct{}{}
+		return nil
+	})
+	if err != nil {
+		t.Fatal("Expected listener to start listening")
+	}
+
+	broker.deliver("as.TestEvent", `{"Sequence":1}`)
+	broker.deliver("as.TestEvent", `{"id":"testID","name":"TestEvent","version":3,"payload":{"Email":"testEmail"}}`)
+	broker.deliver("as.TestEvent", `{"id":"testID","name":"OtherEvent","version":1,"payload":{"Email":"testEmail"}}`)
+
+	waitFor(t, func() bool {
+		broker.mutex.Lock()
+		defer broker.mutex.Unlock()
+		return broker.deadLetters == 3
+	}, "Expected undecodable events to be dead-lettered")
+	if len(handled) != 0 {
+		t.Fatal("Expected undecodable events not to be handled")
+	}
+}
+
+func TestCreateListenerKeepsEventQueueArguments(t *testing.T) {
+	broker := newFakeBroker(0)
+	connectionManager := newTestConnectionManager(broker)
+	connectionManager.Connect()
+	newTestListener(t, connectionManager, EventListenerSettings{MaxRetries: 1, RetryDelay: time.Second, Prefetch: 1, Workers: 1})
+
+	broker.mutex.Lock()
+	defer broker.mutex.Unlock()
+	if broker.queueArgs["as.TestEvent"] != nil {
+		t.Fatal("Expected event queue to be declared without arguments")
+	}
+	if _, found := broker.queueArgs["as.TestEvent.retry"]["x-message-ttl"]; found {
+		t.Fatal("Expected retry queue arguments not to depend on the retry delay")
+	}
+}
+
+func TestListenDeadLettersUntransformableMessages(t *testing.T) {
+	broker := newFakeBroker(0)
+	connectionManager := newTestConnectionManager(broker)
+	connectionManager.Connect()
+	listener := newTestListener(t, connectionManager, EventListenerSettings{MaxRetries: 1, RetryDelay: time.Second, Prefetch: 1, Workers: 1})
+	err := listener.Listen(func(context.Context, events.EventEnvelope, any) error {
+		return nil
+	})
+	if err != nil {
+		t.Fatal("Expected listener to start listening")
+	}
+
+	broker.deliver("as.TestEvent", "notJSON")
+
+	waitFor(t, func() bool {
+		broker.mutex.Lock()
+		defer broker.mutex.Unlock()
+		return broker.deadLetters == 1
+	}, "Expected untransformable message to be nacked without requeue")
+	if len(broker.publishedTo("as.TestEvent.dead-letter")) != 1 {
+		t.Fatal("Expected untransformable message to be copied to the dead-letter queue")
+	}
+}
+
+func TestListenRetriesFailedMessages(t *testing.T) {
+	broker := newFakeBroker(0)
+	connectionManager := newTestConnectionManager(broker)
+	connectionManager.Connect()
+	listener := newTestListener(t, connectionManager, EventListenerSettings{MaxRetries: 3, RetryDelay: time.Second, Prefetch: 1, Workers: 1})
+	err := listener.Listen(func(context.Context, events.EventEnvelope, any) error {
+		return errors.New("Test handler error")
+	})
+	if err != nil {
+		t.Fatal("Expected listener to start listening")
+	}
+
+	broker.deliverWithHeaders("as.TestEvent", `{"Email":"testEmail"}`, amqp.Table{retryCountHeader: int32(1)})
+
+	waitFor(t, func() bool {
+		return len(broker.publishedTo("as.TestEvent.retry")) == 1
+	}, "Expected failed message to be republished to the retry queue")
+	retryMessage := broker.publishedTo("as.TestEvent.retry")[0]
+	if retryMessage.Headers[retryCountHeader] != int32(2) {
+		t.Fatal("Expected retry count to be incremented")
+	}
+	if retryMessage.Expiration != "1000" {
+		t.Fatal("Expected retry message to expire after the retry delay")
+	}
+	waitFor(t, func() bool {
+		_, _, _, acks := broker.stats()
+		return acks == 1
+	}, "Expected failed message to be acknowledged once scheduled for retry")
+}
+
+func TestListenDeadLettersExhaustedMessages(t *testing.T) {
+	broker := newFakeBroker(0)
+	connectionManager := newTestConnectionManager(broker)
+	connectionManager.Connect()
+	listener := newTestListener(t, connectionManager, EventListenerSettings{MaxRetries: 2, RetryDelay: time.Second, Prefetch: 1, Workers: 1})
+	err := listener.Listen(func(context.Context, events.EventEnvelope, any) error {
+		return errors.New("Test handler error")
+	})
+	if err != nil {
+		t.Fatal("Expected listener to start listening")
+	}
+
+	broker.deliverWithHeaders("as.TestEvent", `{"Email":"testEmail"}`, amqp.Table{retryCountHeader: int32(2)})
+
+	waitFor(t, func() bool {
+		broker.mutex.Lock()
+		defer broker.mutex.Unlock()
+		return broker.deadLetters == 1
+	}, "Expected exhausted message to be nacked without requeue")
+	if len(broker.publishedTo("as.TestEvent.dead-letter")) != 1 {
+		t.Fatal("Expected exhausted message to be copied to the dead-letter queue")
+	}
+	if len(broker.publishedTo("as.TestEvent.retry")) != 0 {
+		t.Fatal("Expected exhausted message not to be retried")
+	}
+}
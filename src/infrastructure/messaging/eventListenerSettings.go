@@ -0,0 +1,10 @@
+package messaging
+
+import "time"
+
+type EventListenerSettings struct {
+	MaxRetries int
+	RetryDelay time.Duration
+	Prefetch   int
+	Workers    int
+}
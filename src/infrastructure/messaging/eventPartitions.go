@@ -0,0 +1,60 @@
+package messaging
+
+import (
+	"go-as/src/domain/events"
+	"hash/fnv"
+)
+
+type decodedMessage[M any] struct {
+	message  M
+	envelope events.EventEnvelope
+	event    any
+}
+
+type eventPartitions[M any] struct {
+	partitions []chan decodedMessage[M]
+}
+
+func (partitions *eventPartitions[M]) dispatch(message M, envelope events.EventEnvelope, event any) {
+	partition := partitions.partitions[getPartition(getPartitionKey(event), len(partitions.partitions))]
+	partition <- decodedMessage[M]{
+		message:  message,
+		envelope: envelope,
+		event:    event,
+	}
+}
+
+func (partitions *eventPartitions[M]) close() {
+	for _, partition := range partitions.partitions {
+		close(partition)
+	}
+}
+
+func startEventPartitions[M any](workers int, bufferSize int, handle func(decodedMessage decodedMessage[M])) *eventPartitions[M] {
+	partitions := make([]chan decodedMessage[M], workers)
+	for i := range partitions {
+		partitions[i] = make(chan decodedMessage[M], bufferSize)
+		go func(partition <-chan decodedMessage[M]) {
+			for decodedMessage := range partition {
+				handle(decodedMessage)
+			}
+		}(partitions[i])
+	}
+	return &eventPartitions[M]{
+		partitions: partitions,
+	}
+}
+
+func getPartitionKey(event any) string {
+	partitionedEvent, ok := event.(events.PartitionedEvent)
+	if !ok {
+		return ""
+	}
+	return partitionedEvent.PartitionKey()
+}
+
+func getPartition(key string, partitions int) int {
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(key))
+	return int(hash.Sum32() % uint32(partitions))
+}
@@ -0,0 +1,64 @@
+package messaging
+
+import (
+	"errors"
+	"go-as/src/domain/events"
+	"sync"
+)
+
+var ErrInMemoryQueueFull = errors.New("In-memory event queue is full")
+
+type inMemoryMessage struct {
+	envelope events.EventEnvelope
+	attempts int
+}
+
+type InMemoryEventBus struct {
+	mutex      sync.Mutex
+	queues     map[string][]chan inMemoryMessage
+	bufferSize int
+}
+
+// Publish delivers the envelope to every subscriber queue or to none of them.
+// All writers hold the exclusive lock, so the capacity checked up front
+// cannot be taken before the sends.
+func (bus *InMemoryEventBus) Publish(envelope events.EventEnvelope) error {
+	bus.mutex.Lock()
+	defer bus.mutex.Unlock()
+	queues := bus.queues[envelope.Name]
+	for _, queue := range queues {
+		if len(queue) == cap(queue) {
+			return ErrInMemoryQueueFull
+		}
+	}
+	for _, queue := range queues {
+		queue <- inMemoryMessage{envelope: envelope}
+	}
+	return nil
+}
+
+func (bus *InMemoryEventBus) redeliver(queue chan inMemoryMessage, message inMemoryMessage) bool {
+	bus.mutex.Lock()
+	defer bus.mutex.Unlock()
+	select {
+	case queue <- message:
+		return true
+	default:
+		return false
+	}
+}
+
+func (bus *InMemoryEventBus) declareQueue(eventName string) chan inMemoryMessage {
+	bus.mutex.Lock()
+	defer bus.mutex.Unlock()
+	queue := make(chan inMemoryMessage, bus.bufferSize)
+	bus.queues[eventName] = append(bus.queues[eventName], queue)
+	return queue
+}
+
+func NewInMemoryEventBus(bufferSize int) *InMemoryEventBus {
+	return &InMemoryEventBus{
+		queues:     make(map[string][]chan inMemoryMessage),
+		bufferSize: bufferSize,
+	}
+}
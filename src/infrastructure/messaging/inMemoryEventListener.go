@@ -0,0 +1,63 @@
+package messaging
+
+import (
+	"context"
+	"fmt"
+	"go-as/src/domain/events"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type InMemoryEventListener struct {
+	eventBus      *InMemoryEventBus
+	queue         chan inMemoryMessage
+	eventRegistry *events.EventRegistry
+	settings      EventListenerSettings
+	logger        *zap.Logger
+}
+
+func (listener *InMemoryEventListener) Listen(handler events.EventHandler) error {
+	go listener.dispatchMessages(handler)
+	return nil
+}
+
+func (listener *InMemoryEventListener) dispatchMessages(handler events.EventHandler) {
+	partitions := startEventPartitions(listener.settings.Workers, listener.settings.Prefetch, func(decodedMessage decodedMessage[inMemoryMessage]) {
+		listener.handleMessage(decodedMessage, handler)
+	})
+	defer partitions.close()
+
+	for message := range listener.queue {
+		event, err := listener.eventRegistry.Decode(message.envelope)
+		if err != nil {
+			listener.logger.Warn(fmt.Sprintf("Error decoding event %s %s, dropping it: %s", message.envelope.Name, message.envelope.ID, err.Error()))
+			continue
+		}
+		partitions.dispatch(message, message.envelope, event)
+	}
+}
+
+func (listener *InMemoryEventListener) handleMessage(decodedMessage decodedMessage[inMemoryMessage], handler events.EventHandler) {
+	if err := handler(context.Background(), decodedMessage.envelope, decodedMessage.event); err != nil {
+		listener.logger.Warn(fmt.Sprintf("Error handling event %s %s: %s", decodedMessage.envelope.Name, decodedMessage.envelope.ID, err.Error()))
+		listener.retry(decodedMessage.message)
+	}
+}
+
+func (listener *InMemoryEventListener) retry(message inMemoryMessage) {
+	if message.attempts >= listener.settings.MaxRetries {
+		listener.logger.Warn(fmt.Sprintf("Event %s %s exhausted its %d retries, dropping it", message.envelope.Name, message.envelope.ID, listener.settings.MaxRetries))
+		return
+	}
+	message.attempts++
+	listener.redeliverAfterDelay(message)
+}
+
+func (listener *InMemoryEventListener) redeliverAfterDelay(message inMemoryMessage) {
+	time.AfterFunc(listener.settings.RetryDelay, func() {
+		if !listener.eventBus.redeliver(listener.queue, message) {
+			listener.redeliverAfterDelay(message)
+		}
+	})
+}
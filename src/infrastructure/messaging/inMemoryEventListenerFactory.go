@@ -0,0 +1,33 @@
+package messaging
+
+import (
+	"go-as/src/domain/events"
+
+	"go.uber.org/zap"
+)
+
+type InMemoryEventListenerFactory struct {
+	eventBus      *InMemoryEventBus
+	eventRegistry *events.EventRegistry
+	settings      EventListenerSettings
+	logger        *zap.Logger
+}
+
+func (factory *InMemoryEventListenerFactory) CreateListener(eventName string) (events.EventListener, error) {
+	return &InMemoryEventListener{
+		eventBus:      factory.eventBus,
+		queue:         factory.eventBus.declareQueue(eventName),
+		eventRegistry: factory.eventRegistry,
+		settings:      factory.settings,
+		logger:        factory.logger,
+	}, nil
+}
+
+func NewInMemoryEventListenerFactory(eventBus *InMemoryEventBus, eventRegistry *events.EventRegistry, settings EventListenerSettings, logger *zap.Logger) *InMemoryEventListenerFactory {
+	return &InMemoryEventListenerFactory{
+		eventBus:      eventBus,
+		eventRegistry: eventRegistry,
+		settings:      settings,
+		logger:        logger,
+	}
+}
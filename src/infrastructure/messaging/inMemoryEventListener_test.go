@@ -0,0 +1,118 @@
+package messaging
+
+import (
+	"context"
+	"errors"
+	"go-as/src/domain/events"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+func newTestInMemoryListener(t *testing.T, eventBus *InMemoryEventBus, settings EventListenerSettings) events.EventListener {
+	factory := NewInMemoryEventListenerFactory(eventBus, newTestEventRegistry(), settings, zap.NewNop())
+	listener, err := factory.CreateListener("TestEvent")
+	if err != nil {
+		t.Fatal("Expected listener to be created")
+	}
+	return listener
+}
+
+func TestInMemoryListenerHandlesSentEvents(t *testing.T) {
+	eventBus := NewInMemoryEventBus(10)
+	listener := newTestInMemoryListener(t, eventBus, EventListenerSettings{MaxRetries: 1, RetryDelay: time.Millisecond, Prefetch: 1, Workers: 1})
+	handledEnvelopes := make(chan events.EventEnvelope, 1)
+	err := listener.Listen(func(_ context.Context, envelope events.EventEnvelope, event any) error {
+		if event.(*TestEvent).Email == "testEmail" {
+			handledEnvelopes <- envelope
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal("Expected listener to start listening")
+	}
+
+	err = NewInMemoryOutboxSender(eventBus).Send(events.OutboxEvent{ID: 1, EventName: "TestEvent", Payload: `{"Email":"testEmail"}`})
+
+	if err != nil {
+		t.Fatal("Expected event to be sent")
+	}
+	select {
+	case envelope := <-handledEnvelopes:
+		if envelope.ID != "outbox-1" {
+			t.Fatal("Expected envelope id to be derived from the outbox event")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Expected sent event to be handled")
+	}
+}
+
+func TestInMemoryListenerRetriesFailedEvents(t *testing.T) {
+	eventBus := NewInMemoryEventBus(10)
+	listener := newTestInMemoryListener(t, eventBus, EventListenerSettings{MaxRetries: 2, RetryDelay: time.Millisecond, Prefetch: 1, Workers: 1})
+	var mutex sync.Mutex
+	attempts := 0
+	err := listener.Listen(func(context.Context, events.EventEnvelope, any) error {
+		mutex.Lock()
+		defer mutex.Unlock()
+		attempts++
+		return errors.New("Test handler error")
+	})
+	if err != nil {
+		t.Fatal("Expected listener to start listening")
+	}
+
+	err = eventBus.Publish(events.EventEnvelope{ID: "testID", Name: "TestEvent", Version: 2, Payload: []byte(`{"Email":"testEmail"}`)})
+
+	if err != nil {
+		t.Fatal("Expected event to be published")
+	}
+	waitFor(t, func() bool {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return attempts == 3
+	}, "Expected failed event to be retried up to the configured retries")
+	time.Sleep(20 * time.Millisecond)
+	mutex.Lock()
+	defer mutex.Unlock()
+	if attempts != 3 {
+		t.Fatal("Expected event to be dropped after exhausting its retries")
+	}
+}
+
+func TestInMemoryBusRejectsEventsWhenQueueIsFull(t *testing.T) {
+	eventBus := NewInMemoryEventBus(1)
+	newTestInMemoryListener(t, eventBus, EventListenerSettings{MaxRetries: 1, RetryDelay: time.Millisecond, Prefetch: 1, Workers: 1})
+	envelope := events.EventEnvelope{ID: "testID", Name: "TestEvent", Version: 2, Payload: []byte(`{"Email":"testEmail"}`)}
+
+	firstErr := eventBus.Publish(envelope)
+	secondErr := eventBus.Publish(envelope)
+
+	if firstErr != nil {
+		t.Fatal("Expected first event to be queued")
+	}
+	if secondErr != ErrInMemoryQueueFull {
+		t.Fatal("Error expected to be ErrInMemoryQueueFull")
+	}
+}
+
+func TestInMemoryBusDoesNotPartiallyPublishWhenAQueueIsFull(t *testing.T) {
+	eventBus := NewInMemoryEventBus(1)
+	newTestInMemoryListener(t, eventBus, EventListenerSettings{MaxRetries: 1, RetryDelay: time.Millisecond, Prefetch: 1, Workers: 1})
+	envelope := events.EventEnvelope{ID: "testID", Name: "TestEvent", Version: 2, Payload: []byte(`{"Email":"testEmail"}`)}
+	if err := eventBus.Publish(envelope); err != nil {
+		t.Fatal("Expected first event to be queued")
+	}
+	newTestInMemoryListener(t, eventBus, EventListenerSettings{MaxRetries: 1, RetryDelay: time.Millisecond, Prefetch: 1, Workers: 1})
+
+	err := eventBus.Publish(envelope)
+
+	if err != ErrInMemoryQueueFull {
+		t.Fatal("Error expected to be ErrInMemoryQueueFull")
+	}
+	if len(eventBus.queues["TestEvent"][1]) != 0 {
+		t.Fatal("Expected rejected event not to be delivered to the queues with room")
+	}
+}
@@ -0,0 +1,27 @@
+package messaging
+
+import (
+	"encoding/json"
+	"fmt"
+	"go-as/src/domain/events"
+)
+
+type InMemoryOutboxSender struct {
+	eventBus *InMemoryEventBus
+}
+
+func (sender *InMemoryOutboxSender) Send(pendingEvent events.OutboxEvent) error {
+	return sender.eventBus.Publish(events.EventEnvelope{
+		ID:         fmt.Sprintf("outbox-%d", pendingEvent.ID),
+		Name:       pendingEvent.EventName,
+		Version:    1,
+		OccurredAt: pendingEvent.CreatedAt,
+		Payload:    json.RawMessage(pendingEvent.Payload),
+	})
+}
+
+func NewInMemoryOutboxSender(eventBus *InMemoryEventBus) *InMemoryOutboxSender {
+	return &InMemoryOutboxSender{
+		eventBus: eventBus,
+	}
+}
@@ -0,0 +1,142 @@
+package messaging
+
+import (
+	"context"
+	"fmt"
+	"go-as/src/domain/events"
+	"go-as/src/infrastructure/transformers"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"go.uber.org/zap"
+)
+
+type NATSEventListener struct {
+	natsJetStream                     *NATSJetStream
+	subject                           string
+	deadLetterSubject                 string
+	consumerName                      string
+	eventName                         string
+	eventRegistry                     *events.EventRegistry
+	eventMessageToEnvelopeTransformer *transformers.EventMessageToEnvelopeTransformer
+	settings                          EventListenerSettings
+	logger                            *zap.Logger
+}
+
+func (listener *NATSEventListener) Listen(handler events.EventHandler) error {
+	partitions := startEventPartitions(listener.settings.Workers, listener.settings.Prefetch, func(decodedMessage decodedMessage[*nats.Msg]) {
+		listener.handleMessage(decodedMessage, handler)
+	})
+	_, err := listener.natsJetStream.jetStream.QueueSubscribe(
+		listener.subject,
+		listener.consumerName,
+		func(message *nats.Msg) {
+			listener.dispatchMessage(message, partitions)
+		},
+		nats.Durable(listener.consumerName),
+		nats.DeliverAll(),
+		nats.ManualAck(),
+		nats.AckExplicit(),
+		nats.MaxAckPending(listener.settings.Prefetch),
+	)
+	if err != nil {
+		partitions.close()
+		return err
+	}
+	return nil
+}
+
+func (listener *NATSEventListener) dispatchMessage(message *nats.Msg, partitions *eventPartitions[*nats.Msg]) {
+	listener.logger.Debug(fmt.Sprintf("Got %dB message %s", len(message.Data), describeMessage(message)))
+	var timestamp time.Time
+	if metadata, err := message.Metadata(); err == nil {
+		timestamp = metadata.Timestamp
+	}
+	envelope, err := listener.eventMessageToEnvelopeTransformer.Transform(message.Data, message.Header.Get(nats.MsgIdHdr), timestamp, listener.eventName)
+	if err != nil {
+		listener.logger.Warn(fmt.Sprintf("Error transforming message %s to envelope: %s", describeMessage(message), err.Error()))
+		listener.deadLetter(message)
+		return
+	}
+	event, err := listener.eventRegistry.Decode(*envelope)
+	if err != nil {
+		listener.logger.Warn(fmt.Sprintf("Error decoding message %s: %s", describeMessage(message), err.Error()))
+		listener.deadLetter(message)
+		return
+	}
+	partitions.dispatch(message, *envelope, event)
+}
+
+func (listener *NATSEventListener) handleMessage(decodedMessage decodedMessage[*nats.Msg], handler events.EventHandler) {
+	message := decodedMessage.message
+	if err := handler(context.Background(), decodedMessage.envelope, decodedMessage.event); err != nil {
+		listener.logger.Warn(fmt.Sprintf("Error handling message %s: %s", describeMessage(message), err.Error()))
+		listener.retry(message)
+		return
+	}
+	if err := message.Ack(); err != nil {
+		listener.logger.Warn(fmt.Sprintf("Error acknowledging message %s due to %s", describeMessage(message), err.Error()))
+	}
+}
+
+func (listener *NATSEventListener) retry(message *nats.Msg) {
+	metadata, err := message.Metadata()
+	if err == nil && metadata.NumDelivered > uint64(listener.settings.MaxRetries) {
+		listener.logger.Warn(fmt.Sprintf("Message %s exhausted its %d retries", describeMessage(message), listener.settings.MaxRetries))
+		listener.deadLetter(message)
+		return
+	}
+	listener.redeliverAfterDelay(message)
+}
+
+func (listener *NATSEventListener) redeliverAfterDelay(message *nats.Msg) {
+	if err := message.NakWithDelay(listener.settings.RetryDelay); err != nil {
+		listener.logger.Warn(fmt.Sprintf("Error scheduling retry of message %s due to %s", describeMessage(message), err.Error()))
+	}
+}
+
+// deadLetter copies the message to the dead-letter subject before terminating
+// it, so it is redelivered rather than lost when the copy cannot be published.
+// The consumer has no delivery limit for the same reason: retries are counted
+// here instead.
+func (listener *NATSEventListener) deadLetter(message *nats.Msg) {
+	if _, err := listener.natsJetStream.jetStream.PublishMsg(listener.toDeadLetterMessage(message)); err != nil {
+		listener.logger.Warn(fmt.Sprintf("Error dead-lettering message %s due to %s", describeMessage(message), err.Error()))
+		listener.redeliverAfterDelay(message)
+		return
+	}
+	listener.terminate(message)
+}
+
+// toDeadLetterMessage keeps the original headers but derives a new message id,
+// since the dead-letter subject shares the stream and its duplicate window.
+func (listener *NATSEventListener) toDeadLetterMessage(message *nats.Msg) *nats.Msg {
+	header := nats.Header{}
+	for key, values := range message.Header {
+		header[key] = append([]string{}, values...)
+	}
+	header.Del(nats.MsgIdHdr)
+	if messageID := message.Header.Get(nats.MsgIdHdr); messageID != "" {
+		header.Set(nats.MsgIdHdr, fmt.Sprintf("dlq-%s", messageID))
+	}
+	return &nats.Msg{
+		Subject: listener.deadLetterSubject,
+		Header:  header,
+		Data:    message.Data,
+	}
+}
+
+// describeMessage identifies a message in logs without its data, which carries user data.
+func describeMessage(message *nats.Msg) string {
+	var sequence uint64
+	if metadata, err := message.Metadata(); err == nil {
+		sequence = metadata.Sequence.Stream
+	}
+	return fmt.Sprintf("%d (message id %s, subject %s)", sequence, message.Header.Get(nats.MsgIdHdr), message.Subject)
+}
+
+func (listener *NATSEventListener) terminate(message *nats.Msg) {
+	if err := message.Term(); err != nil {
+		listener.logger.Warn(fmt.Sprintf("Error terminating message %s due to %s", describeMessage(message), err.Error()))
+	}
+}
@@ -0,0 +1,41 @@
+package messaging
+
+import (
+	"fmt"
+	"go-as/src/domain/events"
+	"go-as/src/infrastructure/transformers"
+
+	"go.uber.org/zap"
+)
+
+type NATSEventListenerFactory struct {
+	natsJetStream                     *NATSJetStream
+	eventRegistry                     *events.EventRegistry
+	eventMessageToEnvelopeTransformer *transformers.EventMessageToEnvelopeTransformer
+	settings                          EventListenerSettings
+	logger                            *zap.Logger
+}
+
+func (factory *NATSEventListenerFactory) CreateListener(eventName string) (events.EventListener, error) {
+	return &NATSEventListener{
+		natsJetStream:                     factory.natsJetStream,
+		subject:                           factory.natsJetStream.subjectForEvent(eventName),
+		deadLetterSubject:                 factory.natsJetStream.deadLetterSubjectForEvent(eventName),
+		consumerName:                      fmt.Sprintf("as-%s", eventName),
+		eventName:                         eventName,
+		eventRegistry:                     factory.eventRegistry,
+		eventMessageToEnvelopeTransformer: factory.eventMessageToEnvelopeTransformer,
+		settings:                          factory.settings,
+		logger:                            factory.logger,
+	}, nil
+}
+
+func NewNATSEventListenerFactory(natsJetStream *NATSJetStream, eventRegistry *events.EventRegistry, eventMessageToEnvelopeTransformer *transformers.EventMessageToEnvelopeTransformer, settings EventListenerSettings, logger *zap.Logger) *NATSEventListenerFactory {
+	return &NATSEventListenerFactory{
+		natsJetStream:                     natsJetStream,
+		eventRegistry:                     eventRegistry,
+		eventMessageToEnvelopeTransformer: eventMessageToEnvelopeTransformer,
+		settings:                          settings,
+		logger:                            logger,
+	}
+}
@@ -0,0 +1,191 @@
+package messaging
+
+import (
+	"context"
+	"errors"
+	"go-as/src/domain/events"
+	"go-as/src/infrastructure/transformers"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+	"go.uber.org/zap"
+)
+
+var testNATSStreamSettings = NATSStreamSettings{Stream: "TEST", SubjectPrefix: "test"}
+
+func runTestNATSServer(t *testing.T) *nats.Conn {
+	natsServer, err := server.NewServer(&server.Options{
+		Host:      "127.0.0.1",
+		Port:      server.RANDOM_PORT,
+		JetStream: true,
+		StoreDir:  t.TempDir(),
+		NoLog:     true,
+		NoSigs:    true,
+	})
+	if err != nil {
+		t.Fatalf("Expected NATS server to be created, got %s", err.Error())
+	}
+	go natsServer.Start()
+	t.Cleanup(natsServer.Shutdown)
+	if !natsServer.ReadyForConnections(5 * time.Second) {
+		t.Fatal("Expected NATS server to accept connections")
+	}
+	connection, err := nats.Connect(natsServer.ClientURL())
+	if err != nil {
+		t.Fatalf("Expected NATS connection, got %s", err.Error())
+	}
+	t.Cleanup(connection.Close)
+	return connection
+}
+
+func newTestNATSJetStream(t *testing.T) *NATSJetStream {
+	natsJetStream, err := NewNATSJetStream(runTestNATSServer(t), testNATSStreamSettings)
+	if err != nil {
+		t.Fatalf("Expected JetStream to be set up, got %s", err.Error())
+	}
+	return natsJetStream
+}
+
+func newTestNATSListener(t *testing.T, natsJetStream *NATSJetStream, settings EventListenerSettings) events.EventListener {
+	factory := NewNATSEventListenerFactory(natsJetStream, newTestEventRegistry(), transformers.NewEventMessageToEnvelopeTransformer(), settings, zap.NewNop())
+	listener, err := factory.CreateListener("TestEvent")
+	if err != nil {
+		t.Fatal("Expected listener to be created")
+	}
+	return listener
+}
+
+func nextStreamMessage(t *testing.T, natsJetStream *NATSJetStream, subject string) *nats.Msg {
+	subscription, err := natsJetStream.jetStream.SubscribeSync(subject, nats.DeliverAll())
+	if err != nil {
+		t.Fatalf("Expected subscription to %s, got %s", subject, err.Error())
+	}
+	defer subscription.Unsubscribe()
+	message, err := subscription.NextMsg(2 * time.Second)
+	if err != nil {
+		t.Fatalf("Expected message on %s, got %s", subject, err.Error())
+	}
+	return message
+}
+
+func TestNATSJetStreamDeclaresStream(t *testing.T) {
+	connection := runTestNATSServer(t)
+
+	natsJetStream, err := NewNATSJetStream(connection, testNATSStreamSettings)
+
+	if err != nil {
+		t.Fatal("Expected stream to be declared")
+	}
+	streamInfo, err := natsJetStream.jetStream.StreamInfo("TEST")
+	if err != nil || len(streamInfo.Config.Subjects) != 1 || streamInfo.Config.Subjects[0] != "test.>" {
+		t.Fatal("Expected stream to capture every subject under the prefix")
+	}
+	if _, err = NewNATSJetStream(connection, testNATSStreamSettings); err != nil {
+		t.Fatal("Expected existing stream to be reused")
+	}
+}
+
+func TestNATSOutboxSenderPublishesOncePerOutboxEvent(t *testing.T) {
+	natsJetStream := newTestNATSJetStream(t)
+	sender := NewNATSOutboxSender(natsJetStream, OutboxRelaySettings{ConfirmTimeout: 2 * time.Second})
+	outboxEvent := events.OutboxEvent{ID: 1, EventName: "TestEvent", Payload: `{"Email":"testEmail"}`}
+
+	firstErr := sender.Send(outboxEvent)
+	secondErr := sender.Send(outboxEvent)
+
+	if firstErr != nil || secondErr != nil {
+		t.Fatal("Expected outbox event to be sent")
+	}
+	message := nextStreamMessage(t, natsJetStream, "test.TestEvent")
+	if message.Header.Get(nats.MsgIdHdr) != "outbox-1" || string(message.Data) != outboxEvent.Payload {
+		t.Fatal("Expected outbox event to be published with its outbox id")
+	}
+	streamInfo, err := natsJetStream.jetStream.StreamInfo("TEST")
+	if err != nil || streamInfo.State.Msgs != 1 {
+		t.Fatal("Expected resent outbox event to be deduplicated by the stream")
+	}
+}
+
+func TestNATSListenerHandlesSentEvents(t *testing.T) {
+	natsJetStream := newTestNATSJetStream(t)
+	listener := newTestNATSListener(t, natsJetStream, EventListenerSettings{MaxRetries: 1, RetryDelay: time.Millisecond, Prefetch: 1, Workers: 1})
+	handledEnvelopes := make(chan events.EventEnvelope, 1)
+	err := listener.Listen(func(_ context.Context, envelope events.EventEnvelope, event any) error {
+		if event.(*TestEvent).Email == "testEmail" {
+			handledEnvelopes <- envelope
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal("Expected listener to start listening")
+	}
+
+	err = NewNATSOutboxSender(natsJetStream, OutboxRelaySettings{ConfirmTimeout: 2 * time.Second}).Send(events.OutboxEvent{ID: 1, EventName: "TestEvent", Payload: `{"Email":"testEmail"}`})
+
+	if err != nil {
+		t.Fatal("Expected event to be sent")
+	}
+	select {
+	case envelope := <-handledEnvelopes:
+		if envelope.ID != "outbox-1" {
+			t.Fatal("Expected envelope id to be the message id")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Expected sent event to be handled")
+	}
+}
+
+func TestNATSListenerDeadLettersEventsAfterExhaustingRetries(t *testing.T) {
+	natsJetStream := newTestNATSJetStream(t)
+	listener := newTestNATSListener(t, natsJetStream, EventListenerSettings{MaxRetries: 2, RetryDelay: time.Millisecond, Prefetch: 1, Workers: 1})
+	var mutex sync.Mutex
+	attempts := 0
+	err := listener.Listen(func(context.Context, events.EventEnvelope, any) error {
+		mutex.Lock()
+		defer mutex.Unlock()
+		attempts++
+		return errors.New("Test handler error")
+	})
+	if err != nil {
+		t.Fatal("Expected listener to start listening")
+	}
+
+	err = NewNATSOutboxSender(natsJetStream, OutboxRelaySettings{ConfirmTimeout: 2 * time.Second}).Send(events.OutboxEvent{ID: 1, EventName: "TestEvent", Payload: `{"Email":"testEmail"}`})
+
+	if err != nil {
+		t.Fatal("Expected event to be sent")
+	}
+	message := nextStreamMessage(t, natsJetStream, "test.dlq.TestEvent")
+	if string(message.Data) != `{"Email":"testEmail"}` || message.Header.Get(nats.MsgIdHdr) != "dlq-outbox-1" {
+		t.Fatal("Expected exhausted event to be copied to the dead-letter subject")
+	}
+	mutex.Lock()
+	defer mutex.Unlock()
+	if attempts != 3 {
+		t.Fatal("Expected event to be dead-lettered after the configured retries")
+	}
+}
+
+func TestNATSListenerDeadLettersMalformedMessages(t *testing.T) {
+	natsJetStream := newTestNATSJetStream(t)
+	listener := newTestNATSListener(t, natsJetStream, EventListenerSettings{MaxRetries: 1, RetryDelay: time.Millisecond, Prefetch: 1, Workers: 1})
+	err := listener.Listen(func(context.Context, events.EventEnvelope, any) error {
+		t.Error("Expected malformed message not to be handled")
+		return nil
+	})
+	if err != nil {
+		t.Fatal("Expected listener to start listening")
+	}
+
+	_, err = natsJetStream.jetStream.Publish("test.TestEvent", []byte(`{"Email":`))
+
+	if err != nil {
+		t.Fatal("Expected message to be published")
+	}
+	if message := nextStreamMessage(t, natsJetStream, "test.dlq.TestEvent"); string(message.Data) != `{"Email":` {
+		t.Fatal("Expected malformed message to be copied to the dead-letter subject")
+	}
+}
@@ -0,0 +1,24 @@
+package messaging
+
+import (
+	"errors"
+
+	"github.com/nats-io/nats.go"
+)
+
+type NATSHealthChecker struct {
+	connection *nats.Conn
+}
+
+func (checker *NATSHealthChecker) Check() error {
+	if !checker.connection.IsConnected() {
+		return errors.New("NATS connection lost")
+	}
+	return nil
+}
+
+func NewNATSHealthChecker(connection *nats.Conn) *NATSHealthChecker {
+	return &NATSHealthChecker{
+		connection: connection,
+	}
+}
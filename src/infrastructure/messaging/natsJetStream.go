@@ -0,0 +1,56 @@
+package messaging
+
+import (
+	"fmt"
+
+	"github.com/nats-io/nats.go"
+)
+
+type NATSStreamSettings struct {
+	Stream        string
+	SubjectPrefix string
+}
+
+type NATSJetStream struct {
+	jetStream nats.JetStreamContext
+	settings  NATSStreamSettings
+}
+
+func (natsJetStream *NATSJetStream) subjectForEvent(eventName string) string {
+	return fmt.Sprintf("%s.%s", natsJetStream.settings.SubjectPrefix, eventName)
+}
+
+func (natsJetStream *NATSJetStream) deadLetterSubjectForEvent(eventName string) string {
+	return fmt.Sprintf("%s.dlq.%s", natsJetStream.settings.SubjectPrefix, eventName)
+}
+
+func (natsJetStream *NATSJetStream) declareStream() error {
+	_, err := natsJetStream.jetStream.StreamInfo(natsJetStream.settings.Stream)
+	if err == nil {
+		return nil
+	}
+	if err != nats.ErrStreamNotFound {
+		return err
+	}
+	_, err = natsJetStream.jetStream.AddStream(&nats.StreamConfig{
+		Name:     natsJetStream.settings.Stream,
+		Subjects: []string{fmt.Sprintf("%s.>", natsJetStream.settings.SubjectPrefix)},
+		Storage:  nats.FileStorage,
+	})
+	return err
+}
+
+func NewNATSJetStream(connection *nats.Conn, settings NATSStreamSettings) (*NATSJetStream, error) {
+	jetStream, err := connection.JetStream()
+	if err != nil {
+		return nil, err
+	}
+	natsJetStream := &NATSJetStream{
+		jetStream: jetStream,
+		settings:  settings,
+	}
+	if err = natsJetStream.declareStream(); err != nil {
+		return nil, err
+	}
+	return natsJetStream, nil
+}
@@ -0,0 +1,34 @@
+package messaging
+
+import (
+	"context"
+	"fmt"
+	"go-as/src/domain/events"
+	"time"
+
+	"github.com/nats-io/nats.go"
+)
+
+type NATSOutboxSender struct {
+	natsJetStream  *NATSJetStream
+	confirmTimeout time.Duration
+}
+
+func (sender *NATSOutboxSender) Send(pendingEvent events.OutboxEvent) error {
+	ctx, cancel := context.WithTimeout(context.Background(), sender.confirmTimeout)
+	defer cancel()
+	_, err := sender.natsJetStream.jetStream.Publish(
+		sender.natsJetStream.subjectForEvent(pendingEvent.EventName),
+		[]byte(pendingEvent.Payload),
+		nats.MsgId(fmt.Sprintf("outbox-%d", pendingEvent.ID)),
+		nats.Context(ctx),
+	)
+	return err
+}
+
+func NewNATSOutboxSender(natsJetStream *NATSJetStream, settings OutboxRelaySettings) *NATSOutboxSender {
+	return &NATSOutboxSender{
+		natsJetStream:  natsJetStream,
+		confirmTimeout: settings.ConfirmTimeout,
+	}
+}
@@ -0,0 +1,124 @@
+package messaging
+
+import (
+	"context"
+	"fmt"
+	"go-as/src/domain/events"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type OutboxRelaySettings struct {
+	PollInterval           time.Duration
+	BatchSize              int
+	RetryBaseDelay         time.Duration
+	RetryMaxDelay          time.Duration
+	MaxAttempts            int
+	LeaseDuration          time.Duration
+	ConfirmTimeout         time.Duration
+	Retention              time.Duration
+	RetentionSweepInterval time.Duration
+}
+
+type OutboxEventSender interface {
+	Send(pendingEvent events.OutboxEvent) error
+}
+
+// OutboxRelay claims pending events in a short transaction and sends them outside of it,
+// claimed events stay leased until LeaseDuration so a crashed relay only delays them
+type OutboxRelay struct {
+	outboxEventSender OutboxEventSender
+	outboxRepository  events.OutboxRepository
+	settings          OutboxRelaySettings
+	ctx               context.Context
+	cancel            context.CancelFunc
+	done              chan struct{}
+	logger            *zap.Logger
+}
+
+func (relay *OutboxRelay) Run() {
+	go relay.relayPeriodically()
+}
+
+func (relay *OutboxRelay) Stop(ctx context.Context) error {
+	relay.cancel()
+	select {
+	case <-relay.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (relay *OutboxRelay) relayPeriodically() {
+	defer close(relay.done)
+	ticker := time.NewTicker(relay.settings.PollInterval)
+	defer ticker.Stop()
+	retentionTicker := time.NewTicker(relay.settings.RetentionSweepInterval)
+	defer retentionTicker.Stop()
+	for {
+		select {
+		case <-relay.ctx.Done():
+			return
+		case instant := <-ticker.C:
+			if err := relay.RelayPending(relay.ctx, instant.UTC()); err != nil {
+				relay.logger.Warn(fmt.Sprintf("Error relaying outbox events: %s", err.Error()))
+			}
+		case instant := <-retentionTicker.C:
+			if err := relay.PurgeSent(relay.ctx, instant.UTC()); err != nil {
+				relay.logger.Warn(fmt.Sprintf("Error purging sent outbox events: %s", err.Error()))
+			}
+		}
+	}
+}
+
+func (relay *OutboxRelay) RelayPending(ctx context.Context, instant time.Time) error {
+	pendingEvents, err := relay.outboxRepository.ClaimPending(ctx, instant, instant.Add(relay.settings.LeaseDuration), relay.settings.BatchSize)
+	if err != nil {
+		return err
+	}
+	for _, pendingEvent := range pendingEvents {
+		if err = relay.relay(ctx, pendingEvent, instant); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (relay *OutboxRelay) PurgeSent(ctx context.Context, instant time.Time) error {
+	purgedEvents, err := relay.outboxRepository.DeleteSentBefore(ctx, instant.Add(-relay.settings.Retention))
+	if err != nil {
+		return err
+	}
+	if purgedEvents > 0 {
+		relay.logger.Info(fmt.Sprintf("Purged %d sent outbox events", purgedEvents))
+	}
+	return nil
+}
+
+func (relay *OutboxRelay) relay(ctx context.Context, pendingEvent events.OutboxEvent, instant time.Time) error {
+	if err := relay.outboxEventSender.Send(pendingEvent); err != nil {
+		pendingEvent.RecordFailure(instant, err, relay.settings.RetryBaseDelay, relay.settings.RetryMaxDelay, relay.settings.MaxAttempts)
+		if pendingEvent.FailedAt != nil {
+			relay.logger.Error(fmt.Sprintf("Giving up on outbox event %d after %d attempts: %s", pendingEvent.ID, pendingEvent.Attempts, err.Error()))
+		} else {
+			relay.logger.Warn(fmt.Sprintf("Error publishing outbox event %d: %s", pendingEvent.ID, err.Error()))
+		}
+		return relay.outboxRepository.MarkFailed(ctx, pendingEvent)
+	}
+	return relay.outboxRepository.MarkSent(ctx, pendingEvent.ID, time.Now().UTC())
+}
+
+func NewOutboxRelay(outboxEventSender OutboxEventSender, outboxRepository events.OutboxRepository, settings OutboxRelaySettings, logger *zap.Logger) *OutboxRelay {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &OutboxRelay{
+		outboxEventSender: outboxEventSender,
+		outboxRepository:  outboxRepository,
+		settings:          settings,
+		ctx:               ctx,
+		cancel:            cancel,
+		done:              make(chan struct{}),
+		logger:            logger,
+	}
+}
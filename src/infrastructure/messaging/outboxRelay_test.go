@@ -0,0 +1,132 @@
+package messaging
+
+import (
+	"context"
+	"errors"
+	"go-as/src/domain/events"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type fakeOutboxRepository struct {
+	pendingEvents []events.OutboxEvent
+	leaseUntil    time.Time
+	sentIDs       []int64
+	failedEvents  []events.OutboxEvent
+	purgedBefore  time.Time
+}
+
+func (repo *fakeOutboxRepository) Save(context.Context, events.OutboxEvent) error {
+	return nil
+}
+
+func (repo *fakeOutboxRepository) ClaimPending(_ context.Context, _ time.Time, leaseUntil time.Time, _ int) ([]events.OutboxEvent, error) {
+	repo.leaseUntil = leaseUntil
+	return repo.pendingEvents, nil
+}
+
+func (repo *fakeOutboxRepository) MarkSent(_ context.Context, id int64, _ time.Time) error {
+	repo.sentIDs = append(repo.sentIDs, id)
+	return nil
+}
+
+func (repo *fakeOutboxRepository) MarkFailed(_ context.Context, event events.OutboxEvent) error {
+	repo.failedEvents = append(repo.failedEvents, event)
+	return nil
+}
+
+func (repo *fakeOutboxRepository) DeleteSentBefore(_ context.Context, instant time.Time) (int64, error) {
+	repo.purgedBefore = instant
+	return 0, nil
+}
+
+type fakeOutboxSender struct {
+	err error
+}
+
+func (sender *fakeOutboxSender) Send(events.OutboxEvent) error {
+	return sender.err
+}
+
+func newTestOutboxRelay(sender OutboxEventSender, repo events.OutboxRepository) *OutboxRelay {
+	return NewOutboxRelay(sender, repo, OutboxRelaySettings{
+		PollInterval:           time.Hour,
+		BatchSize:              10,
+		RetryBaseDelay:         time.Second,
+		RetryMaxDelay:          time.Minute,
+		MaxAttempts:            3,
+		LeaseDuration:          time.Minute,
+		Retention:              24 * time.Hour,
+		RetentionSweepInterval: time.Hour,
+	}, zap.NewNop())
+}
+
+func TestOutboxRelayMarksSentEvents(t *testing.T) {
+	repo := &fakeOutboxRepository{pendingEvents: []events.OutboxEvent{{ID: 1}, {ID: 2}}}
+	instant := time.Now().UTC()
+
+	err := newTestOutboxRelay(&fakeOutboxSender{}, repo).RelayPending(context.Background(), instant)
+
+	if err != nil {
+		t.Fatal("Expected pending events to be relayed")
+	}
+	if len(repo.sentIDs) != 2 {
+		t.Fatal("Expected every claimed event to be marked as sent")
+	}
+	if !repo.leaseUntil.Equal(instant.Add(time.Minute)) {
+		t.Fatal("Expected claimed events to be leased for the lease duration")
+	}
+}
+
+func TestOutboxRelaySchedulesRetryOnSendError(t *testing.T) {
+	repo := &fakeOutboxRepository{pendingEvents: []events.OutboxEvent{{ID: 1}}}
+
+	err := newTestOutboxRelay(&fakeOutboxSender{err: errors.New("sendError")}, repo).RelayPending(context.Background(), time.Now().UTC())
+
+	if err != nil {
+		t.Fatal("Expected send errors to be recorded on the event")
+	}
+	if len(repo.failedEvents) != 1 || repo.failedEvents[0].Attempts != 1 || repo.failedEvents[0].FailedAt != nil {
+		t.Fatal("Expected event to be scheduled for retry")
+	}
+}
+
+func TestOutboxRelayFailsEventAfterMaxAttempts(t *testing.T) {
+	repo := &fakeOutboxRepository{pendingEvents: []events.OutboxEvent{{ID: 1, Attempts: 2}}}
+
+	err := newTestOutboxRelay(&fakeOutboxSender{err: errors.New("sendError")}, repo).RelayPending(context.Background(), time.Now().UTC())
+
+	if err != nil {
+		t.Fatal("Expected send errors to be recorded on the event")
+	}
+	if len(repo.failedEvents) != 1 || repo.failedEvents[0].FailedAt == nil {
+		t.Fatal("Expected event to be failed after max attempts")
+	}
+}
+
+func TestOutboxRelayPurgesSentEventsOlderThanRetention(t *testing.T) {
+	repo := &fakeOutboxRepository{}
+	instant := time.Now().UTC()
+
+	err := newTestOutboxRelay(&fakeOutboxSender{}, repo).PurgeSent(context.Background(), instant)
+
+	if err != nil {
+		t.Fatal("Expected sent events to be purged")
+	}
+	if !repo.purgedBefore.Equal(instant.Add(-24 * time.Hour)) {
+		t.Fatal("Expected sent events older than the retention to be purged")
+	}
+}
+
+func TestOutboxRelayStops(t *testing.T) {
+	relay := newTestOutboxRelay(&fakeOutboxSender{}, &fakeOutboxRepository{})
+	relay.Run()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	if err := relay.Stop(ctx); err != nil {
+		t.Fatal("Expected relay to stop")
+	}
+}
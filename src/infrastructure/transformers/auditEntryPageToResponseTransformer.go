@@ -0,0 +1,37 @@
+package transformers
+
+import (
+	"encoding/json"
+	"go-as/src/domain/audit"
+	"go-as/src/domain/pagination"
+	"go-as/src/infrastructure/dto"
+)
+
+type AuditEntryPageToResponseTransformer struct{}
+
+func (transformer *AuditEntryPageToResponseTransformer) Transform(page *pagination.Page[audit.AuditEntry]) *dto.AuditEntryPageResponseDTO {
+	items := make([]dto.AuditEntryResponseDTO, 0, len(page.Items))
+	for _, entry := range page.Items {
+		items = append(items, dto.AuditEntryResponseDTO{
+			ID:         entry.ID,
+			Actor:      entry.Actor,
+			Action:     entry.Action,
+			TargetType: entry.TargetType,
+			Target:     entry.Target,
+			Before:     json.RawMessage(entry.Before),
+			After:      json.RawMessage(entry.After),
+			TraceID:    entry.TraceID,
+			CreatedAt:  entry.CreatedAt,
+		})
+	}
+	return &dto.AuditEntryPageResponseDTO{
+		Items: items,
+		Page:  page.Page,
+		Size:  page.Size,
+		Total: page.Total,
+	}
+}
+
+func NewAuditEntryPageToResponseTransformer() *AuditEntryPageToResponseTransformer {
+	return &AuditEntryPageToResponseTransformer{}
+}
@@ -0,0 +1,34 @@
+package transformers
+
+import (
+	"go-as/src/application/checkUserHasPermissions"
+	"go-as/src/domain/permission"
+	"go-as/src/infrastructure/dto"
+)
+
+type CheckPermissionsRequestTransformer struct{}
+
+func (transformer *CheckPermissionsRequestTransformer) Transform(userEmail string, requestDTO *dto.CheckUserPermissionsRequestDTO) *checkUserHasPermissions.CheckUserHasPermissionRequest {
+	checkRequest := checkUserHasPermissions.CheckUserHasPermissionRequest{
+		UserEmail:       userEmail,
+		PermissionNames: requestDTO.Permissions,
+		Resource:        transformer.TransformResource(requestDTO.Resource),
+		Mode:            checkUserHasPermissions.CheckMode(requestDTO.Mode),
+	}
+	return &checkRequest
+}
+
+func (*CheckPermissionsRequestTransformer) TransformResource(resourceDTO *dto.ResourceDTO) *permission.Resource {
+	if resourceDTO == nil {
+		return nil
+	}
+	return &permission.Resource{
+		Type: resourceDTO.Type,
+		ID:   resourceDTO.ID,
+	}
+}
+
+func NewCheckPermissionsRequestTransformer() *CheckPermissionsRequestTransformer {
+	transformer := CheckPermissionsRequestTransformer{}
+	return &transformer
+}
@@ -0,0 +1,28 @@
+package transformers
+
+import (
+	"go-as/src/application/checkUserHasPermissions"
+	"go-as/src/infrastructure/dto"
+)
+
+type CheckPermissionsResponseTransformer struct {
+	denialTransformer *PermissionDenialToResponseTransformer
+}
+
+func (transformer *CheckPermissionsResponseTransformer) Transform(checkResponse *checkUserHasPermissions.CheckUserHasPermissionResponse) *dto.CheckUserPermissionsResponseDTO {
+	responseDTO := dto.CheckUserPermissionsResponseDTO{
+		Result:    checkResponse.Result,
+		Decisions: checkResponse.Decisions,
+	}
+	for _, denial := range checkResponse.Denials {
+		responseDTO.Denials = append(responseDTO.Denials, *transformer.denialTransformer.Transform(&denial))
+	}
+	return &responseDTO
+}
+
+func NewCheckPermissionsResponseTransformer(denialTransformer *PermissionDenialToResponseTransformer) *CheckPermissionsResponseTransformer {
+	transformer := CheckPermissionsResponseTransformer{
+		denialTransformer: denialTransformer,
+	}
+	return &transformer
+}
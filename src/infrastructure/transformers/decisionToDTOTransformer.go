@@ -0,0 +1,24 @@
+package transformers
+
+import (
+	"go-as/src/domain/decision"
+	"go-as/src/infrastructure/dto"
+)
+
+type DecisionToDTOTransformer struct{}
+
+func (*DecisionToDTOTransformer) Transform(recordedDecision decision.Decision) *dto.DecisionDTO {
+	return &dto.DecisionDTO{
+		Subject:     recordedDecision.Subject,
+		Permissions: recordedDecision.Permissions,
+		Allowed:     recordedDecision.Allowed,
+		Reason:      recordedDecision.Reason,
+		Source:      recordedDecision.Source,
+		TraceID:     recordedDecision.TraceID,
+		DecidedAt:   recordedDecision.DecidedAt,
+	}
+}
+
+func NewDecisionToDTOTransformer() *DecisionToDTOTransformer {
+	return &DecisionToDTOTransformer{}
+}
@@ -0,0 +1,39 @@
+package transformers
+
+import (
+	"go-as/src/application/getUserEffectivePermissions"
+	"go-as/src/domain/user"
+	"go-as/src/infrastructure/dto"
+)
+
+type EffectivePermissionsResponseTransformer struct{}
+
+func (transformer *EffectivePermissionsResponseTransformer) Transform(effectivePermissionsResponse *getUserEffectivePermissions.GetUserEffectivePermissionsResponse) *dto.EffectivePermissionsResponseDTO {
+	responseDTO := dto.EffectivePermissionsResponseDTO{
+		Superuser:   effectivePermissionsResponse.Superuser,
+		Permissions: make([]dto.EffectivePermissionDTO, 0, len(effectivePermissionsResponse.Permissions)),
+	}
+	for _, effectivePermission := range effectivePermissionsResponse.Permissions {
+		responseDTO.Permissions = append(responseDTO.Permissions, dto.EffectivePermissionDTO{
+			Permission: effectivePermission.PermissionName,
+			Sources:    transformer.transformSources(effectivePermission.Sources),
+		})
+	}
+	return &responseDTO
+}
+
+func (*EffectivePermissionsResponseTransformer) transformSources(sources []user.PermissionSource) []dto.PermissionSourceDTO {
+	sourceResponses := make([]dto.PermissionSourceDTO, 0, len(sources))
+	for _, source := range sources {
+		sourceResponses = append(sourceResponses, dto.PermissionSourceDTO{
+			Source:   source.Source,
+			RolePath: source.RolePath,
+		})
+	}
+	return sourceResponses
+}
+
+func NewEffectivePermissionsResponseTransformer() *EffectivePermissionsResponseTransformer {
+	transformer := EffectivePermissionsResponseTransformer{}
+	return &transformer
+}
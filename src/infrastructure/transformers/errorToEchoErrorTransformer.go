@@ -1,7 +1,12 @@
 package transformers
 
 import (
+	"go-as/src/application/batchCheckUserPermissions"
+	"go-as/src/application/checkUserHasPermissions"
 	"go-as/src/domain/internals"
+	"go-as/src/domain/permission"
+	"go-as/src/domain/role"
+	"go-as/src/domain/user"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
@@ -13,13 +18,24 @@ func (transformer *ErrorToEchoErrorTransformer) Transform(err error) *echo.HTTPE
 	if err == nil {
 		return nil
 	}
+	if httpErr, ok := err.(*echo.HTTPError); ok {
+		return httpErr
+	}
 	return echo.NewHTTPError(transformer.getHTTPStatusCode(err), err.Error())
 }
 
 func (*ErrorToEchoErrorTransformer) getHTTPStatusCode(err error) int {
 	switch err.(type) {
-	case internals.UseCaseAuthorizationError:
+	case internals.UseCaseAuthorizationError, internals.UseCasePermissionDeniedError, user.UserDisabledError:
 		return http.StatusForbidden
+	case role.RoleNotFoundError, permission.PermissionNotFoundError, user.UserNotFoundError:
+		return http.StatusNotFound
+	case permission.PermissionInUseError, user.UserAlreadyExistsError, role.RoleAlreadyExistsError:
+		return http.StatusConflict
+	case role.RoleHierarchyCycleError, user.InvalidGrantValidityError, batchCheckUserPermissions.BatchSizeExceededError, batchCheckUserPermissions.EmptyBatchError, checkUserHasPermissions.InvalidCheckModeError:
+		return http.StatusBadRequest
+	case user.UserVersionMismatchError, role.RoleVersionMismatchError:
+		return http.StatusPreconditionFailed
 	default:
 		return http.StatusInternalServerError
 	}
@@ -0,0 +1,45 @@
+package transformers
+
+import (
+	"encoding/json"
+	"go-as/src/domain/events"
+	"time"
+)
+
+type EventMessageToEnvelopeTransformer struct{}
+
+func (*EventMessageToEnvelopeTransformer) Transform(body []byte, messageID string, timestamp time.Time, eventName string) (*events.EventEnvelope, error) {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(body, &fields)
+	if err != nil {
+		return nil, err
+	}
+	_, hasName := fields["name"]
+	_, hasPayload := fields["payload"]
+	if !hasName || !hasPayload {
+		return &events.EventEnvelope{
+			ID:         messageID,
+			Name:       eventName,
+			Version:    1,
+			OccurredAt: timestamp,
+			Payload:    body,
+		}, nil
+	}
+
+	var envelope events.EventEnvelope
+	err = json.Unmarshal(body, &envelope)
+	if err != nil {
+		return nil, err
+	}
+	if envelope.ID == "" {
+		envelope.ID = messageID
+	}
+	if envelope.Version == 0 {
+		envelope.Version = 1
+	}
+	return &envelope, nil
+}
+
+func NewEventMessageToEnvelopeTransformer() *EventMessageToEnvelopeTransformer {
+	return &EventMessageToEnvelopeTransformer{}
+}
@@ -0,0 +1,27 @@
+package transformers
+
+import (
+	"go-as/src/domain/user"
+	"go-as/src/infrastructure/dto"
+)
+
+type GrantValidityDTOToDomainTransformer struct{}
+
+func (transformer *GrantValidityDTOToDomainTransformer) Transform(validityDTOs map[string]dto.GrantValidityDTO) map[string]user.GrantValidity {
+	validities := make(map[string]user.GrantValidity, len(validityDTOs))
+	for grantName, validityDTO := range validityDTOs {
+		validities[grantName] = transformer.TransformValidity(validityDTO)
+	}
+	return validities
+}
+
+func (*GrantValidityDTOToDomainTransformer) TransformValidity(validityDTO dto.GrantValidityDTO) user.GrantValidity {
+	return user.GrantValidity{
+		ValidFrom:  validityDTO.ValidFrom,
+		ValidUntil: validityDTO.ValidUntil,
+	}
+}
+
+func NewGrantValidityDTOToDomainTransformer() *GrantValidityDTOToDomainTransformer {
+	return &GrantValidityDTOToDomainTransformer{}
+}
@@ -0,0 +1,25 @@
+package transformers
+
+import (
+	"go-as/src/domain/user"
+	"go-as/src/infrastructure/dto"
+)
+
+type PermissionDenialToResponseTransformer struct{}
+
+func (transformer *PermissionDenialToResponseTransformer) Transform(denial *user.PermissionDenial) *dto.PermissionDenialDTO {
+	denialResponse := dto.PermissionDenialDTO{
+		Permission: denial.PermissionName,
+		Source:     "role",
+		Role:       denial.RoleName,
+	}
+	if denial.IsUserLevel() {
+		denialResponse.Source = "user"
+	}
+	return &denialResponse
+}
+
+func NewPermissionDenialToResponseTransformer() *PermissionDenialToResponseTransformer {
+	transformer := PermissionDenialToResponseTransformer{}
+	return &transformer
+}
@@ -0,0 +1,31 @@
+package transformers
+
+import (
+	"go-as/src/domain/user"
+	"go-as/src/infrastructure/dto"
+)
+
+type PermissionExplanationToResponseTransformer struct {
+	denialTransformer *PermissionDenialToResponseTransformer
+}
+
+func (transformer *PermissionExplanationToResponseTransformer) Transform(explanation *user.PermissionExplanation) *dto.PermissionExplanationDTO {
+	explanationResponse := dto.PermissionExplanationDTO{
+		Permission:     explanation.PermissionName,
+		Granted:        explanation.Granted,
+		Source:         explanation.Source,
+		RolePath:       explanation.RolePath,
+		ClosestMatches: explanation.ClosestMatches,
+	}
+	if explanation.Denial != nil {
+		explanationResponse.Denial = transformer.denialTransformer.Transform(explanation.Denial)
+	}
+	return &explanationResponse
+}
+
+func NewPermissionExplanationToResponseTransformer(denialTransformer *PermissionDenialToResponseTransformer) *PermissionExplanationToResponseTransformer {
+	transformer := PermissionExplanationToResponseTransformer{
+		denialTransformer: denialTransformer,
+	}
+	return &transformer
+}
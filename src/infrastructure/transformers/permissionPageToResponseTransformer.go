@@ -0,0 +1,30 @@
+package transformers
+
+import (
+	"go-as/src/domain/pagination"
+	"go-as/src/domain/permission"
+	"go-as/src/infrastructure/dto"
+)
+
+type PermissionPageToResponseTransformer struct {
+	permissionTransformer *PermissionToResponseTransformer
+}
+
+func (transformer *PermissionPageToResponseTransformer) Transform(page *pagination.Page[permission.Permission]) *dto.PermissionPageResponseDTO {
+	items := make([]dto.PermissionResponseDTO, 0, len(page.Items))
+	for _, permission := range page.Items {
+		items = append(items, *transformer.permissionTransformer.Transform(&permission))
+	}
+	return &dto.PermissionPageResponseDTO{
+		Items: items,
+		Page:  page.Page,
+		Size:  page.Size,
+		Total: page.Total,
+	}
+}
+
+func NewPermissionPageToResponseTransformer(permissionTransformer *PermissionToResponseTransformer) *PermissionPageToResponseTransformer {
+	return &PermissionPageToResponseTransformer{
+		permissionTransformer: permissionTransformer,
+	}
+}
@@ -9,7 +9,9 @@ type PermissionToResponseTransformer struct{}
 
 func (transformer *PermissionToResponseTransformer) Transform(permission *permission.Permission) *dto.PermissionResponseDTO {
 	permissionResponse := dto.PermissionResponseDTO{
-		Name: permission.Name,
+		Name:        permission.Name,
+		Description: permission.Description,
+		Service:     permission.Service,
 	}
 	return &permissionResponse
 }
@@ -0,0 +1,30 @@
+package transformers
+
+import (
+	"go-as/src/domain/pagination"
+	"go-as/src/domain/role"
+	"go-as/src/infrastructure/dto"
+)
+
+type RolePageToResponseTransformer struct {
+	roleTransformer *RoleToResponseTransformer
+}
+
+func (transformer *RolePageToResponseTransformer) Transform(page *pagination.Page[role.Role]) *dto.RolePageResponseDTO {
+	items := make([]dto.RoleResponseDTO, 0, len(page.Items))
+	for _, role := range page.Items {
+		items = append(items, *transformer.roleTransformer.Transform(&role))
+	}
+	return &dto.RolePageResponseDTO{
+		Items: items,
+		Page:  page.Page,
+		Size:  page.Size,
+		Total: page.Total,
+	}
+}
+
+func NewRolePageToResponseTransformer(roleTransformer *RoleToResponseTransformer) *RolePageToResponseTransformer {
+	return &RolePageToResponseTransformer{
+		roleTransformer: roleTransformer,
+	}
+}
@@ -12,12 +12,22 @@ type RoleToResponseTransformer struct {
 
 func (transformer *RoleToResponseTransformer) Transform(role *role.Role) *dto.RoleResponseDTO {
 	roleResponse := dto.RoleResponseDTO{
-		Name:        role.Name,
-		Permissions: transformer.transformPermissions(role.Permissions),
+		Name:              role.Name,
+		Permissions:       transformer.transformPermissions(role.Permissions),
+		DeniedPermissions: transformer.transformPermissions(role.DeniedPermissions),
+		Parents:           transformer.transformParents(role.Parents),
 	}
 	return &roleResponse
 }
 
+func (*RoleToResponseTransformer) transformParents(parents []role.Role) []string {
+	var parentNames []string
+	for _, parent := range parents {
+		parentNames = append(parentNames, parent.Name)
+	}
+	return parentNames
+}
+
 func (transformer *RoleToResponseTransformer) transformPermissions(permissions []permission.Permission) []dto.PermissionResponseDTO {
 	var permissionResponses []dto.PermissionResponseDTO
 	for _, permission := range permissions {
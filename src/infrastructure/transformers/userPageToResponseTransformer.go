@@ -0,0 +1,30 @@
+package transformers
+
+import (
+	"go-as/src/domain/pagination"
+	"go-as/src/domain/user"
+	"go-as/src/infrastructure/dto"
+)
+
+type UserPageToResponseTransformer struct {
+	userTransformer *UserToResponseTransformer
+}
+
+func (transformer *UserPageToResponseTransformer) Transform(page *pagination.Page[user.User]) *dto.UserPageResponseDTO {
+	items := make([]dto.UserResponseDTO, 0, len(page.Items))
+	for _, user := range page.Items {
+		items = append(items, *transformer.userTransformer.Transform(&user))
+	}
+	return &dto.UserPageResponseDTO{
+		Items: items,
+		Page:  page.Page,
+		Size:  page.Size,
+		Total: page.Total,
+	}
+}
+
+func NewUserPageToResponseTransformer(userTransformer *UserToResponseTransformer) *UserPageToResponseTransformer {
+	return &UserPageToResponseTransformer{
+		userTransformer: userTransformer,
+	}
+}
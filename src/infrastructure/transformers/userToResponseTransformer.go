@@ -0,0 +1,36 @@
+package transformers
+
+import (
+	"go-as/src/domain/permission"
+	"go-as/src/domain/user"
+	"go-as/src/infrastructure/dto"
+)
+
+type UserToResponseTransformer struct{}
+
+func (transformer *UserToResponseTransformer) Transform(user *user.User) *dto.UserResponseDTO {
+	roleNames := make([]string, 0, len(user.Roles))
+	for _, role := range user.Roles {
+		roleNames = append(roleNames, role.Name)
+	}
+	return &dto.UserResponseDTO{
+		Email:             user.Email,
+		Superuser:         user.Superuser,
+		Disabled:          user.Disabled,
+		Roles:             roleNames,
+		Permissions:       transformer.transformPermissionNames(user.Permissions),
+		DeniedPermissions: transformer.transformPermissionNames(user.DeniedPermissions),
+	}
+}
+
+func (*UserToResponseTransformer) transformPermissionNames(permissions []permission.Permission) []string {
+	permissionNames := make([]string, 0, len(permissions))
+	for _, permission := range permissions {
+		permissionNames = append(permissionNames, permission.Name)
+	}
+	return permissionNames
+}
+
+func NewUserToResponseTransformer() *UserToResponseTransformer {
+	return &UserToResponseTransformer{}
+}